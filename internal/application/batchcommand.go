@@ -0,0 +1,176 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/transformer"
+)
+
+// defaultBatchCommandConcurrency is used when Device.MaxBatchCommandConcurrency is not configured
+const defaultBatchCommandConcurrency = 8
+
+const (
+	batchMethodGet = "get"
+	batchMethodSet = "set"
+)
+
+// BatchCommand is a read or write command of a device in a batch command request.
+type BatchCommand struct {
+	DeviceName  string `json:"deviceName"`
+	CommandName string `json:"commandName"`
+	// Method is either "get" or "set", case-insensitive
+	Method string `json:"method"`
+	// QueryParams are passed to the command, including the ds-pushevent, ds-returnevent, ds-regexcmd,
+	// ds-verify and ds-units reserved parameters
+	QueryParams map[string]string `json:"queryParams,omitempty"`
+	// Settings are the parameters of a set command
+	Settings map[string]any `json:"settings,omitempty"`
+}
+
+// BatchCommandRequest executes the commands of multiple devices in one request.
+type BatchCommandRequest struct {
+	commonDTO.BaseRequest `json:",inline"`
+	Commands              []BatchCommand `json:"commands"`
+}
+
+// BatchCommandResult is the outcome of a command of a batch command request.
+type BatchCommandResult struct {
+	DeviceName  string      `json:"deviceName"`
+	CommandName string      `json:"commandName"`
+	Method      string      `json:"method"`
+	StatusCode  int         `json:"statusCode"`
+	Message     string      `json:"message,omitempty"`
+	Event       *dtos.Event `json:"event,omitempty"`
+	// Verification reports the read-back verification of a set command in the verify modes
+	Verification []WriteVerification `json:"verification,omitempty"`
+}
+
+// BatchCommandResponse reports the results of a batch command request in the order of the commands.
+type BatchCommandResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	Results                []BatchCommandResult `json:"results"`
+}
+
+// ExecuteBatchCommands executes the commands concurrently, up to Device.MaxBatchCommandConcurrency at a time, and
+// returns their results in the same order. A failed command doesn't affect the others.
+func ExecuteBatchCommands(ctx context.Context, commands []BatchCommand, dic *di.Container) ([]BatchCommandResult, errors.EdgeX) {
+	if len(commands) == 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "no command in the batch command request", nil)
+	}
+
+	concurrency := container.ConfigurationFrom(dic.Get).Device.MaxBatchCommandConcurrency
+	if concurrency <= 0 {
+		concurrency = defaultBatchCommandConcurrency
+	}
+	semaphore := make(chan struct{}, concurrency)
+
+	results := make([]BatchCommandResult, len(commands))
+	var wg sync.WaitGroup
+	for i, command := range commands {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func() {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			results[i] = executeBatchCommand(ctx, command, dic)
+		}()
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+func executeBatchCommand(ctx context.Context, command BatchCommand, dic *di.Container) BatchCommandResult {
+	result := BatchCommandResult{
+		DeviceName:  command.DeviceName,
+		CommandName: command.CommandName,
+		Method:      strings.ToLower(command.Method),
+	}
+	queryParams, reserved := filterBatchQueryParams(command.QueryParams)
+
+	var event *dtos.Event
+	ctx, err := transformer.WithRequestedUnits(ctx, command.QueryParams[sdkCommon.UnitsParameter])
+	if err != nil {
+		result.StatusCode = err.Code()
+		result.Message = err.Error()
+		return result
+	}
+	switch result.Method {
+	case batchMethodGet:
+		event, err = GetCommand(ctx, command.DeviceName, command.CommandName, queryParams, reserved[common.RegexCommand], dic)
+	case batchMethodSet:
+		event, result.Verification, err = SetCommand(ctx, command.DeviceName, command.CommandName, queryParams, command.Settings,
+			command.QueryParams[sdkCommon.VerifyWriteParameter], dic)
+	default:
+		err = errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("unknown command method '%s', only 'get' or 'set' is allowed", command.Method), nil)
+	}
+	if err != nil {
+		result.StatusCode = err.Code()
+		result.Message = err.Error()
+		return result
+	}
+
+	result.StatusCode = http.StatusOK
+	if event == nil {
+		if result.Method == batchMethodGet {
+			result.Message = DroppedReadingsMessage
+		}
+		return result
+	}
+	// the events of the set commands are always pushed, as the single set command does
+	if result.Method == batchMethodSet || reserved[common.PushEvent] {
+		correlationId := utils.FromContext(ctx, common.CorrelationHeader)
+		go sdkCommon.SendEvent(event, correlationId, dic)
+	}
+	if result.Method == batchMethodGet && reserved[common.ReturnEvent] {
+		result.Event = event
+	}
+	return result
+}
+
+// filterBatchQueryParams returns the query string of the command without the SDK reserved parameters,
+// and the values of the reserved parameters with the same defaults as the single command
+func filterBatchQueryParams(params map[string]string) (string, map[string]bool) {
+	reserved := map[string]bool{
+		common.PushEvent:    false,
+		common.ReturnEvent:  true,
+		common.RegexCommand: true,
+	}
+	values := url.Values{}
+	for k, v := range params {
+		switch k {
+		case common.PushEvent:
+			reserved[k] = v == common.ValueTrue
+		case common.ReturnEvent, common.RegexCommand:
+			reserved[k] = v != common.ValueFalse
+		}
+		if strings.HasPrefix(k, sdkCommon.SDKReservedPrefix) {
+			continue
+		}
+		values.Set(k, v)
+	}
+	return values.Encode(), reserved
+}
@@ -0,0 +1,188 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/cache"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/transformer"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+// SourceReading is the result of reading a single source with ReadSources.
+type SourceReading struct {
+	Event *dtos.Event
+	Err   errors.EdgeX
+}
+
+type readBatch struct {
+	sourceNames []string
+	reqs        []sdkModels.CommandRequest
+	// requests contains the keys of the CommandRequests of the batch
+	requests map[string]bool
+}
+
+// ReadSources reads several sources (DeviceCommand, DeviceResource or regex DeviceResource names) of a device
+// with as few ProtocolDriver calls as possible. The CommandRequests of all sources are merged into batches of
+// at most Device.MaxCmdOps distinct CommandRequests, and the results are split back into one Event per source.
+// CommandRequests are only merged if they read the same DeviceResource with the same attributes. A source for which
+// the ProtocolDriver returns no value fails, as in GetCommand.
+func ReadSources(ctx context.Context, deviceName string, sourceNames []string, dic *di.Container) map[string]SourceReading {
+	results := make(map[string]SourceReading, len(sourceNames))
+	if len(sourceNames) == 0 {
+		return results
+	}
+
+	failed := false
+	device, err := validateServiceAndDeviceState(deviceName, dic)
+	defer func() {
+		if failed {
+			DeviceRequestFailed(deviceName, dic)
+		} else {
+			DeviceRequestSucceeded(device, dic)
+		}
+	}()
+	if err != nil {
+		failed = true
+		for _, sourceName := range sourceNames {
+			results[sourceName] = SourceReading{Err: errors.NewCommonEdgeXWrapper(err)}
+		}
+		return results
+	}
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	configuration := container.ConfigurationFrom(dic.Get)
+	sourceReqs := make(map[string][]sdkModels.CommandRequest, len(sourceNames))
+	var batches []*readBatch
+	var current *readBatch
+	for _, sourceName := range sourceNames {
+		if _, ok := sourceReqs[sourceName]; ok {
+			continue
+		}
+		reqs, err := readRequests(device, sourceName, "", true, dic)
+		if err != nil {
+			failed = true
+			results[sourceName] = SourceReading{Err: errors.NewCommonEdgeXWrapper(err)}
+			continue
+		}
+		sourceReqs[sourceName] = reqs
+
+		if current == nil || len(current.requests)+newRequestCount(current, reqs) > configuration.Device.MaxCmdOps {
+			current = &readBatch{requests: make(map[string]bool)}
+			batches = append(batches, current)
+		}
+		current.sourceNames = append(current.sourceNames, sourceName)
+		for _, req := range reqs {
+			key := requestKey(req)
+			if !current.requests[key] {
+				current.requests[key] = true
+				current.reqs = append(current.reqs, req)
+			}
+		}
+	}
+
+	for _, batch := range batches {
+		// execute protocol-specific read operation
+		values, edgexErr := handleReadCommands(ctx, device, batch.reqs, dic)
+		if edgexErr != nil {
+			failed = true
+			for _, sourceName := range batch.sourceNames {
+				errMsg := fmt.Sprintf("error reading %s for %s", sourceName, device.Name)
+				results[sourceName] = SourceReading{Err: errors.NewCommonEdgeX(errors.Kind(edgexErr), errMsg, edgexErr)}
+			}
+			continue
+		}
+		lc.Debugf("AutoEvent - read %d source(s) of device %s with %d CommandRequest(s)", len(batch.sourceNames), device.Name, len(batch.reqs))
+
+		valuesByRequest := requestValues(batch.reqs, values)
+		// the transform states of the DeviceResources, e.g. the previous values of the validation rules, are updated
+		// once for the values of the batch, although a value is converted once per source
+		batchCtx := transformer.WithTransformStates(ctx, container.TransformStatesFrom(dic.Get).Batch())
+
+		for _, sourceName := range batch.sourceNames {
+			cvs := make([]*sdkModels.CommandValue, 0, len(sourceReqs[sourceName]))
+			for _, req := range sourceReqs[sourceName] {
+				if cv, ok := valuesByRequest[requestKey(req)]; ok {
+					// every source transforms its own copy, since the value can be shared by several sources
+					copied := *cv
+					cvs = append(cvs, &copied)
+				}
+			}
+
+			if err := checkReadValues(cvs, sourceName, device.Name); err != nil {
+				failed = true
+				results[sourceName] = SourceReading{Err: err}
+				continue
+			}
+
+			// convert CommandValue to Event
+			event, err := transformer.CommandValuesToEventDTO(batchCtx, cvs, device.Name, sourceName, configuration.Device.DataTransform, dic)
+			if err != nil {
+				failed = true
+				results[sourceName] = SourceReading{Err: errors.NewCommonEdgeX(errors.KindServerError, "failed to convert CommandValue to Event", err)}
+				continue
+			}
+			results[sourceName] = SourceReading{Event: event}
+		}
+	}
+
+	if !failed {
+		cache.Devices().SetLastConnectedByName(deviceName)
+	}
+	return results
+}
+
+func newRequestCount(batch *readBatch, reqs []sdkModels.CommandRequest) int {
+	count := 0
+	seen := make(map[string]bool, len(reqs))
+	for _, req := range reqs {
+		key := requestKey(req)
+		if !batch.requests[key] && !seen[key] {
+			seen[key] = true
+			count++
+		}
+	}
+	return count
+}
+
+// requestKey identifies the CommandRequests reading the same DeviceResource with the same attributes. The maps
+// are printed with sorted keys, so that equal attributes give the same key.
+func requestKey(req sdkModels.CommandRequest) string {
+	return fmt.Sprintf("%s %v", req.DeviceResourceName, req.Attributes)
+}
+
+// requestValues maps the key of each CommandRequest to its CommandValue. The ProtocolDriver returns the values in
+// the order of the CommandRequests, a value of another DeviceResource is matched to the first CommandRequest of
+// its DeviceResource.
+func requestValues(reqs []sdkModels.CommandRequest, values []*sdkModels.CommandValue) map[string]*sdkModels.CommandValue {
+	valuesByRequest := make(map[string]*sdkModels.CommandValue, len(values))
+	for i, cv := range values {
+		if cv == nil {
+			continue
+		}
+		if i < len(reqs) && reqs[i].DeviceResourceName == cv.DeviceResourceName {
+			valuesByRequest[requestKey(reqs[i])] = cv
+			continue
+		}
+		for _, req := range reqs {
+			key := requestKey(req)
+			if _, ok := valuesByRequest[key]; !ok && req.DeviceResourceName == cv.DeviceResourceName {
+				valuesByRequest[key] = cv
+				break
+			}
+		}
+	}
+	return valuesByRequest
+}
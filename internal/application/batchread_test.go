@@ -0,0 +1,57 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/stretchr/testify/assert"
+
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+func TestRequestKey(t *testing.T) {
+	req := sdkModels.CommandRequest{DeviceResourceName: "r", Attributes: map[string]any{"a": 1, "b": "x"}}
+	sameAttributes := sdkModels.CommandRequest{DeviceResourceName: "r", Attributes: map[string]any{"b": "x", "a": 1}}
+	otherAttributes := sdkModels.CommandRequest{DeviceResourceName: "r", Attributes: map[string]any{"a": 2, "b": "x"}}
+	otherResource := sdkModels.CommandRequest{DeviceResourceName: "s", Attributes: map[string]any{"a": 1, "b": "x"}}
+
+	assert.Equal(t, requestKey(req), requestKey(sameAttributes))
+	assert.NotEqual(t, requestKey(req), requestKey(otherAttributes))
+	assert.NotEqual(t, requestKey(req), requestKey(otherResource))
+}
+
+func TestNewRequestCount(t *testing.T) {
+	first := sdkModels.CommandRequest{DeviceResourceName: "r", Attributes: map[string]any{"startingAddress": 1}}
+	second := sdkModels.CommandRequest{DeviceResourceName: "r", Attributes: map[string]any{"startingAddress": 2}}
+	batch := &readBatch{requests: map[string]bool{requestKey(first): true}}
+
+	assert.Equal(t, 0, newRequestCount(batch, []sdkModels.CommandRequest{first}))
+	assert.Equal(t, 1, newRequestCount(batch, []sdkModels.CommandRequest{first, second, second}))
+}
+
+func TestRequestValues(t *testing.T) {
+	first := sdkModels.CommandRequest{DeviceResourceName: "r", Attributes: map[string]any{"startingAddress": 1}}
+	second := sdkModels.CommandRequest{DeviceResourceName: "r", Attributes: map[string]any{"startingAddress": 2}}
+	other := sdkModels.CommandRequest{DeviceResourceName: "s"}
+	firstValue := &sdkModels.CommandValue{DeviceResourceName: "r", Type: common.ValueTypeInt32, Value: int32(1)}
+	secondValue := &sdkModels.CommandValue{DeviceResourceName: "r", Type: common.ValueTypeInt32, Value: int32(2)}
+	otherValue := &sdkModels.CommandValue{DeviceResourceName: "s", Type: common.ValueTypeInt32, Value: int32(3)}
+
+	// the values are matched to the CommandRequests of the same DeviceResource by position
+	values := requestValues([]sdkModels.CommandRequest{first, second, other}, []*sdkModels.CommandValue{firstValue, secondValue, otherValue})
+	assert.Same(t, firstValue, values[requestKey(first)])
+	assert.Same(t, secondValue, values[requestKey(second)])
+	assert.Same(t, otherValue, values[requestKey(other)])
+
+	// out of order values are matched to the first CommandRequest of their DeviceResource
+	values = requestValues([]sdkModels.CommandRequest{first, second, other}, []*sdkModels.CommandValue{otherValue, nil, firstValue})
+	assert.Same(t, firstValue, values[requestKey(first)])
+	assert.NotContains(t, values, requestKey(second))
+	assert.Same(t, otherValue, values[requestKey(other)])
+}
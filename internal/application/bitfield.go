@@ -0,0 +1,156 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/cache"
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/transformer"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+// bitFieldWord returns the DeviceResource of the word of the bit field, which must be an integer DeviceResource
+// read and written by the driver
+func bitFieldWord(device models.Device, field *transformer.BitField) (models.DeviceResource, errors.EdgeX) {
+	word, ok := cache.Profiles().DeviceResource(device.ProfileName, field.Word)
+	if !ok {
+		errMsg := fmt.Sprintf("word %s of bit field %s for %s not defined", field.Word, field.Name, device.Name)
+		return models.DeviceResource{}, errors.NewCommonEdgeX(errors.KindServerError, errMsg, nil)
+	}
+	_, isField := word.Attributes[sdkCommon.BitFieldWordAttribute]
+	if isField || transformer.IsVirtualResource(word) {
+		errMsg := fmt.Sprintf("word %s of bit field %s for %s is not a DeviceResource of the device", word.Name, field.Name, device.Name)
+		return models.DeviceResource{}, errors.NewCommonEdgeX(errors.KindServerError, errMsg, nil)
+	}
+	return word, nil
+}
+
+// holdBitFieldWords holds a command slot of the device for the read and the write of the words when bit fields are
+// written, so that no other command of the device changes a word in between. The returned function must be called
+// to release the slot once the write is done.
+func holdBitFieldWords(ctx context.Context, device models.Device, reqs []sdkModels.CommandRequest, cvs []*sdkModels.CommandValue,
+	dic *di.Container) (context.Context, func(), errors.EdgeX) {
+	writesBitField := slices.ContainsFunc(cvs, func(cv *sdkModels.CommandValue) bool {
+		dr, _ := cache.Profiles().DeviceResource(device.ProfileName, cv.DeviceResourceName)
+		_, ok := dr.Attributes[sdkCommon.BitFieldWordAttribute]
+		return ok
+	})
+	if !writesBitField {
+		return ctx, func() {}, nil
+	}
+	return holdCommandSlot(ctx, device, reqs, dic)
+}
+
+// mergeBitFields replaces the written bit fields by their words, so that the driver only writes whole words. The
+// bit fields are merged into the written value of a word written along, or else into the current value of the word
+// read beforehand, with the context of holdBitFieldWords. The read-modify-write still isn't atomic on the device
+// side, a change of the other bits of the word by the device itself or by another client of the device between the
+// read and the write is overwritten.
+func mergeBitFields(ctx context.Context, device models.Device, reqs []sdkModels.CommandRequest, cvs []*sdkModels.CommandValue,
+	attributes string, dic *di.Container) ([]sdkModels.CommandRequest, []*sdkModels.CommandValue, errors.EdgeX) {
+	type writtenField struct {
+		field *transformer.BitField
+		cv    *sdkModels.CommandValue
+	}
+
+	mergedReqs := make([]sdkModels.CommandRequest, 0, len(reqs))
+	mergedCVs := make([]*sdkModels.CommandValue, 0, len(cvs))
+	var words []models.DeviceResource
+	fieldsByWord := make(map[string][]writtenField)
+	for i, cv := range cvs {
+		dr, _ := cache.Profiles().DeviceResource(device.ProfileName, cv.DeviceResourceName)
+		field, edgexErr := transformer.ResourceBitField(dr)
+		if edgexErr != nil {
+			return nil, nil, errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+		if field == nil {
+			mergedReqs = append(mergedReqs, reqs[i])
+			mergedCVs = append(mergedCVs, cv)
+			continue
+		}
+		if _, ok := fieldsByWord[field.Word]; !ok {
+			word, edgexErr := bitFieldWord(device, field)
+			if edgexErr != nil {
+				return nil, nil, errors.NewCommonEdgeXWrapper(edgexErr)
+			}
+			if word.Properties.ReadWrite == common.ReadWrite_R {
+				errMsg := fmt.Sprintf("word %s of bit field %s is marked as read-only", word.Name, field.Name)
+				return nil, nil, errors.NewCommonEdgeX(errors.KindNotAllowed, errMsg, nil)
+			}
+			words = append(words, word)
+		}
+		fieldsByWord[field.Word] = append(fieldsByWord[field.Word], writtenField{field: field, cv: cv})
+	}
+	if len(words) == 0 {
+		return reqs, cvs, nil
+	}
+
+	// read the current values of the words which aren't written along
+	var readReqs []sdkModels.CommandRequest
+	for _, word := range words {
+		if slices.ContainsFunc(mergedCVs, func(cv *sdkModels.CommandValue) bool { return cv.DeviceResourceName == word.Name }) {
+			continue
+		}
+		if word.Properties.ReadWrite == common.ReadWrite_W {
+			errMsg := fmt.Sprintf("word %s is marked as write-only and can't be read to merge its bit fields", word.Name)
+			return nil, nil, errors.NewCommonEdgeX(errors.KindNotAllowed, errMsg, nil)
+		}
+		var edgexErr errors.EdgeX
+		if readReqs, edgexErr = appendResourceRequest(readReqs, device, word, attributes); edgexErr != nil {
+			return nil, nil, errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+	}
+	var current []*sdkModels.CommandValue
+	if len(readReqs) > 0 {
+		var edgexErr errors.EdgeX
+		current, edgexErr = handleReadCommands(ctx, device, readReqs, dic)
+		if edgexErr != nil {
+			return nil, nil, errors.NewCommonEdgeX(errors.Kind(edgexErr), "failed to read the words to merge the bit fields into", edgexErr)
+		}
+	}
+
+	for _, word := range words {
+		var wordCV *sdkModels.CommandValue
+		if i := slices.IndexFunc(mergedCVs, func(cv *sdkModels.CommandValue) bool { return cv.DeviceResourceName == word.Name }); i >= 0 {
+			wordCV = mergedCVs[i]
+		} else {
+			i := slices.IndexFunc(current, func(cv *sdkModels.CommandValue) bool {
+				return cv != nil && cv.DeviceResourceName == word.Name && cv.Value != nil
+			})
+			if i < 0 {
+				errMsg := fmt.Sprintf("no value of word %s read to merge its bit fields into", word.Name)
+				return nil, nil, errors.NewCommonEdgeX(errors.KindServerError, errMsg, nil)
+			}
+			var err error
+			if wordCV, err = sdkModels.NewCommandValue(word.Name, word.Properties.ValueType, current[i].Value); err != nil {
+				errMsg := fmt.Sprintf("invalid value of word %s read to merge its bit fields into", word.Name)
+				return nil, nil, errors.NewCommonEdgeX(errors.KindServerError, errMsg, err)
+			}
+			var edgexErr errors.EdgeX
+			if mergedReqs, edgexErr = appendResourceRequest(mergedReqs, device, word, attributes); edgexErr != nil {
+				return nil, nil, errors.NewCommonEdgeXWrapper(edgexErr)
+			}
+			mergedCVs = append(mergedCVs, wordCV)
+		}
+
+		for _, written := range fieldsByWord[word.Name] {
+			if edgexErr := written.field.Merge(wordCV, written.cv); edgexErr != nil {
+				return nil, nil, errors.NewCommonEdgeXWrapper(edgexErr)
+			}
+		}
+	}
+	return mergedReqs, mergedCVs, nil
+}
@@ -0,0 +1,145 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	bootstrapMocks "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	clientMocks "github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/cache"
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/config"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/interfaces/mocks"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+const (
+	bitFieldService  = "bitfield-service"
+	bitFieldProfile  = "bitfield-profile"
+	bitFieldDevice   = "bitfield-device"
+	bitFieldWordName = "status"
+)
+
+// wordDriver is a driver of a single word, whose read takes a while to widen the window of a concurrent write
+type wordDriver struct {
+	mutex sync.Mutex
+	value uint16
+}
+
+func (d *wordDriver) read(_ string, _ map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest) []*sdkModels.CommandValue {
+	time.Sleep(20 * time.Millisecond)
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return []*sdkModels.CommandValue{{DeviceResourceName: reqs[0].DeviceResourceName, Type: common.ValueTypeUint16, Value: d.value}}
+}
+
+func (d *wordDriver) write(_ string, _ map[string]models.ProtocolProperties, _ []sdkModels.CommandRequest, params []*sdkModels.CommandValue) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.value = params[0].Value.(uint16)
+	return nil
+}
+
+func bitFieldDic(t *testing.T, driver *wordDriver) *di.Container {
+	mockDriver := &mocks.ProtocolDriver{}
+	mockDriver.On("HandleReadCommands", mock.Anything, mock.Anything, mock.Anything).Return(driver.read, nil)
+	mockDriver.On("HandleWriteCommands", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(driver.write)
+
+	mockDeviceClient := &clientMocks.DeviceClient{}
+	mockDeviceClient.On("DevicesByServiceName", context.Background(), bitFieldService, 0, -1).
+		Return(responses.NewMultiDevicesResponse("", "", http.StatusOK, 0, nil), nil)
+	mockProvisionWatcherClient := &clientMocks.ProvisionWatcherClient{}
+	mockProvisionWatcherClient.On("ProvisionWatchersByServiceName", context.Background(), bitFieldService, 0, -1).
+		Return(responses.NewMultiProvisionWatchersResponse("", "", http.StatusOK, 0, nil), nil)
+	mockMetricsManager := &bootstrapMocks.MetricsManager{}
+	mockMetricsManager.On("Register", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) any {
+			return &config.ConfigurationStruct{Device: config.DeviceInfo{MaxConcurrentCommands: 1}}
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) any {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.DeviceClientName: func(get di.Get) any {
+			return mockDeviceClient
+		},
+		bootstrapContainer.DeviceProfileClientName: func(get di.Get) any {
+			return &clientMocks.DeviceProfileClient{}
+		},
+		bootstrapContainer.ProvisionWatcherClientName: func(get di.Get) any {
+			return mockProvisionWatcherClient
+		},
+		container.ProtocolDriverName: func(get di.Get) any {
+			return mockDriver
+		},
+		bootstrapContainer.MetricsManagerInterfaceName: func(get di.Get) any {
+			return mockMetricsManager
+		},
+		container.CommandSchedulerName: func(get di.Get) any {
+			return container.NewCommandScheduler()
+		},
+	})
+	require.NoError(t, cache.InitCache(bitFieldService, bitFieldService, dic))
+
+	field := func(name string, offset int) models.DeviceResource {
+		return models.DeviceResource{
+			Name:       name,
+			Attributes: map[string]any{sdkCommon.BitFieldWordAttribute: bitFieldWordName, sdkCommon.BitFieldOffsetAttribute: offset},
+			Properties: models.ResourceProperties{ValueType: common.ValueTypeBool, ReadWrite: common.ReadWrite_RW},
+		}
+	}
+	require.NoError(t, cache.Profiles().Add(models.DeviceProfile{
+		Name: bitFieldProfile,
+		DeviceResources: []models.DeviceResource{
+			{Name: bitFieldWordName, Properties: models.ResourceProperties{ValueType: common.ValueTypeUint16, ReadWrite: common.ReadWrite_RW}},
+			field("running", 0),
+			field("alarm", 1),
+		},
+	}))
+	require.NoError(t, cache.Devices().Add(models.Device{Name: bitFieldDevice, ProfileName: bitFieldProfile, ServiceName: bitFieldService}))
+	return dic
+}
+
+func TestWriteDeviceResource_ConcurrentBitFields(t *testing.T) {
+	driver := &wordDriver{}
+	dic := bitFieldDic(t, driver)
+	device, ok := cache.Devices().ForName(bitFieldDevice)
+	require.True(t, ok)
+
+	// the word is read and written within a single command slot, neither write overwrites the bit field of the other
+	var wg sync.WaitGroup
+	for _, name := range []string{"running", "alarm"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, edgexErr := writeDeviceResource(context.Background(), device, name, "", map[string]any{name: "true"}, "", dic)
+			assert.NoError(t, edgexErr)
+		}()
+	}
+	wg.Wait()
+
+	driver.mutex.Lock()
+	defer driver.mutex.Unlock()
+	assert.Equal(t, uint16(0b11), driver.value)
+}
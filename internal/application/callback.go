@@ -1,6 +1,6 @@
 // -*- Mode: Go; indent-tabs-mode: t -*-
 //
-// Copyright (C) 2020-2025 IOTech Ltd
+// Copyright (C) 2020-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -12,6 +12,7 @@ import (
 
 	"github.com/edgexfoundry/device-sdk-go/v4/internal/cache"
 	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/transformer"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
@@ -37,11 +38,14 @@ func UpdateProfile(profileRequest requests.DeviceProfileRequest, dic *di.Contain
 	}
 
 	lc.Debugf("profile %s updated", profileRequest.Profile.Name)
+	transformer.RemoveProfileExpressions(profileRequest.Profile.Name)
 
 	driver := container.ProtocolDriverFrom(dic.Get)
+	transformStates := container.TransformStatesFrom(dic.Get)
 	devices := cache.Devices().All()
 	for _, d := range devices {
 		if d.ProfileName == profileRequest.Profile.Name {
+			transformStates.RemoveDevice(d.Name)
 			if err := driver.UpdateDevice(d.Name, d.Protocols, d.AdminState); err != nil {
 				errMsg := fmt.Sprintf("driver.UpdateDevice callback failed for %s", d.Name)
 				return errors.NewCommonEdgeX(errors.KindServerError, errMsg, err)
@@ -62,6 +66,7 @@ func DeleteProfile(profileName string, dic *di.Container) errors.EdgeX {
 			return errors.NewCommonEdgeX(errors.KindServerError, errMsg, err)
 		}
 		lc.Debugf("profile %s is removed from cache", profileName)
+		transformer.RemoveProfileExpressions(profileName)
 	} else {
 		lc.Warnf("received Profile Deletion System Event for %s, but the profile is still used by some devices", profileName)
 	}
@@ -144,6 +149,7 @@ func UpdateDevice(updateDeviceRequest requests.UpdateDeviceRequest, dic *di.Cont
 		return errors.NewCommonEdgeX(errors.KindServerError, errMsg, edgexErr)
 	}
 	lc.Debugf("device %s updated", device.Name)
+	container.TransformStatesFrom(dic.Get).RemoveDevice(device.Name)
 
 	driver := container.ProtocolDriverFrom(dic.Get)
 	err := driver.UpdateDevice(device.Name, device.Protocols, device.AdminState)
@@ -196,6 +202,10 @@ func DeleteDevice(name string, dic *di.Container) errors.EdgeX {
 
 	reqFailsTracker := container.AllowedRequestFailuresTrackerFrom(dic.Get)
 	reqFailsTracker.Remove(device.Name)
+	if scheduler := container.CommandSchedulerFrom(dic.Get); scheduler != nil {
+		scheduler.Remove(device.Name, dic)
+	}
+	container.TransformStatesFrom(dic.Get).RemoveDevice(device.Name)
 
 	return nil
 }
@@ -311,6 +321,7 @@ func updateAssociatedProfile(profileName string, dic *di.Container) errors.EdgeX
 		errMsg := fmt.Sprintf("failed to to update profile %s in cache, using the original one", profileName)
 		return errors.NewCommonEdgeX(errors.KindServerError, errMsg, err)
 	}
+	transformer.RemoveProfileExpressions(profileName)
 
 	return nil
 }
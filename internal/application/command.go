@@ -1,20 +1,19 @@
 // -*- Mode: Go; indent-tabs-mode: t -*-
 //
-// Copyright (C) 2020-2025 IOTech Ltd
+// Copyright (C) 2020-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
 package application
 
 import (
-	"bytes"
 	"context"
 	"encoding/base64"
-	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"math"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -34,6 +33,12 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
 
+// DroppedReadingsMessage is the message of the response of a GET command whose readings are all dropped by the
+// validation rules of the DeviceResources
+const DroppedReadingsMessage = "all the readings are dropped by the validation rules"
+
+// GetCommand reads the DeviceCommand or DeviceResource. The Event is nil without error if all the readings are
+// dropped by the validation rules, while a ProtocolDriver returning no CommandValue fails the command.
 func GetCommand(ctx context.Context, deviceName string, commandName string, queryParams string, regexCmd bool, dic *di.Container) (res *dtos.Event, err errors.EdgeX) {
 	if deviceName == "" {
 		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "device name is empty", nil)
@@ -57,11 +62,11 @@ func GetCommand(ctx context.Context, deviceName string, commandName string, quer
 
 	_, cmdExist := cache.Profiles().DeviceCommand(device.ProfileName, commandName)
 	if cmdExist {
-		res, err = readDeviceCommand(device, commandName, queryParams, dic)
+		res, err = readDeviceCommand(ctx, device, commandName, queryParams, dic)
 	} else if regexCmd {
-		res, err = readDeviceResourcesRegex(device, commandName, queryParams, dic)
+		res, err = readDeviceResourcesRegex(ctx, device, commandName, queryParams, dic)
 	} else {
-		res, err = readDeviceResource(device, commandName, queryParams, dic)
+		res, err = readDeviceResource(ctx, device, commandName, queryParams, dic)
 	}
 
 	if err != nil {
@@ -75,16 +80,20 @@ func GetCommand(ctx context.Context, deviceName string, commandName string, quer
 	return res, nil
 }
 
-func SetCommand(ctx context.Context, deviceName string, commandName string, queryParams string, requests map[string]any, dic *di.Container) (event *dtos.Event, err errors.EdgeX) {
+// SetCommand writes the DeviceCommand or DeviceResource. The verify parameter is the value of the ds-verify query
+// parameter, and the verification of the written DeviceResources is returned in the verify modes, even if they
+// don't match the written values.
+func SetCommand(ctx context.Context, deviceName string, commandName string, queryParams string, requests map[string]any, verify string, dic *di.Container) (event *dtos.Event, verification []WriteVerification, err errors.EdgeX) {
 	if deviceName == "" {
-		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "device name is empty", nil)
+		return nil, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "device name is empty", nil)
 	}
 	if commandName == "" {
-		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "command is empty", nil)
+		return nil, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "command is empty", nil)
 	}
 	var device models.Device
 	defer func() {
-		if err != nil {
+		// the device has responded if the written values are read back, even if they don't match
+		if err != nil && !isWriteMismatch(err) {
 			DeviceRequestFailed(deviceName, dic)
 		} else {
 			DeviceRequestSucceeded(device, dic)
@@ -93,28 +102,127 @@ func SetCommand(ctx context.Context, deviceName string, commandName string, quer
 
 	device, err = validateServiceAndDeviceState(deviceName, dic)
 	if err != nil {
-		return nil, errors.NewCommonEdgeXWrapper(err)
+		return nil, nil, errors.NewCommonEdgeXWrapper(err)
 	}
 
 	_, cmdExist := cache.Profiles().DeviceCommand(device.ProfileName, commandName)
 	if cmdExist {
-		event, err = writeDeviceCommand(device, commandName, queryParams, requests, dic)
+		event, verification, err = writeDeviceCommand(ctx, device, commandName, queryParams, requests, verify, dic)
 	} else {
-		event, err = writeDeviceResource(device, commandName, queryParams, requests, dic)
+		event, verification, err = writeDeviceResource(ctx, device, commandName, queryParams, requests, verify, dic)
 	}
 
 	if err != nil {
-		return nil, errors.NewCommonEdgeXWrapper(err)
+		return nil, verification, errors.NewCommonEdgeXWrapper(err)
 	}
 
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	lc.Debugf("SET Device Command successfully. Device: %s, Source: %s, %s: %s", deviceName, commandName, common.CorrelationHeader, utils.FromContext(ctx, common.CorrelationHeader))
 
 	cache.Devices().SetLastConnectedByName(deviceName)
+	return event, verification, nil
+}
+
+func readDeviceResource(ctx context.Context, device models.Device, resourceName string, attributes string, dic *di.Container) (*dtos.Event, errors.EdgeX) {
+	reqs, edgexErr := deviceResourceReadRequests(device, resourceName, attributes)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
+	// execute protocol-specific read operation
+	results, edgexErr := handleReadCommands(ctx, device, reqs, dic)
+	if edgexErr != nil {
+		errMsg := fmt.Sprintf("error reading DeviceResource %s for %s", resourceName, device.Name)
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgexErr), errMsg, edgexErr)
+	}
+	if edgexErr = checkReadValues(results, resourceName, device.Name); edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
+	// convert CommandValue to Event
+	configuration := container.ConfigurationFrom(dic.Get)
+	event, err := transformer.CommandValuesToEventDTO(ctx, results, device.Name, resourceName, configuration.Device.DataTransform, dic)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to convert CommandValue to Event", err)
+	}
+
+	return event, nil
+}
+
+func readDeviceResourcesRegex(ctx context.Context, device models.Device, regexResourceName string, attributes string, dic *di.Container) (*dtos.Event, errors.EdgeX) {
+	reqs, edgexErr := deviceResourcesRegexReadRequests(device, regexResourceName, attributes, dic)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
+	// execute protocol-specific read operation
+	results, edgexErr := handleReadCommands(ctx, device, reqs, dic)
+	if edgexErr != nil {
+		errMsg := fmt.Sprintf("error reading Regex DeviceResource(s) %s for %s", regexResourceName, device.Name)
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgexErr), errMsg, edgexErr)
+	}
+	if edgexErr = checkReadValues(results, regexResourceName, device.Name); edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
+	// convert CommandValue to Event
+	configuration := container.ConfigurationFrom(dic.Get)
+	event, err := transformer.CommandValuesToEventDTO(ctx, results, device.Name, regexResourceName, configuration.Device.DataTransform, dic)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to convert CommandValue to Event", err)
+	}
+
+	return event, nil
+}
+
+func readDeviceCommand(ctx context.Context, device models.Device, commandName string, attributes string, dic *di.Container) (*dtos.Event, errors.EdgeX) {
+	reqs, edgexErr := deviceCommandReadRequests(device, commandName, attributes, dic)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
+	// execute protocol-specific read operation
+	results, edgexErr := handleReadCommands(ctx, device, reqs, dic)
+	if edgexErr != nil {
+		errMsg := fmt.Sprintf("error reading DeviceCommand %s for %s", commandName, device.Name)
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgexErr), errMsg, edgexErr)
+	}
+	if edgexErr = checkReadValues(results, commandName, device.Name); edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
+	// convert CommandValue to Event
+	configuration := container.ConfigurationFrom(dic.Get)
+	event, err := transformer.CommandValuesToEventDTO(ctx, results, device.Name, commandName, configuration.Device.DataTransform, dic)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to transform CommandValue to Event", err)
+	}
+
 	return event, nil
 }
 
-func readDeviceResource(device models.Device, resourceName string, attributes string, dic *di.Container) (*dtos.Event, errors.EdgeX) {
+// checkReadValues fails the read if the ProtocolDriver returns no CommandValue, since no Event can be created
+func checkReadValues(results []*sdkModels.CommandValue, sourceName string, deviceName string) errors.EdgeX {
+	if slices.ContainsFunc(results, func(cv *sdkModels.CommandValue) bool { return cv != nil }) {
+		return nil
+	}
+	errMsg := fmt.Sprintf("no value read for %s of device %s", sourceName, deviceName)
+	return errors.NewCommonEdgeX(errors.KindServerError, errMsg, nil)
+}
+
+// readRequests prepares the CommandRequests to read the given DeviceCommand, DeviceResource or
+// regex DeviceResource name in the same precedence as GetCommand.
+func readRequests(device models.Device, sourceName string, attributes string, regexCmd bool, dic *di.Container) ([]sdkModels.CommandRequest, errors.EdgeX) {
+	_, cmdExist := cache.Profiles().DeviceCommand(device.ProfileName, sourceName)
+	if cmdExist {
+		return deviceCommandReadRequests(device, sourceName, attributes, dic)
+	} else if regexCmd {
+		return deviceResourcesRegexReadRequests(device, sourceName, attributes, dic)
+	}
+	return deviceResourceReadRequests(device, sourceName, attributes)
+}
+
+func deviceResourceReadRequests(device models.Device, resourceName string, attributes string) ([]sdkModels.CommandRequest, errors.EdgeX) {
 	dr, ok := cache.Profiles().DeviceResource(device.ProfileName, resourceName)
 	if !ok {
 		errMsg := fmt.Sprintf("DeviceResource %s not found", resourceName)
@@ -126,6 +234,17 @@ func readDeviceResource(device models.Device, resourceName string, attributes st
 		return nil, errors.NewCommonEdgeX(errors.KindNotAllowed, errMsg, nil)
 	}
 
+	// a virtual deviceResource is computed from the values of its sources
+	if transformer.IsVirtualResource(dr) {
+		return appendVirtualSourceRequests(nil, device, dr, attributes)
+	}
+	// a bit field is decoded from the value of its word
+	if field, edgexErr := transformer.ResourceBitField(dr); edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	} else if field != nil {
+		return appendResourceRequest(nil, device, dr, attributes)
+	}
+
 	var req sdkModels.CommandRequest
 	var reqs []sdkModels.CommandRequest
 
@@ -141,25 +260,10 @@ func readDeviceResource(device models.Device, resourceName string, attributes st
 	req.Type = dr.Properties.ValueType
 	reqs = append(reqs, req)
 
-	// execute protocol-specific read operation
-	driver := container.ProtocolDriverFrom(dic.Get)
-	results, err := driver.HandleReadCommands(device.Name, device.Protocols, reqs)
-	if err != nil {
-		errMsg := fmt.Sprintf("error reading DeviceResource %s for %s", dr.Name, device.Name)
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, errMsg, err)
-	}
-
-	// convert CommandValue to Event
-	configuration := container.ConfigurationFrom(dic.Get)
-	event, err := transformer.CommandValuesToEventDTO(results, device.Name, dr.Name, configuration.Device.DataTransform, dic)
-	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to convert CommandValue to Event", err)
-	}
-
-	return event, nil
+	return reqs, nil
 }
 
-func readDeviceResourcesRegex(device models.Device, regexResourceName string, attributes string, dic *di.Container) (*dtos.Event, errors.EdgeX) {
+func deviceResourcesRegexReadRequests(device models.Device, regexResourceName string, attributes string, dic *di.Container) ([]sdkModels.CommandRequest, errors.EdgeX) {
 	regex, err := regexp.CompilePOSIX(regexResourceName)
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to CompilePOSIX resource name", err)
@@ -179,6 +283,14 @@ func readDeviceResourcesRegex(device models.Device, regexResourceName string, at
 			lc.Debugf("DeviceResource %s is marked as write-only, skipping adding to RegEx Read list", dr.Name)
 			continue
 		}
+		if transformer.IsVirtualResource(dr) {
+			lc.Debugf("DeviceResource %s is virtual, skipping adding to RegEx Read list", dr.Name)
+			continue
+		}
+		if _, ok := dr.Attributes[sdkCommon.BitFieldWordAttribute]; ok {
+			lc.Debugf("DeviceResource %s is a bit field, skipping adding to RegEx Read list", dr.Name)
+			continue
+		}
 
 		// prepare CommandRequest
 		var req sdkModels.CommandRequest
@@ -200,25 +312,10 @@ func readDeviceResourcesRegex(device models.Device, regexResourceName string, at
 		return nil, errors.NewCommonEdgeX(errors.KindNotAllowed, errMsg, nil)
 	}
 
-	// execute protocol-specific read operation
-	driver := container.ProtocolDriverFrom(dic.Get)
-	results, err := driver.HandleReadCommands(device.Name, device.Protocols, reqs)
-	if err != nil {
-		errMsg := fmt.Sprintf("error reading Regex DeviceResource(s) %s for %s", regexResourceName, device.Name)
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, errMsg, err)
-	}
-
-	// convert CommandValue to Event
-	configuration := container.ConfigurationFrom(dic.Get)
-	event, err := transformer.CommandValuesToEventDTO(results, device.Name, regexResourceName, configuration.Device.DataTransform, dic)
-	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to convert CommandValue to Event", err)
-	}
-
-	return event, nil
+	return reqs, nil
 }
 
-func readDeviceCommand(device models.Device, commandName string, attributes string, dic *di.Container) (*dtos.Event, errors.EdgeX) {
+func deviceCommandReadRequests(device models.Device, commandName string, attributes string, dic *di.Container) ([]sdkModels.CommandRequest, errors.EdgeX) {
 	dc, ok := cache.Profiles().DeviceCommand(device.ProfileName, commandName)
 	if !ok {
 		errMsg := fmt.Sprintf("DeviceCommand %s not found", commandName)
@@ -237,8 +334,9 @@ func readDeviceCommand(device models.Device, commandName string, attributes stri
 	}
 
 	// prepare CommandRequests
-	reqs := make([]sdkModels.CommandRequest, len(dc.ResourceOperations))
-	for i, op := range dc.ResourceOperations {
+	reqs := make([]sdkModels.CommandRequest, 0, len(dc.ResourceOperations))
+	var virtuals, fields []models.DeviceResource
+	for _, op := range dc.ResourceOperations {
 		drName := op.DeviceResource
 		// check the deviceResource in ResourceOperation actually exist
 		dr, ok := cache.Profiles().DeviceResource(device.ProfileName, drName)
@@ -246,45 +344,113 @@ func readDeviceCommand(device models.Device, commandName string, attributes stri
 			errMsg := fmt.Sprintf("DeviceResource %s in GET commnd %s for %s not defined", drName, dc.Name, device.Name)
 			return nil, errors.NewCommonEdgeX(errors.KindServerError, errMsg, nil)
 		}
+		// the virtual deviceResources are computed from the values of their sources
+		if transformer.IsVirtualResource(dr) {
+			virtuals = append(virtuals, dr)
+			continue
+		}
+		// the bit fields are decoded from the values of their words
+		if _, ok := dr.Attributes[sdkCommon.BitFieldWordAttribute]; ok {
+			fields = append(fields, dr)
+			continue
+		}
 
-		reqs[i].DeviceResourceName = dr.Name
-		reqs[i].Attributes = dr.Attributes
+		var req sdkModels.CommandRequest
+		req.DeviceResourceName = dr.Name
+		req.Attributes = dr.Attributes
 		if attributes != "" {
-			if len(reqs[i].Attributes) <= 0 {
-				reqs[i].Attributes = make(map[string]interface{})
+			if len(req.Attributes) <= 0 {
+				req.Attributes = make(map[string]interface{})
 			}
-			reqs[i].Attributes[sdkCommon.URLRawQuery] = attributes
+			req.Attributes[sdkCommon.URLRawQuery] = attributes
+		}
+		req.Type = dr.Properties.ValueType
+		reqs = append(reqs, req)
+	}
+	for _, dr := range fields {
+		var edgexErr errors.EdgeX
+		reqs, edgexErr = appendResourceRequest(reqs, device, dr, attributes)
+		if edgexErr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+	}
+	for _, dr := range virtuals {
+		var edgexErr errors.EdgeX
+		reqs, edgexErr = appendVirtualSourceRequests(reqs, device, dr, attributes)
+		if edgexErr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgexErr)
 		}
-		reqs[i].Type = dr.Properties.ValueType
 	}
 
-	// execute protocol-specific read operation
-	driver := container.ProtocolDriverFrom(dic.Get)
-	results, err := driver.HandleReadCommands(device.Name, device.Protocols, reqs)
-	if err != nil {
-		errMsg := fmt.Sprintf("error reading DeviceCommand %s for %s", dc.Name, device.Name)
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, errMsg, err)
+	return reqs, nil
+}
+
+// appendVirtualSourceRequests appends the CommandRequests to read the sources of the virtual DeviceResource, unless
+// they are requested already
+func appendVirtualSourceRequests(reqs []sdkModels.CommandRequest, device models.Device, dr models.DeviceResource, attributes string) ([]sdkModels.CommandRequest, errors.EdgeX) {
+	sources, edgexErr := transformer.VirtualSources(device.ProfileName, dr)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	for _, source := range sources {
+		sourceDR, ok := cache.Profiles().DeviceResource(device.ProfileName, source)
+		if !ok || transformer.IsVirtualResource(sourceDR) {
+			errMsg := fmt.Sprintf("source %s of virtual DeviceResource %s for %s is not a DeviceResource read from the device", source, dr.Name, device.Name)
+			return nil, errors.NewCommonEdgeX(errors.KindServerError, errMsg, nil)
+		}
+		reqs, edgexErr = appendResourceRequest(reqs, device, sourceDR, attributes)
+		if edgexErr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+		}
 	}
+	return reqs, nil
+}
 
-	// convert CommandValue to Event
-	event, err := transformer.CommandValuesToEventDTO(results, device.Name, dc.Name, configuration.Device.DataTransform, dic)
-	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to transform CommandValue to Event", err)
+// appendResourceRequest appends the CommandRequest to read the DeviceResource, or the word of the bit field, unless
+// it's requested already
+func appendResourceRequest(reqs []sdkModels.CommandRequest, device models.Device, dr models.DeviceResource, attributes string) ([]sdkModels.CommandRequest, errors.EdgeX) {
+	field, edgexErr := transformer.ResourceBitField(dr)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	if field != nil {
+		if dr, edgexErr = bitFieldWord(device, field); edgexErr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+		if dr.Properties.ReadWrite == common.ReadWrite_W {
+			errMsg := fmt.Sprintf("word %s of bit field %s is marked as write-only", dr.Name, field.Name)
+			return nil, errors.NewCommonEdgeX(errors.KindNotAllowed, errMsg, nil)
+		}
+	}
+	if slices.ContainsFunc(reqs, func(req sdkModels.CommandRequest) bool { return req.DeviceResourceName == dr.Name }) {
+		return reqs, nil
 	}
 
-	return event, nil
+	req := sdkModels.CommandRequest{DeviceResourceName: dr.Name, Attributes: dr.Attributes, Type: dr.Properties.ValueType}
+	if attributes != "" {
+		if len(req.Attributes) <= 0 {
+			req.Attributes = make(map[string]any)
+		}
+		req.Attributes[sdkCommon.URLRawQuery] = attributes
+	}
+	return append(reqs, req), nil
 }
 
-func writeDeviceResource(device models.Device, resourceName string, attributes string, requests map[string]any, dic *di.Container) (*dtos.Event, errors.EdgeX) {
+func writeDeviceResource(ctx context.Context, device models.Device, resourceName string, attributes string, requests map[string]any, verify string, dic *di.Container) (*dtos.Event, []WriteVerification, errors.EdgeX) {
 	dr, ok := cache.Profiles().DeviceResource(device.ProfileName, resourceName)
 	if !ok {
 		errMsg := fmt.Sprintf("DeviceResource %s not found", resourceName)
-		return nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, errMsg, nil)
+		return nil, nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, errMsg, nil)
 	}
 	// check deviceResource is not read-only
 	if dr.Properties.ReadWrite == common.ReadWrite_R {
 		errMsg := fmt.Sprintf("DeviceResource %s is marked as read-only", dr.Name)
-		return nil, errors.NewCommonEdgeX(errors.KindNotAllowed, errMsg, nil)
+		return nil, nil, errors.NewCommonEdgeX(errors.KindNotAllowed, errMsg, nil)
+	}
+	// check deviceResource is not virtual
+	if transformer.IsVirtualResource(dr) {
+		errMsg := fmt.Sprintf("DeviceResource %s is virtual and can't be written", dr.Name)
+		return nil, nil, errors.NewCommonEdgeX(errors.KindNotAllowed, errMsg, nil)
 	}
 
 	// check set parameters contains provided deviceResource
@@ -294,14 +460,19 @@ func writeDeviceResource(device models.Device, resourceName string, attributes s
 			v = dr.Properties.DefaultValue
 		} else {
 			errMsg := fmt.Sprintf("DeviceResource %s not found in request body and no default value defined", dr.Name)
-			return nil, errors.NewCommonEdgeX(errors.KindServerError, errMsg, nil)
+			return nil, nil, errors.NewCommonEdgeX(errors.KindServerError, errMsg, nil)
 		}
 	}
 
+	mode, edgexErr := verifyMode(verify, dr.Attributes[sdkCommon.VerifyWriteParameter])
+	if edgexErr != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
 	// create CommandValue
 	cv, edgexErr := createCommandValueFromDeviceResource(dr, v)
 	if edgexErr != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to create CommandValue", edgexErr)
+		return nil, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to create CommandValue", edgexErr)
 	}
 
 	// prepare CommandRequest
@@ -319,44 +490,72 @@ func writeDeviceResource(device models.Device, resourceName string, attributes s
 	// transform write value
 	configuration := container.ConfigurationFrom(dic.Get)
 	if configuration.Device.DataTransform {
-		edgexErr = transformer.TransformWriteParameter(cv, dr.Properties)
+		edgexErr = transformer.TransformWriteUnit(ctx, cv, device, dr)
+		if edgexErr == nil {
+			edgexErr = transformer.TransformWriteExpression(cv, device.ProfileName, dr)
+		}
+		var calibration *transformer.Calibration
+		if edgexErr == nil {
+			calibration, edgexErr = transformer.ResourceCalibration(device, dr)
+		}
+		if edgexErr == nil {
+			edgexErr = transformer.TransformWriteParameter(cv, dr.Properties, calibration)
+		}
 		if edgexErr != nil {
-			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to transform set parameter", edgexErr)
+			return nil, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to transform set parameter", edgexErr)
 		}
 	}
 
+	// a bit field is written as part of its word
+	cvs := []*sdkModels.CommandValue{cv}
+	ctx, release, edgexErr := holdBitFieldWords(ctx, device, reqs, cvs, dic)
+	if edgexErr != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	defer release()
+	reqs, cvs, edgexErr = mergeBitFields(ctx, device, reqs, cvs, attributes, dic)
+	if edgexErr != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
 	// execute protocol-specific write operation
-	driver := container.ProtocolDriverFrom(dic.Get)
-	err := driver.HandleWriteCommands(device.Name, device.Protocols, reqs, []*sdkModels.CommandValue{cv})
-	if err != nil {
+	verification, edgexErr := writeCommands(ctx, device, reqs, cvs, mode, dic)
+	if edgexErr != nil {
 		errMsg := fmt.Sprintf("error writing DeviceResource %s for %s", dr.Name, device.Name)
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, errMsg, err)
+		return nil, verification, errors.NewCommonEdgeX(errors.Kind(edgexErr), errMsg, edgexErr)
 	}
 
 	// Updated resource value will be published to MessageBus as long as it's not write-only
 	if dr.Properties.ReadWrite != common.ReadWrite_W {
-		return transformer.CommandValuesToEventDTO([]*sdkModels.CommandValue{cv}, device.Name, resourceName, configuration.Device.DataTransform, dic)
+		event, edgexErr := transformer.CommandValuesToEventDTO(transformer.WithWrittenValues(ctx), cvs, device.Name, resourceName,
+			configuration.Device.DataTransform, dic)
+		return event, verification, edgexErr
 	}
 
-	return nil, nil
+	return nil, verification, nil
 }
 
-func writeDeviceCommand(device models.Device, commandName string, attributes string, requests map[string]any, dic *di.Container) (*dtos.Event, errors.EdgeX) {
+func writeDeviceCommand(ctx context.Context, device models.Device, commandName string, attributes string, requests map[string]any, verify string, dic *di.Container) (*dtos.Event, []WriteVerification, errors.EdgeX) {
 	dc, ok := cache.Profiles().DeviceCommand(device.ProfileName, commandName)
 	if !ok {
 		errMsg := fmt.Sprintf("DeviceCommand %s not found", commandName)
-		return nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, errMsg, nil)
+		return nil, nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, errMsg, nil)
 	}
 	// check deviceCommand is not read-only
 	if dc.ReadWrite == common.ReadWrite_R {
 		errMsg := fmt.Sprintf("DeviceCommand %s is marked as read-only", dc.Name)
-		return nil, errors.NewCommonEdgeX(errors.KindNotAllowed, errMsg, nil)
+		return nil, nil, errors.NewCommonEdgeX(errors.KindNotAllowed, errMsg, nil)
 	}
 	// check ResourceOperation count does not exceed MaxCmdOps defined in configuration
 	configuration := container.ConfigurationFrom(dic.Get)
 	if len(dc.ResourceOperations) > configuration.Device.MaxCmdOps {
 		errMsg := fmt.Sprintf("SET command %s exceed device %s MaxCmdOps (%d)", dc.Name, device.Name, configuration.Device.MaxCmdOps)
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, errMsg, nil)
+		return nil, nil, errors.NewCommonEdgeX(errors.KindServerError, errMsg, nil)
+	}
+
+	mode, edgexErr := verifyMode(verify, dc.Tags[sdkCommon.VerifyWriteParameter])
+	if edgexErr != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(edgexErr)
 	}
 
 	// create CommandValues
@@ -367,7 +566,15 @@ func writeDeviceCommand(device models.Device, commandName string, attributes str
 		dr, ok := cache.Profiles().DeviceResource(device.ProfileName, drName)
 		if !ok {
 			errMsg := fmt.Sprintf("DeviceResource %s in SET commnd %s for %s not defined", drName, dc.Name, device.Name)
-			return nil, errors.NewCommonEdgeX(errors.KindServerError, errMsg, nil)
+			return nil, nil, errors.NewCommonEdgeX(errors.KindServerError, errMsg, nil)
+		}
+		// the virtual deviceResources are not written, but reported along with the written ones
+		if transformer.IsVirtualResource(dr) {
+			if _, ok := requests[dr.Name]; ok {
+				errMsg := fmt.Sprintf("DeviceResource %s is virtual and can't be written", dr.Name)
+				return nil, nil, errors.NewCommonEdgeX(errors.KindNotAllowed, errMsg, nil)
+			}
+			continue
 		}
 
 		// check request body contains the deviceResource
@@ -377,9 +584,12 @@ func writeDeviceCommand(device models.Device, commandName string, attributes str
 				value = ro.DefaultValue
 			} else if dr.Properties.DefaultValue != "" {
 				value = dr.Properties.DefaultValue
+			} else if _, isField := dr.Attributes[sdkCommon.BitFieldWordAttribute]; isField {
+				// the bit fields which are not written keep their current value
+				continue
 			} else {
 				errMsg := fmt.Sprintf("DeviceResource %s not found in request body and no default value defined", dr.Name)
-				return nil, errors.NewCommonEdgeX(errors.KindServerError, errMsg, nil)
+				return nil, nil, errors.NewCommonEdgeX(errors.KindServerError, errMsg, nil)
 			}
 		}
 
@@ -399,10 +609,15 @@ func writeDeviceCommand(device models.Device, commandName string, attributes str
 		if err == nil {
 			cvs = append(cvs, cv)
 		} else {
-			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to create CommandValue", err)
+			return nil, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to create CommandValue", err)
 		}
 	}
 
+	if len(cvs) == 0 {
+		errMsg := fmt.Sprintf("DeviceCommand %s has no DeviceResource to write", dc.Name)
+		return nil, nil, errors.NewCommonEdgeX(errors.KindNotAllowed, errMsg, nil)
+	}
+
 	// prepare CommandRequests
 	reqs := make([]sdkModels.CommandRequest, len(cvs))
 	for i, cv := range cvs {
@@ -420,27 +635,49 @@ func writeDeviceCommand(device models.Device, commandName string, attributes str
 
 		// transform write value
 		if configuration.Device.DataTransform {
-			err := transformer.TransformWriteParameter(cv, dr.Properties)
+			err := transformer.TransformWriteUnit(ctx, cv, device, dr)
+			if err == nil {
+				err = transformer.TransformWriteExpression(cv, device.ProfileName, dr)
+			}
+			var calibration *transformer.Calibration
+			if err == nil {
+				calibration, err = transformer.ResourceCalibration(device, dr)
+			}
+			if err == nil {
+				err = transformer.TransformWriteParameter(cv, dr.Properties, calibration)
+			}
 			if err != nil {
-				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to transform set parameter", err)
+				return nil, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to transform set parameter", err)
 			}
 		}
 	}
 
+	// the bit fields are written as part of their words
+	ctx, release, edgexErr := holdBitFieldWords(ctx, device, reqs, cvs, dic)
+	if edgexErr != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	defer release()
+	reqs, cvs, edgexErr = mergeBitFields(ctx, device, reqs, cvs, attributes, dic)
+	if edgexErr != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
 	// execute protocol-specific write operation
-	driver := container.ProtocolDriverFrom(dic.Get)
-	err := driver.HandleWriteCommands(device.Name, device.Protocols, reqs, cvs)
-	if err != nil {
+	verification, edgexErr := writeCommands(ctx, device, reqs, cvs, mode, dic)
+	if edgexErr != nil {
 		errMsg := fmt.Sprintf("error writing DeviceCommand %s for %s", dc.Name, device.Name)
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, errMsg, err)
+		return nil, verification, errors.NewCommonEdgeX(errors.Kind(edgexErr), errMsg, edgexErr)
 	}
 
 	// Updated resource(s) value will be published to MessageBus as long as they're not write-only
 	if dc.ReadWrite != common.ReadWrite_W {
-		return transformer.CommandValuesToEventDTO(cvs, device.Name, commandName, configuration.Device.DataTransform, dic)
+		event, edgexErr := transformer.CommandValuesToEventDTO(transformer.WithWrittenValues(ctx), cvs, device.Name, commandName,
+			configuration.Device.DataTransform, dic)
+		return event, verification, edgexErr
 	}
 
-	return nil, nil
+	return nil, verification, nil
 }
 
 func validateServiceAndDeviceState(deviceName string, dic *di.Container) (models.Device, errors.EdgeX) {
@@ -687,14 +924,10 @@ func createCommandValueFromDeviceResource(dr models.DeviceResource, value interf
 		var decodedToBytes []byte
 		decodedToBytes, err = base64.StdEncoding.DecodeString(v)
 		if err == nil {
-			var val float32
-			val, err = float32FromBytes(decodedToBytes)
-			if err != nil {
-				break
-			} else if math.IsNaN(float64(val)) {
-				err = fmt.Errorf("fail to parse %v to float32, unexpected result %v", v, val)
-			} else {
-				result, err = sdkModels.NewCommandValue(dr.Name, common.ValueTypeFloat32, val)
+			// the raw bytes are decoded in the byte order of the DeviceResource
+			result, err = sdkModels.NewCommandValueFromBytes(dr.Name, common.ValueTypeFloat32, decodedToBytes, dr.Attributes)
+			if err == nil && math.IsNaN(float64(result.Value.(float32))) {
+				err = fmt.Errorf("fail to parse %v to float32, unexpected result %v", v, result.Value)
 			}
 		}
 	case common.ValueTypeFloat32Array:
@@ -721,13 +954,10 @@ func createCommandValueFromDeviceResource(dr models.DeviceResource, value interf
 		var decodedToBytes []byte
 		decodedToBytes, err = base64.StdEncoding.DecodeString(v)
 		if err == nil {
-			val, err = float64FromBytes(decodedToBytes)
-			if err != nil {
-				break
-			} else if math.IsNaN(val) {
-				err = fmt.Errorf("fail to parse %v to float64, unexpected result %v", v, val)
-			} else {
-				result, err = sdkModels.NewCommandValue(dr.Name, common.ValueTypeFloat64, val)
+			// the raw bytes are decoded in the byte order of the DeviceResource
+			result, err = sdkModels.NewCommandValueFromBytes(dr.Name, common.ValueTypeFloat64, decodedToBytes, dr.Attributes)
+			if err == nil && math.IsNaN(result.Value.(float64)) {
+				err = fmt.Errorf("fail to parse %v to float64, unexpected result %v", v, result.Value)
 			}
 		}
 	case common.ValueTypeFloat64Array:
@@ -749,15 +979,3 @@ func createCommandValueFromDeviceResource(dr models.DeviceResource, value interf
 	}
 	return result, nil
 }
-
-func float32FromBytes(numericValue []byte) (res float32, err error) {
-	reader := bytes.NewReader(numericValue)
-	err = binary.Read(reader, binary.BigEndian, &res)
-	return
-}
-
-func float64FromBytes(numericValue []byte) (res float64, err error) {
-	reader := bytes.NewReader(numericValue)
-	err = binary.Read(reader, binary.BigEndian, &res)
-	return
-}
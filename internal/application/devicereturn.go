@@ -1,6 +1,6 @@
 // -*- Mode: Go; indent-tabs-mode: t -*-
 //
-// Copyright (C) 2025 IOTech Ltd
+// Copyright (C) 2025-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -95,6 +95,10 @@ func DeviceRequestFailed(deviceName string, dic *di.Container) {
 }
 
 func DeviceRequestSucceeded(d models.Device, dic *di.Container) {
+	if observer, ok := dic.Get(container.AutoEventManagerName).(container.DeviceRequestObserver); ok {
+		observer.DeviceRequestSucceeded(d.Name)
+	}
+
 	config := container.ConfigurationFrom(dic.Get)
 	reqFailsTracker := container.AllowedRequestFailuresTrackerFrom(dic.Get)
 	if config.Device.AllowedFails > 0 && reqFailsTracker.Value(d.Name) < int(config.Device.AllowedFails) {
@@ -0,0 +1,171 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/spf13/cast"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+type readResult struct {
+	values []*sdkModels.CommandValue
+	err    error
+}
+
+// handleReadCommands executes the protocol-specific read operation within the command deadline,
+// once the device command scheduler grants a slot.
+// The ContextProtocolDriver is preferred when implemented, otherwise the ProtocolDriver is invoked
+// and abandoned once the deadline expires.
+func handleReadCommands(ctx context.Context, device models.Device, reqs []sdkModels.CommandRequest, dic *di.Container) ([]*sdkModels.CommandValue, errors.EdgeX) {
+	ctx, cancel := commandContext(ctx, reqs, dic)
+	defer cancel()
+
+	release, err := acquireCommandSlot(ctx, device, dic)
+	if err != nil {
+		return nil, driverError(ctx, fmt.Errorf("failed to wait for command slot of device %s: %w", device.Name, err))
+	}
+
+	if ctxDriver := container.ContextProtocolDriverFrom(dic.Get); ctxDriver != nil {
+		defer release()
+		results, err := ctxDriver.HandleReadCommandsWithContext(ctx, device.Name, device.Protocols, reqs)
+		return results, driverError(ctx, err)
+	}
+
+	driver := container.ProtocolDriverFrom(dic.Get)
+	if ctx.Done() == nil {
+		defer release()
+		results, err := driver.HandleReadCommands(device.Name, device.Protocols, reqs)
+		return results, driverError(ctx, err)
+	}
+
+	// the command slot is held until the driver returns, even if the caller has given up waiting
+	done := make(chan readResult, 1)
+	go func() {
+		defer release()
+		results, err := driver.HandleReadCommands(device.Name, device.Protocols, reqs)
+		done <- readResult{values: results, err: err}
+	}()
+
+	select {
+	case res := <-done:
+		return res.values, driverError(ctx, res.err)
+	case <-ctx.Done():
+		return nil, driverError(ctx, ctx.Err())
+	}
+}
+
+// handleWriteCommands executes the protocol-specific write operation within the command deadline,
+// once the device command scheduler grants a slot.
+// The ContextProtocolDriver is preferred when implemented, otherwise the ProtocolDriver is invoked
+// and abandoned once the deadline expires.
+func handleWriteCommands(ctx context.Context, device models.Device, reqs []sdkModels.CommandRequest, params []*sdkModels.CommandValue, dic *di.Container) errors.EdgeX {
+	ctx, cancel := commandContext(ctx, reqs, dic)
+	defer cancel()
+
+	release, err := acquireCommandSlot(ctx, device, dic)
+	if err != nil {
+		return driverError(ctx, fmt.Errorf("failed to wait for command slot of device %s: %w", device.Name, err))
+	}
+
+	if ctxDriver := container.ContextProtocolDriverFrom(dic.Get); ctxDriver != nil {
+		defer release()
+		err := ctxDriver.HandleWriteCommandsWithContext(ctx, device.Name, device.Protocols, reqs, params)
+		return driverError(ctx, err)
+	}
+
+	driver := container.ProtocolDriverFrom(dic.Get)
+	if ctx.Done() == nil {
+		defer release()
+		return driverError(ctx, driver.HandleWriteCommands(device.Name, device.Protocols, reqs, params))
+	}
+
+	// the command slot is held until the driver returns, even if the caller has given up waiting
+	done := make(chan error, 1)
+	go func() {
+		defer release()
+		done <- driver.HandleWriteCommands(device.Name, device.Protocols, reqs, params)
+	}()
+
+	select {
+	case err := <-done:
+		return driverError(ctx, err)
+	case <-ctx.Done():
+		return driverError(ctx, ctx.Err())
+	}
+}
+
+// commandContext derives the context of a single driver call, bounded by the Device.CommandTimeout
+// configuration or the largest timeout attribute of the requested DeviceResources.
+func commandContext(ctx context.Context, reqs []sdkModels.CommandRequest, dic *di.Container) (context.Context, context.CancelFunc) {
+	timeout := commandTimeout(reqs, dic)
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+func commandTimeout(reqs []sdkModels.CommandRequest, dic *di.Container) time.Duration {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	configuration := container.ConfigurationFrom(dic.Get)
+
+	var timeout time.Duration
+	if configuration.Device.CommandTimeout != "" {
+		d, err := time.ParseDuration(configuration.Device.CommandTimeout)
+		if err != nil {
+			lc.Warnf("failed to parse Device.CommandTimeout %s, no deadline applied: %v", configuration.Device.CommandTimeout, err)
+		} else {
+			timeout = d
+		}
+	}
+
+	overridden := false
+	var resourceTimeout time.Duration
+	for _, req := range reqs {
+		v, ok := req.Attributes[sdkCommon.CommandTimeoutAttribute]
+		if !ok {
+			continue
+		}
+		d, err := time.ParseDuration(cast.ToString(v))
+		if err != nil {
+			lc.Warnf("failed to parse %s attribute of DeviceResource %s: %v", sdkCommon.CommandTimeoutAttribute, req.DeviceResourceName, err)
+			continue
+		}
+		overridden = true
+		if d > resourceTimeout {
+			resourceTimeout = d
+		}
+	}
+	if overridden {
+		return resourceTimeout
+	}
+	return timeout
+}
+
+// driverError converts the error returned by the ProtocolDriver to EdgeX error, and reports
+// the KindServiceUnavailable error kind (503) when the command deadline has been exceeded.
+func driverError(ctx context.Context, err error) errors.EdgeX {
+	if err == nil {
+		return nil
+	}
+	if ctx.Err() == context.DeadlineExceeded {
+		deadline, _ := ctx.Deadline()
+		errMsg := fmt.Sprintf("command deadline %s exceeded", deadline.Format(time.RFC3339Nano))
+		return errors.NewCommonEdgeX(errors.KindServiceUnavailable, errMsg, err)
+	}
+	return errors.NewCommonEdgeX(errors.KindServerError, "", err)
+}
@@ -0,0 +1,97 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/spf13/cast"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+type heldCommandSlotKey struct{}
+
+// heldCommandSlot is a command slot of the device held for a sequence of driver calls, like the read, write,
+// read back and restore of a verified write. The slot is released once the sequence and all its calls are done,
+// including the calls abandoned after their deadline.
+type heldCommandSlot struct {
+	deviceName string
+	mutex      sync.Mutex
+	holders    int
+	release    func()
+}
+
+// hold adds a holder of the slot, the returned function removes it
+func (s *heldCommandSlot) hold() func() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.holders++
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.mutex.Lock()
+			defer s.mutex.Unlock()
+			s.holders--
+			if s.holders == 0 {
+				s.release()
+			}
+		})
+	}
+}
+
+// holdCommandSlot acquires a command slot of the device for all the driver calls made with the returned context.
+// The returned function must be called to release the slot once the sequence of calls is done.
+func holdCommandSlot(ctx context.Context, device models.Device, reqs []sdkModels.CommandRequest, dic *di.Container) (context.Context, func(), errors.EdgeX) {
+	waitCtx, cancel := commandContext(ctx, reqs, dic)
+	defer cancel()
+	release, err := acquireCommandSlot(waitCtx, device, dic)
+	if err != nil {
+		return nil, nil, driverError(waitCtx, fmt.Errorf("failed to wait for command slot of device %s: %w", device.Name, err))
+	}
+	slot := &heldCommandSlot{deviceName: device.Name, release: release}
+	return context.WithValue(ctx, heldCommandSlotKey{}, slot), slot.hold(), nil
+}
+
+// acquireCommandSlot blocks until the command scheduler grants a slot to the device or the context is done, unless
+// the context holds a slot of the device. The returned function must be called to release the slot once the driver
+// call returns.
+func acquireCommandSlot(ctx context.Context, device models.Device, dic *di.Container) (func(), error) {
+	if slot, ok := ctx.Value(heldCommandSlotKey{}).(*heldCommandSlot); ok && slot.deviceName == device.Name {
+		return slot.hold(), nil
+	}
+	scheduler := container.CommandSchedulerFrom(dic.Get)
+	if scheduler == nil {
+		return func() {}, nil
+	}
+	return scheduler.Acquire(ctx, device.Name, maxConcurrentCommands(device, dic), dic)
+}
+
+// maxConcurrentCommands returns the command concurrency limit of the device. The ds-maxconcurrentcommands
+// property defined in the device Properties or Protocols takes precedence over Device.MaxConcurrentCommands.
+func maxConcurrentCommands(device models.Device, dic *di.Container) int {
+	if v, ok := device.Properties[sdkCommon.MaxConcurrentCommandsProperty]; ok {
+		if limit, err := cast.ToIntE(v); err == nil {
+			return limit
+		}
+	}
+	for _, protocol := range device.Protocols {
+		if v, ok := protocol[sdkCommon.MaxConcurrentCommandsProperty]; ok {
+			if limit, err := cast.ToIntE(v); err == nil {
+				return limit
+			}
+		}
+	}
+	return container.ConfigurationFrom(dic.Get).Device.MaxConcurrentCommands
+}
@@ -0,0 +1,125 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/config"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+)
+
+func schedulerDic(maxConcurrentCommands int) *di.Container {
+	return di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) any {
+			return &config.ConfigurationStruct{
+				Device: config.DeviceInfo{
+					MaxConcurrentCommands: maxConcurrentCommands,
+				},
+			}
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) any {
+			return logger.NewMockClient()
+		},
+	})
+}
+
+func TestMaxConcurrentCommands(t *testing.T) {
+	tests := []struct {
+		name          string
+		configLimit   int
+		device        models.Device
+		expectedLimit int
+	}{
+		{"unlimited", 0, models.Device{Name: "d"}, 0},
+		{"configuration", 2, models.Device{Name: "d"}, 2},
+		{"device properties", 2, models.Device{Name: "d", Properties: map[string]any{sdkCommon.MaxConcurrentCommandsProperty: "1"}}, 1},
+		{"device protocols", 0, models.Device{Name: "d", Protocols: map[string]models.ProtocolProperties{"modbus-rtu": {sdkCommon.MaxConcurrentCommandsProperty: 1}}}, 1},
+		{"invalid device property", 3, models.Device{Name: "d", Properties: map[string]any{sdkCommon.MaxConcurrentCommandsProperty: "x"}}, 3},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := schedulerDic(testCase.configLimit)
+			assert.Equal(t, testCase.expectedLimit, maxConcurrentCommands(testCase.device, dic))
+		})
+	}
+}
+
+func TestAcquireCommandSlot(t *testing.T) {
+	device := models.Device{Name: "test-device"}
+
+	// the commands are not scheduled without a command scheduler in the DIC
+	dic := schedulerDic(1)
+	release, err := acquireCommandSlot(context.Background(), device, dic)
+	require.NoError(t, err)
+	defer release()
+	otherRelease, err := acquireCommandSlot(context.Background(), device, dic)
+	require.NoError(t, err)
+	otherRelease()
+
+	dic.Update(di.ServiceConstructorMap{
+		container.CommandSchedulerName: func(get di.Get) any {
+			return container.NewCommandScheduler()
+		},
+	})
+	release, err = acquireCommandSlot(context.Background(), device, dic)
+	require.NoError(t, err)
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = acquireCommandSlot(ctx, device, dic)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestHoldCommandSlot(t *testing.T) {
+	device := models.Device{Name: "test-device"}
+	dic := schedulerDic(1)
+	dic.Update(di.ServiceConstructorMap{
+		container.CommandSchedulerName: func(get di.Get) any {
+			return container.NewCommandScheduler()
+		},
+	})
+
+	heldCtx, release, edgexErr := holdCommandSlot(context.Background(), device, nil, dic)
+	require.NoError(t, edgexErr)
+
+	// the driver calls made with the context share the held slot
+	callRelease, err := acquireCommandSlot(heldCtx, device, dic)
+	require.NoError(t, err)
+	otherCallRelease, err := acquireCommandSlot(heldCtx, device, dic)
+	require.NoError(t, err)
+	otherCallRelease()
+
+	// the other commands of the device wait for the slot
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = acquireCommandSlot(ctx, device, dic)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// the slot is held until the sequence and all its driver calls are done
+	release()
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = acquireCommandSlot(ctx, device, dic)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	callRelease()
+	otherRelease, err := acquireCommandSlot(context.Background(), device, dic)
+	require.NoError(t, err)
+	otherRelease()
+}
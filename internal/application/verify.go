@@ -0,0 +1,235 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/spf13/cast"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/cache"
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+// statuses of WriteVerification
+const (
+	WriteVerified = "Verified"
+	WriteMismatch = "Mismatch"
+	// WriteSkipped is the status of a write-only DeviceResource, which can't be read back
+	WriteSkipped = "Skipped"
+)
+
+// WriteVerification is the outcome of reading back a written DeviceResource. The values are the raw
+// device values, i.e. after the write transforms and mappings and before the read ones.
+type WriteVerification struct {
+	ResourceName string `json:"resourceName"`
+	Status       string `json:"status"`
+	Expected     string `json:"expected,omitempty"`
+	Actual       string `json:"actual,omitempty"`
+	// Restored reports that the previous value was written back because the write was not verified
+	Restored bool   `json:"restored,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+// SetCommandResponse reports the outcome of a SET command, including the verification of the written
+// DeviceResources in the verify modes.
+type SetCommandResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	Verification           []WriteVerification `json:"verification,omitempty"`
+}
+
+// verifyMode resolves the write verification mode from the ds-verify query parameter, which overrides
+// the ds-verify DeviceResource attribute or DeviceCommand tag. It returns an empty string if the write is not verified.
+func verifyMode(param string, setting any) (string, errors.EdgeX) {
+	mode := param
+	if mode == "" && setting != nil {
+		mode = cast.ToString(setting)
+	}
+	mode = strings.ToLower(mode)
+	switch mode {
+	case "", common.ValueFalse:
+		return "", nil
+	case common.ValueTrue, sdkCommon.VerifyWriteRestore:
+		return mode, nil
+	default:
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("invalid %s value '%s', expected true, false or %s", sdkCommon.VerifyWriteParameter, mode, sdkCommon.VerifyWriteRestore), nil)
+	}
+}
+
+// writeCommands executes the write and, in the verify modes, reads back the written DeviceResources and compares
+// them with the written values. In the restore mode the previous values are read before the write, and written
+// back if any DeviceResource doesn't match. A mismatch is reported as a KindStatusConflict error along with the
+// verification, and a failed read-back as an error of its own kind. The sequence of driver calls holds a single
+// command slot of the device, so that no other command of the device is executed in between.
+func writeCommands(ctx context.Context, device models.Device, reqs []sdkModels.CommandRequest, cvs []*sdkModels.CommandValue,
+	mode string, dic *di.Container) ([]WriteVerification, errors.EdgeX) {
+	if mode == "" {
+		return nil, handleWriteCommands(ctx, device, reqs, cvs, dic)
+	}
+
+	ctx, release, err := holdCommandSlot(ctx, device, reqs, dic)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// the write-only DeviceResources are written but not read back
+	readReqs := make([]sdkModels.CommandRequest, 0, len(reqs))
+	for _, req := range reqs {
+		if dr, ok := cache.Profiles().DeviceResource(device.ProfileName, req.DeviceResourceName); ok && dr.Properties.ReadWrite != common.ReadWrite_W {
+			readReqs = append(readReqs, req)
+		}
+	}
+
+	var previous []*sdkModels.CommandValue
+	if mode == sdkCommon.VerifyWriteRestore && len(readReqs) > 0 {
+		var err errors.EdgeX
+		previous, err = handleReadCommands(ctx, device, readReqs, dic)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.Kind(err), "failed to read the values to restore before writing", err)
+		}
+	}
+
+	if err := handleWriteCommands(ctx, device, reqs, cvs, dic); err != nil {
+		return nil, err
+	}
+
+	var actual []*sdkModels.CommandValue
+	var readErr errors.EdgeX
+	if len(readReqs) > 0 {
+		actual, readErr = handleReadCommands(ctx, device, readReqs, dic)
+	}
+	verification, mismatches := compareWrittenValues(cvs, readReqs, actual, readErr)
+	if mismatches == 0 {
+		return verification, nil
+	}
+
+	if len(previous) > 0 {
+		restoreErr := restorePreviousValues(ctx, device, readReqs, previous, dic)
+		for i := range verification {
+			if verification[i].Status == WriteSkipped {
+				continue
+			}
+			if restoreErr != nil {
+				verification[i].Message = strings.TrimSpace(fmt.Sprintf("%s failed to restore the previous value: %v", verification[i].Message, restoreErr))
+			} else {
+				verification[i].Restored = true
+			}
+		}
+	}
+	if readErr != nil {
+		return verification, errors.NewCommonEdgeX(errors.Kind(readErr),
+			fmt.Sprintf("failed to read back the written DeviceResource(s) of device %s", device.Name), readErr)
+	}
+	return verification, errors.NewCommonEdgeX(errors.KindStatusConflict,
+		fmt.Sprintf("%d DeviceResource(s) of device %s don't match the written values", mismatches, device.Name), nil)
+}
+
+// isWriteMismatch returns whether the error of writeCommands reports the written values read back which don't
+// match, i.e. the device responded to the write and its verification
+func isWriteMismatch(err errors.EdgeX) bool {
+	return errors.Kind(err) == errors.KindStatusConflict
+}
+
+func compareWrittenValues(written []*sdkModels.CommandValue, readReqs []sdkModels.CommandRequest, actual []*sdkModels.CommandValue,
+	readErr errors.EdgeX) ([]WriteVerification, int) {
+	readable := make(map[string]bool, len(readReqs))
+	for _, req := range readReqs {
+		readable[req.DeviceResourceName] = true
+	}
+	actualValues := make(map[string]*sdkModels.CommandValue, len(actual))
+	for _, cv := range actual {
+		if cv != nil {
+			actualValues[cv.DeviceResourceName] = cv
+		}
+	}
+
+	verification := make([]WriteVerification, len(written))
+	mismatches := 0
+	for i, cv := range written {
+		result := WriteVerification{ResourceName: cv.DeviceResourceName, Expected: cv.ValueToString()}
+		switch a, ok := actualValues[cv.DeviceResourceName]; {
+		case !readable[cv.DeviceResourceName]:
+			result.Status = WriteSkipped
+			result.Message = "write-only DeviceResource is not read back"
+		case readErr != nil:
+			result.Status = WriteMismatch
+			result.Message = fmt.Sprintf("failed to read back: %v", readErr)
+		case !ok:
+			result.Status = WriteMismatch
+			result.Message = "no value read back"
+		default:
+			result.Actual = a.ValueToString()
+			result.Status = WriteVerified
+			if !commandValuesEqual(cv, a) {
+				result.Status = WriteMismatch
+			}
+		}
+		if result.Status == WriteMismatch {
+			mismatches++
+		}
+		verification[i] = result
+	}
+	return verification, mismatches
+}
+
+// commandValuesEqual compares the written and read back values, the floats within the precision of their type
+func commandValuesEqual(written *sdkModels.CommandValue, actual *sdkModels.CommandValue) bool {
+	switch written.Type {
+	case common.ValueTypeFloat32, common.ValueTypeFloat64:
+		expected, err := cast.ToFloat64E(written.Value)
+		if err != nil {
+			break
+		}
+		value, err := cast.ToFloat64E(actual.Value)
+		if err != nil {
+			return false
+		}
+		epsilon := 1e-12
+		if written.Type == common.ValueTypeFloat32 {
+			epsilon = 1e-6
+		}
+		return math.Abs(expected-value) <= epsilon*math.Max(1, math.Max(math.Abs(expected), math.Abs(value)))
+	case common.ValueTypeBinary:
+		expected, err1 := written.BinaryValue()
+		value, err2 := actual.BinaryValue()
+		if err1 == nil && err2 == nil {
+			return bytes.Equal(expected, value)
+		}
+	}
+	return written.ValueToString() == actual.ValueToString()
+}
+
+func restorePreviousValues(ctx context.Context, device models.Device, readReqs []sdkModels.CommandRequest, previous []*sdkModels.CommandValue,
+	dic *di.Container) errors.EdgeX {
+	reqs := make([]sdkModels.CommandRequest, 0, len(previous))
+	cvs := make([]*sdkModels.CommandValue, 0, len(previous))
+	for _, cv := range previous {
+		if cv == nil {
+			continue
+		}
+		for _, req := range readReqs {
+			if req.DeviceResourceName == cv.DeviceResourceName {
+				reqs = append(reqs, req)
+				cvs = append(cvs, cv)
+				break
+			}
+		}
+	}
+	return handleWriteCommands(ctx, device, reqs, cvs, dic)
+}
@@ -0,0 +1,73 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+func TestVerifyMode(t *testing.T) {
+	tests := []struct {
+		name          string
+		param         string
+		setting       any
+		expectedMode  string
+		expectedError bool
+	}{
+		{"not verified by default", "", nil, "", false},
+		{"verified by the setting", "", true, common.ValueTrue, false},
+		{"restore by the setting", "", "Restore", sdkCommon.VerifyWriteRestore, false},
+		{"query parameter overrides the setting", common.ValueFalse, true, "", false},
+		{"query parameter enables the verification", common.ValueTrue, nil, common.ValueTrue, false},
+		{"invalid mode", "maybe", nil, "", true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			mode, err := verifyMode(testCase.param, testCase.setting)
+			if testCase.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedMode, mode)
+		})
+	}
+}
+
+func TestCommandValuesEqual(t *testing.T) {
+	tests := []struct {
+		name      string
+		valueType string
+		written   any
+		actual    any
+		expected  bool
+	}{
+		{"equal strings", common.ValueTypeString, "on", "on", true},
+		{"different strings", common.ValueTypeString, "on", "off", false},
+		{"equal integers", common.ValueTypeInt16, int16(-3), int16(-3), true},
+		{"float32 within precision", common.ValueTypeFloat32, float32(20.5), float32(20.500001), true},
+		{"different float64", common.ValueTypeFloat64, 20.5, 20.6, false},
+		{"equal binaries", common.ValueTypeBinary, []byte{1, 2}, []byte{1, 2}, true},
+		{"different binaries", common.ValueTypeBinary, []byte{1, 2}, []byte{1, 3}, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			written, err := sdkModels.NewCommandValue("resource", testCase.valueType, testCase.written)
+			require.NoError(t, err)
+			actual, err := sdkModels.NewCommandValue("resource", testCase.valueType, testCase.actual)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, commandValuesEqual(written, actual))
+		})
+	}
+}
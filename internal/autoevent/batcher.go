@@ -0,0 +1,89 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package autoevent
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/application"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/transformer"
+)
+
+// readBatcher coalesces the reads of the AutoEvents of a device which fire within the same window
+// into a single application.ReadSources call, so that the ProtocolDriver receives batched requests.
+type readBatcher struct {
+	ctx        context.Context
+	deviceName string
+	window     time.Duration
+	dic        *di.Container
+	mutex      sync.Mutex
+	sources    []string
+	// tick is the scheduled time of the AutoEvent which opened the batch
+	tick    time.Time
+	pending map[string][]chan application.SourceReading
+}
+
+func newReadBatcher(ctx context.Context, deviceName string, window time.Duration, dic *di.Container) *readBatcher {
+	return &readBatcher{
+		ctx:        ctx,
+		deviceName: deviceName,
+		window:     window,
+		dic:        dic,
+		pending:    make(map[string][]chan application.SourceReading),
+	}
+}
+
+// read queues the source to the current batch and waits for its reading. The first source
+// queued to an empty batch schedules the batch to be flushed once the window elapses.
+func (b *readBatcher) read(ctx context.Context, sourceName string) (*dtos.Event, errors.EdgeX) {
+	result := make(chan application.SourceReading, 1)
+
+	b.mutex.Lock()
+	if len(b.sources) == 0 {
+		b.tick, _ = transformer.AutoEventTick(ctx)
+		time.AfterFunc(b.window, b.flush)
+	}
+	if _, ok := b.pending[sourceName]; !ok {
+		b.sources = append(b.sources, sourceName)
+	}
+	b.pending[sourceName] = append(b.pending[sourceName], result)
+	b.mutex.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "AutoEvent read cancelled", ctx.Err())
+	case r := <-result:
+		return r.Event, r.Err
+	}
+}
+
+func (b *readBatcher) flush() {
+	b.mutex.Lock()
+	sources := b.sources
+	pending := b.pending
+	tick := b.tick
+	b.sources = nil
+	b.pending = make(map[string][]chan application.SourceReading)
+	b.mutex.Unlock()
+
+	ctx := b.ctx
+	if !tick.IsZero() {
+		ctx = transformer.WithAutoEventTick(ctx, tick)
+	}
+	readings := application.ReadSources(ctx, b.deviceName, sources, b.dic)
+	for sourceName, results := range pending {
+		for _, result := range results {
+			result <- readings[sourceName]
+		}
+	}
+}
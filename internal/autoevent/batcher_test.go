@@ -0,0 +1,230 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package autoevent
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	bootstrapMocks "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	clientMocks "github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/application"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/cache"
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/config"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/interfaces/mocks"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+const (
+	testService   = "test-service"
+	testProfile   = "test-profile"
+	testDevice    = "test-device"
+	testResource  = "test-resource"
+	testCommand   = "test-command"
+	otherResource = "other-resource"
+)
+
+func mockBatcherDic(t *testing.T, driver *mocks.ProtocolDriver) *di.Container {
+	devices := []dtos.Device{{
+		Name:           testDevice,
+		AdminState:     models.Unlocked,
+		OperatingState: models.Up,
+		ServiceName:    testService,
+		ProfileName:    testProfile,
+	}}
+	profile := dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: testProfile},
+		DeviceResources: []dtos.DeviceResource{
+			{Name: testResource, Properties: dtos.ResourceProperties{ValueType: common.ValueTypeInt32, ReadWrite: common.ReadWrite_R}},
+			{Name: otherResource, Properties: dtos.ResourceProperties{ValueType: common.ValueTypeInt32, ReadWrite: common.ReadWrite_R}},
+		},
+		DeviceCommands: []dtos.DeviceCommand{{
+			Name:               testCommand,
+			ReadWrite:          common.ReadWrite_R,
+			ResourceOperations: []dtos.ResourceOperation{{DeviceResource: testResource}, {DeviceResource: otherResource}},
+		}},
+	}
+
+	mockDeviceClient := &clientMocks.DeviceClient{}
+	mockDeviceClient.On("DevicesByServiceName", context.Background(), testService, 0, -1).
+		Return(responses.NewMultiDevicesResponse("", "", http.StatusOK, 1, devices), nil)
+	mockDeviceProfileClient := &clientMocks.DeviceProfileClient{}
+	mockDeviceProfileClient.On("DeviceProfileByName", context.Background(), testProfile).
+		Return(responses.NewDeviceProfileResponse("", "", http.StatusOK, profile), nil)
+	mockProvisionWatcherClient := &clientMocks.ProvisionWatcherClient{}
+	mockProvisionWatcherClient.On("ProvisionWatchersByServiceName", context.Background(), testService, 0, -1).
+		Return(responses.NewMultiProvisionWatchersResponse("", "", http.StatusOK, 0, nil), nil)
+	mockMetricsManager := &bootstrapMocks.MetricsManager{}
+	mockMetricsManager.On("Register", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) any {
+			return &config.ConfigurationStruct{Device: config.DeviceInfo{MaxCmdOps: 8}}
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) any {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.DeviceClientName: func(get di.Get) any {
+			return mockDeviceClient
+		},
+		bootstrapContainer.DeviceProfileClientName: func(get di.Get) any {
+			return mockDeviceProfileClient
+		},
+		bootstrapContainer.ProvisionWatcherClientName: func(get di.Get) any {
+			return mockProvisionWatcherClient
+		},
+		container.ProtocolDriverName: func(get di.Get) any {
+			return driver
+		},
+		container.DeviceServiceName: func(get di.Get) any {
+			return &models.DeviceService{Name: testService, AdminState: models.Unlocked}
+		},
+		bootstrapContainer.MetricsManagerInterfaceName: func(get di.Get) any {
+			return mockMetricsManager
+		},
+		container.AllowedRequestFailuresTrackerName: func(get di.Get) any {
+			return container.NewAllowedFailuresTracker()
+		},
+		container.TransformStatesName: func(get di.Get) any {
+			return container.NewTransformStates()
+		},
+	})
+	require.NoError(t, cache.InitCache(testService, testService, dic))
+	return dic
+}
+
+func TestReadBatcher_Coalesce(t *testing.T) {
+	driver := &mocks.ProtocolDriver{}
+	driver.On("HandleReadCommands", testDevice, mock.Anything, mock.Anything).
+		Return(func(deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest) []*sdkModels.CommandValue {
+			values := make([]*sdkModels.CommandValue, len(reqs))
+			for i, req := range reqs {
+				values[i] = &sdkModels.CommandValue{DeviceResourceName: req.DeviceResourceName, Type: common.ValueTypeInt32, Value: int32(i)}
+			}
+			return values
+		}, nil)
+	dic := mockBatcherDic(t, driver)
+	b := newReadBatcher(context.Background(), testDevice, 20*time.Millisecond, dic)
+
+	sources := []string{testResource, otherResource, testCommand, testResource}
+	events := make([]*dtos.Event, len(sources))
+	var wg sync.WaitGroup
+	for i, sourceName := range sources {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			event, err := b.read(context.Background(), sourceName)
+			assert.NoError(t, err)
+			events[i] = event
+		}()
+	}
+	wg.Wait()
+
+	// the overlapping resources of all sources are read with a single driver call
+	driver.AssertNumberOfCalls(t, "HandleReadCommands", 1)
+	reqs := driver.Calls[0].Arguments.Get(2).([]sdkModels.CommandRequest)
+	assert.Len(t, reqs, 2)
+
+	for i, sourceName := range sources {
+		require.NotNil(t, events[i])
+		assert.Equal(t, sourceName, events[i].SourceName)
+	}
+	assert.Len(t, events[0].Readings, 1)
+	assert.Equal(t, testResource, events[0].Readings[0].ResourceName)
+	assert.Len(t, events[1].Readings, 1)
+	assert.Equal(t, otherResource, events[1].Readings[0].ResourceName)
+	assert.Len(t, events[2].Readings, 2)
+}
+
+func TestReadBatcher_MaxCmdOps(t *testing.T) {
+	driver := &mocks.ProtocolDriver{}
+	driver.On("HandleReadCommands", testDevice, mock.Anything, mock.Anything).
+		Return(func(deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest) []*sdkModels.CommandValue {
+			values := make([]*sdkModels.CommandValue, len(reqs))
+			for i, req := range reqs {
+				values[i] = &sdkModels.CommandValue{DeviceResourceName: req.DeviceResourceName, Type: common.ValueTypeInt32, Value: int32(i)}
+			}
+			return values
+		}, nil)
+	dic := mockBatcherDic(t, driver)
+	container.ConfigurationFrom(dic.Get).Device.MaxCmdOps = 1
+
+	readings := application.ReadSources(context.Background(), testDevice, []string{testResource, otherResource}, dic)
+	require.Len(t, readings, 2)
+	assert.NoError(t, readings[testResource].Err)
+	assert.NoError(t, readings[otherResource].Err)
+	driver.AssertNumberOfCalls(t, "HandleReadCommands", 2)
+}
+
+func TestReadSources_TransformStates(t *testing.T) {
+	driver := &mocks.ProtocolDriver{}
+	driver.On("HandleReadCommands", testDevice, mock.Anything, mock.Anything).
+		Return(func(deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest) []*sdkModels.CommandValue {
+			values := make([]*sdkModels.CommandValue, len(reqs))
+			for i, req := range reqs {
+				values[i] = &sdkModels.CommandValue{DeviceResourceName: req.DeviceResourceName, Type: common.ValueTypeInt32, Value: int32(1)}
+			}
+			return values
+		}, nil)
+	dic := mockBatcherDic(t, driver)
+	container.ConfigurationFrom(dic.Get).Device.DataTransform = true
+	profile, ok := cache.Profiles().ForName(testProfile)
+	require.True(t, ok)
+	profile.DeviceResources[0].Attributes = map[string]any{sdkCommon.StuckCountAttribute: 2}
+	require.NoError(t, cache.Profiles().Update(profile))
+
+	stuck := func(event *dtos.Event) bool {
+		for _, reading := range event.Readings {
+			if reading.ResourceName == testResource {
+				return reading.Tags[sdkModels.QualityReasonTag] == sdkModels.QualityReasonStuckValue
+			}
+		}
+		require.Fail(t, "no reading of %s", testResource)
+		return false
+	}
+
+	// the value shared by both sources counts once for the stuck-value rule of a stuck count of 2
+	for _, expected := range []bool{false, true} {
+		readings := application.ReadSources(context.Background(), testDevice, []string{testResource, testCommand}, dic)
+		require.NoError(t, readings[testResource].Err)
+		require.NoError(t, readings[testCommand].Err)
+		assert.Equal(t, expected, stuck(readings[testResource].Event))
+		assert.Equal(t, expected, stuck(readings[testCommand].Event))
+	}
+	driver.AssertNumberOfCalls(t, "HandleReadCommands", 2)
+}
+
+func TestReadSources_NoValue(t *testing.T) {
+	driver := &mocks.ProtocolDriver{}
+	driver.On("HandleReadCommands", testDevice, mock.Anything, mock.Anything).Return(nil, nil)
+	dic := mockBatcherDic(t, driver)
+
+	readings := application.ReadSources(context.Background(), testDevice, []string{testResource, testCommand}, dic)
+	require.Len(t, readings, 2)
+	for _, reading := range readings {
+		assert.Nil(t, reading.Event)
+		require.Error(t, reading.Err)
+		assert.Equal(t, errors.KindServerError, errors.Kind(reading.Err))
+	}
+}
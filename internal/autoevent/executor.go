@@ -1,6 +1,6 @@
 // -*- Mode: Go; indent-tabs-mode: t -*-
 //
-// Copyright (C) 2019-2025 IOTech Ltd
+// Copyright (C) 2019-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -9,8 +9,8 @@ package autoevent
 import (
 	"context"
 	"fmt"
-	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/OneOfOne/xxhash"
@@ -25,79 +25,168 @@ import (
 
 	"github.com/edgexfoundry/device-sdk-go/v4/internal/application"
 	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/transformer"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
 
 	"github.com/spf13/cast"
 )
 
+// executorStats are the statistics of an Executor reported by its status.
+type executorStats struct {
+	reads, readFailures, suppressed, events atomic.Int64
+
+	mutex         sync.Mutex
+	lastFired     time.Time
+	nextFire      time.Time
+	lastError     string
+	lastErrorTime time.Time
+}
+
 type Executor struct {
 	deviceName        string
 	sourceName        string
 	onChange          bool
-	onChangeThreshold float64
+	onChangeThreshold changeThreshold
+	heartbeat         int
+	suppressed        int
+	resourceThreshold func(profileName string, resourceName string) (changeThreshold, bool)
 	lastReadings      map[string]interface{}
-	duration          time.Duration
+	schedule          schedule
+	windows           activeWindows
+	clock             clock
+	start             time.Time
+	maxBackoff        time.Duration
+	failures          atomic.Int64
+	recovered         chan struct{}
+	interval          string
+	scheduleSpec      string
+	paused            atomic.Bool
+	running           atomic.Bool
+	trigger           chan struct{}
+	stats             executorStats
 	stop              bool
 	mutex             *sync.Mutex
 	pool              *ants.Pool
+	batcher           *readBatcher
 }
 
 // Run triggers this Executor executes the handler for the event source periodically
 func (e *Executor) Run(ctx context.Context, wg *sync.WaitGroup, buffer chan bool, dic *di.Container) {
 	wg.Add(1)
 	defer wg.Done()
+	e.running.Store(true)
+	defer e.running.Store(false)
 
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
-	deadline := time.Now().Add(e.duration)
+	start := e.start
+	if start.IsZero() {
+		start = e.clock.Now()
+	}
+	deadline := e.schedule.next(start)
+	fireTime := start
 
 	for {
+		e.setNextFire(deadline)
+		if deadline.IsZero() {
+			lc.Warnf("AutoEvent - source '%s' of device %s will never fire again", e.sourceName, e.deviceName)
+			return
+		}
 		select {
 		case <-ctx.Done():
 			return
-		case <-time.After(time.Until(deadline)):
+		case <-e.recovered:
+			// the device is back, so the backoff is cancelled and the AutoEvent returns to its normal cadence
+			deadline = e.schedule.next(fireTime)
+		case <-e.trigger:
+			if e.stop {
+				return
+			}
+			// a manual trigger neither shifts the schedule nor counts towards the backoff
+			lc.Debugf("AutoEvent - source '%s' of device %s is triggered", e.sourceName, e.deviceName)
+			if e.execute(ctx, e.clock.Now(), buffer, dic) {
+				e.resetBackoff()
+			}
+		case <-e.clock.After(deadline.Sub(e.clock.Now())):
 			if e.stop {
 				return
 			}
-			deadline = deadline.Add(e.duration)
-			lc.Debugf("AutoEvent - reading %s", e.sourceName)
-			evt, err := readResource(e, dic)
-			if err != nil {
-				lc.Errorf("AutoEvent - error occurs when reading resource %s: %v", e.sourceName, err)
+			fireTime = deadline
+			deadline = e.schedule.next(fireTime)
+			if !e.windows.contains(fireTime) {
+				lc.Tracef("AutoEvent - source '%s' is out of its active windows", e.sourceName)
 				continue
 			}
-
-			if evt != nil {
-				if e.onChange {
-					if e.compareReadings(evt.Readings) {
-						lc.Debugf("AutoEvent - source '%s' readings are the same as previous one", e.sourceName)
-						continue
-					}
-				}
-				// After the auto event executes a read command, it will create a goroutine to send out events.
-				// When the concurrent auto event amount becomes large, core-data might be hard to handle so many HTTP requests at the same time.
-				// The device service will get some network errors like EOF or Connection reset by peer.
-				// By adding a buffer here, the user can use the Service.AsyncBufferSize configuration to control the goroutine for sending events.
-				if err := e.pool.Submit(func() {
-					buffer <- true
-					correlationId := uuid.NewString()
-					sdkCommon.SendEvent(evt, correlationId, dic)
-					lc.Tracef("AutoEvent - Sent new Event/Reading for '%s' source with Correlation Id '%s'", evt.SourceName, correlationId)
-					<-buffer
-				}); err != nil {
-					lc.Errorf("AutoEvent - error occurs when send new event/reading for %s source: %v", e.sourceName, err)
+			if e.paused.Load() {
+				lc.Tracef("AutoEvent - source '%s' of device %s is paused", e.sourceName, e.deviceName)
+				continue
+			}
+			if !e.execute(ctx, fireTime, buffer, dic) {
+				if backoff := e.backoffDeadline(fireTime, deadline); backoff != deadline {
+					lc.Debugf("AutoEvent - source '%s' backs off until %s after %d consecutive failure(s)", e.sourceName, backoff, e.failures.Load())
+					deadline = backoff
 				}
-			} else {
-				lc.Debugf("AutoEvent - no event generated when reading resource %s", e.sourceName)
+				continue
 			}
+			e.failures.Store(0)
+		}
+	}
+}
+
+// execute reads the event source and sends the event, and returns false if the read failed.
+func (e *Executor) execute(ctx context.Context, fireTime time.Time, buffer chan bool, dic *di.Container) bool {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	lc.Debugf("AutoEvent - reading %s", e.sourceName)
+	// the scheduled time of the read is the origin of the event under the aligned origin policy
+	evt, err := readResource(transformer.WithAutoEventTick(ctx, fireTime), e, dic)
+	e.recordRead(fireTime, err)
+	if err != nil {
+		lc.Errorf("AutoEvent - error occurs when reading resource %s: %v", e.sourceName, err)
+		return false
+	}
+	if evt == nil {
+		lc.Debugf("AutoEvent - no event generated when reading resource %s", e.sourceName)
+		return true
+	}
+
+	if e.onChange {
+		if e.compareReadings(evt.Readings) && (e.heartbeat == 0 || e.suppressed < e.heartbeat) {
+			e.suppressed++
+			e.recordSuppressed()
+			lc.Debugf("AutoEvent - source '%s' readings are the same as previous one", e.sourceName)
+			return true
 		}
+		// the event is sent either on change or as a heartbeat after too many suppressed cycles
+		e.suppressed = 0
+	}
+	// After the auto event executes a read command, it will create a goroutine to send out events.
+	// When the concurrent auto event amount becomes large, core-data might be hard to handle so many HTTP requests at the same time.
+	// The device service will get some network errors like EOF or Connection reset by peer.
+	// By adding a buffer here, the user can use the Service.AsyncBufferSize configuration to control the goroutine for sending events.
+	if err := e.pool.Submit(func() {
+		buffer <- true
+		correlationId := uuid.NewString()
+		sdkCommon.SendEvent(evt, correlationId, dic)
+		lc.Tracef("AutoEvent - Sent new Event/Reading for '%s' source with Correlation Id '%s'", evt.SourceName, correlationId)
+		<-buffer
+	}); err != nil {
+		lc.Errorf("AutoEvent - error occurs when send new event/reading for %s source: %v", e.sourceName, err)
+		return true
 	}
+	e.stats.events.Add(1)
+	return true
 }
 
-func readResource(e *Executor, dic *di.Container) (event *dtos.Event, err errors.EdgeX) {
+func readResource(ctx context.Context, e *Executor, dic *di.Container) (event *dtos.Event, err errors.EdgeX) {
 	vars := make(map[string]string, 2)
 	vars[common.Name] = e.deviceName
 	vars[common.Command] = e.sourceName
 
-	res, err := application.GetCommand(context.Background(), e.deviceName, e.sourceName, "", true, dic)
+	// AutoEvents of the same device firing together are read with a batched driver call
+	if e.batcher != nil {
+		return e.batcher.read(ctx, e.sourceName)
+	}
+
+	res, err := application.GetCommand(ctx, e.deviceName, e.sourceName, "", true, dic)
 	if err != nil {
 		return event, err
 	}
@@ -126,8 +215,11 @@ func (e *Executor) compareReadings(readings []dtos.BaseReading) bool {
 			case common.ValueTypeUint8, common.ValueTypeUint16, common.ValueTypeUint32, common.ValueTypeUint64,
 				common.ValueTypeInt8, common.ValueTypeInt16, common.ValueTypeInt32, common.ValueTypeInt64,
 				common.ValueTypeFloat32, common.ValueTypeFloat64:
-				t := cast.ToFloat64(lastReading) - cast.ToFloat64(reading.Value)
-				if math.Abs(t) > e.onChangeThreshold {
+				threshold, ok := e.resourceThreshold(reading.ProfileName, reading.ResourceName)
+				if !ok {
+					threshold = e.onChangeThreshold
+				}
+				if threshold.exceeded(cast.ToFloat64(lastReading), cast.ToFloat64(reading.Value)) {
 					e.lastReadings[reading.ResourceName] = reading.Value
 					result = false
 				}
@@ -157,25 +249,148 @@ func (e *Executor) renewLastReadings(readings []dtos.BaseReading) {
 	}
 }
 
+// backoffDeadline counts a read failure and returns the deadline delayed exponentially with the consecutive failures:
+// the period to the next scheduled deadline is doubled at each failure, and the delay is capped by maxBackoff.
+func (e *Executor) backoffDeadline(fireTime time.Time, next time.Time) time.Time {
+	failures := e.failures.Add(1)
+	if e.maxBackoff <= 0 {
+		return next
+	}
+	period := next.Sub(fireTime)
+	delay := period
+	for i := int64(1); i < failures && delay < e.maxBackoff; i++ {
+		delay = delay*2 + period
+	}
+	if delay > e.maxBackoff {
+		delay = e.maxBackoff
+	}
+	return e.schedule.next(fireTime.Add(delay))
+}
+
+// resetBackoff makes the Executor return to its normal cadence if it is backing off.
+func (e *Executor) resetBackoff() {
+	if e.failures.Swap(0) == 0 {
+		return
+	}
+	select {
+	case e.recovered <- struct{}{}:
+	default:
+	}
+}
+
+func (e *Executor) recordRead(fireTime time.Time, err errors.EdgeX) {
+	e.stats.reads.Add(1)
+	e.stats.mutex.Lock()
+	defer e.stats.mutex.Unlock()
+	e.stats.lastFired = fireTime
+	if err != nil {
+		e.stats.readFailures.Add(1)
+		e.stats.lastError = err.Error()
+		e.stats.lastErrorTime = fireTime
+	}
+}
+
+func (e *Executor) recordSuppressed() {
+	e.stats.suppressed.Add(1)
+}
+
+func (e *Executor) setNextFire(deadline time.Time) {
+	e.stats.mutex.Lock()
+	defer e.stats.mutex.Unlock()
+	e.stats.nextFire = deadline
+}
+
+// Status returns the runtime status and statistics of this Executor
+func (e *Executor) Status() sdkModels.AutoEventStatus {
+	status := sdkModels.AutoEventStatus{
+		DeviceName:          e.deviceName,
+		SourceName:          e.sourceName,
+		Interval:            e.interval,
+		Schedule:            e.scheduleSpec,
+		OnChange:            e.onChange,
+		Running:             e.running.Load(),
+		Paused:              e.paused.Load(),
+		ReadCount:           e.stats.reads.Load(),
+		FailureCount:        e.stats.readFailures.Load(),
+		ConsecutiveFailures: e.failures.Load(),
+		SuppressedCount:     e.stats.suppressed.Load(),
+		EventCount:          e.stats.events.Load(),
+	}
+	e.stats.mutex.Lock()
+	defer e.stats.mutex.Unlock()
+	status.LastFired = unixNano(e.stats.lastFired)
+	status.NextFire = unixNano(e.stats.nextFire)
+	status.LastError = e.stats.lastError
+	status.LastErrorTime = unixNano(e.stats.lastErrorTime)
+	return status
+}
+
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+// Pause makes this Executor skip its scheduled reads until it is resumed
+func (e *Executor) Pause() {
+	e.paused.Store(true)
+}
+
+// Resume makes this Executor fire on its schedule again
+func (e *Executor) Resume() {
+	e.paused.Store(false)
+}
+
+// Trigger makes this Executor fire immediately even if it is paused, and returns false if the Executor is not running.
+// A trigger is dropped if the previous one is still pending.
+func (e *Executor) Trigger() bool {
+	if !e.running.Load() {
+		return false
+	}
+	select {
+	case e.trigger <- struct{}{}:
+	default:
+	}
+	return true
+}
+
 // Stop marks this Executor stopped
 func (e *Executor) Stop() {
 	e.stop = true
 }
 
-// NewExecutor creates an Executor for an AutoEvent
-func NewExecutor(deviceName string, ae models.AutoEvent, pool *ants.Pool) (*Executor, errors.EdgeX) {
+// NewExecutor creates an Executor for an AutoEvent of the device, whose wall-clock times are in the given location
+// unless the AutoEvent specifies its time zone.
+func NewExecutor(device models.Device, ae models.AutoEvent, location *time.Location, pool *ants.Pool) (*Executor, errors.EdgeX) {
+	settings, err := autoEventSettings(device, ae.SourceName)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse AutoEvent %s settings", ae.SourceName), err)
+	}
 	// check Frequency
-	duration, err := time.ParseDuration(ae.Interval)
+	schedule, options, err := parseSchedule(ae.Interval, settings, location)
 	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to parse AutoEvent %s duration", ae.SourceName), err)
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to parse AutoEvent %s schedule", ae.SourceName), err)
+	}
+	threshold := changeThreshold{value: ae.OnChangeThreshold}
+	if options.deadband != nil {
+		threshold = *options.deadband
 	}
 
 	return &Executor{
-		deviceName:        deviceName,
+		deviceName:        device.Name,
 		sourceName:        ae.SourceName,
 		onChange:          ae.OnChange,
-		onChangeThreshold: ae.OnChangeThreshold,
-		duration:          duration,
+		onChangeThreshold: threshold,
+		heartbeat:         options.heartbeat,
+		resourceThreshold: resourceThreshold,
+		schedule:          schedule,
+		windows:           options.windows,
+		clock:             realClock{},
+		interval:          ae.Interval,
+		scheduleSpec:      options.spec,
+		recovered:         make(chan struct{}, 1),
+		trigger:           make(chan struct{}, 1),
 		stop:              false,
 		mutex:             &sync.Mutex{},
 		pool:              pool,
@@ -1,29 +1,41 @@
 // -*- Mode: Go; indent-tabs-mode: t -*-
 //
-// Copyright (C) 2019-2021 IOTech Ltd
+// Copyright (C) 2019-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
 package autoevent
 
 import (
+	"context"
 	"crypto/rand"
 	"runtime"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	msgMocks "github.com/edgexfoundry/go-mod-messaging/v4/messaging/mocks"
 	"github.com/panjf2000/ants/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/interfaces/mocks"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
 )
 
 func TestCompareReadings(t *testing.T) {
 	autoEvent := models.AutoEvent{SourceName: "sourceName", OnChange: true, Interval: "500ms"}
 	pool, err := ants.NewPool(runtime.GOMAXPROCS(0), ants.WithNonblocking(true))
 	require.NoError(t, err)
-	e, err := NewExecutor("device-test", autoEvent, pool)
+	e, err := NewExecutor(models.Device{Name: "device-test"}, autoEvent, time.UTC, pool)
 	require.NoError(t, err)
 
 	testReadings := []dtos.BaseReading{{ResourceName: "r1"}, {ResourceName: "r2"}}
@@ -87,8 +99,9 @@ func TestOnChangeThreshold(t *testing.T) {
 	autoEvent := models.AutoEvent{SourceName: resourceName, OnChange: true, Interval: "500ms"}
 	pool, err := ants.NewPool(runtime.GOMAXPROCS(0), ants.WithNonblocking(true))
 	require.NoError(t, err)
-	e, err := NewExecutor(deviceName, autoEvent, pool)
+	e, err := NewExecutor(models.Device{Name: deviceName}, autoEvent, time.UTC, pool)
 	require.NoError(t, err)
+	e.resourceThreshold = func(string, string) (changeThreshold, bool) { return changeThreshold{}, false }
 
 	tests := []struct {
 		name                string
@@ -127,7 +140,7 @@ func TestOnChangeThreshold(t *testing.T) {
 			currentReading, err := dtos.NewSimpleReading(profileName, deviceName, resourceName, testCase.valueType, testCase.currentReadingValue)
 			require.NoError(t, err)
 			e.lastReadings = map[string]any{lastReading.ResourceName: lastReading.Value}
-			e.onChangeThreshold = testCase.onChangeThreshold
+			e.onChangeThreshold = changeThreshold{value: testCase.onChangeThreshold}
 
 			res := e.compareReadings([]dtos.BaseReading{currentReading})
 
@@ -135,3 +148,104 @@ func TestOnChangeThreshold(t *testing.T) {
 		})
 	}
 }
+
+func TestOnChangeDeadband(t *testing.T) {
+	deviceName := "testDevice"
+	profileName := "testProfile"
+	pool, err := ants.NewPool(runtime.GOMAXPROCS(0), ants.WithNonblocking(true))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name                string
+		deadband            string
+		resourceThreshold   string
+		lastReadingValue    float64
+		currentReadingValue float64
+		expectUnchanged     bool
+	}{
+		{"percentage within deadband", "5%", "", 100, 104, true},
+		{"percentage beyond deadband", "5%", "", 100, 94, false},
+		{"percentage of zero", "5%", "", 0, 0.001, false},
+		{"absolute deadband", "2", "", 100, 102, true},
+		{"resource absolute threshold", "5%", "1", 100, 102, false},
+		{"resource percentage threshold", "", "10%", 100, 109, true},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			device := models.Device{Name: deviceName}
+			if testCase.deadband != "" {
+				device.Properties = map[string]any{sdkCommon.AutoEventsProperty: map[string]any{"r1": map[string]any{deadbandKey: testCase.deadband}}}
+			}
+			e, err := NewExecutor(device, models.AutoEvent{SourceName: "r1", OnChange: true, Interval: "1s"}, time.UTC, pool)
+			require.NoError(t, err)
+			e.resourceThreshold = func(profile string, resource string) (changeThreshold, bool) {
+				if testCase.resourceThreshold == "" || profile != profileName || resource != "r1" {
+					return changeThreshold{}, false
+				}
+				threshold, err := parseThreshold(testCase.resourceThreshold)
+				require.NoError(t, err)
+				return threshold, true
+			}
+
+			lastReading, readingErr := dtos.NewSimpleReading(profileName, deviceName, "r1", common.ValueTypeFloat64, testCase.lastReadingValue)
+			require.NoError(t, readingErr)
+			currentReading, readingErr := dtos.NewSimpleReading(profileName, deviceName, "r1", common.ValueTypeFloat64, testCase.currentReadingValue)
+			require.NoError(t, readingErr)
+			e.lastReadings = map[string]any{lastReading.ResourceName: lastReading.Value}
+
+			assert.Equal(t, testCase.expectUnchanged, e.compareReadings([]dtos.BaseReading{currentReading}))
+		})
+	}
+}
+
+func TestExecutor_Run_Heartbeat(t *testing.T) {
+	driver := &mocks.ProtocolDriver{}
+	driver.On("HandleReadCommands", testDevice, mock.Anything, mock.Anything).
+		Return([]*sdkModels.CommandValue{{DeviceResourceName: testResource, Type: common.ValueTypeInt32, Value: int32(1)}}, nil)
+	dic := mockBatcherDic(t, driver)
+	var published atomic.Int64
+	mcMock := &msgMocks.MessageClient{}
+	mcMock.On("PublishWithSizeLimit", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(mock.Arguments) { published.Add(1) }).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.MessagingClientName: func(get di.Get) any {
+			return mcMock
+		},
+	})
+
+	pool, err := ants.NewPool(runtime.GOMAXPROCS(0))
+	require.NoError(t, err)
+	defer pool.Release()
+	device := models.Device{
+		Name:       testDevice,
+		Properties: map[string]any{sdkCommon.AutoEventsProperty: map[string]any{testResource: map[string]any{heartbeatKey: "2"}}},
+	}
+	e, err := NewExecutor(device, models.AutoEvent{SourceName: testResource, OnChange: true, Interval: "10s"}, time.UTC, pool)
+	require.NoError(t, err)
+	clock := newFakeClock(date(1, 0, 0, 0))
+	e.clock = clock
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	go func() {
+		e.Run(ctx, &wg, make(chan bool, 1), dic)
+		close(done)
+	}()
+
+	// the unchanged readings are sent at the first cycle and after every 2 suppressed cycles
+	for i := 0; i < 6; i++ {
+		<-clock.waiting
+		clock.advance(10 * time.Second)
+	}
+	<-clock.waiting
+	driver.AssertNumberOfCalls(t, "HandleReadCommands", 6)
+	require.Eventually(t, func() bool {
+		return published.Load() == 2
+	}, time.Second, 5*time.Millisecond)
+
+	cancel()
+	<-done
+	assert.Equal(t, int64(2), published.Load())
+}
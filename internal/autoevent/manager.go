@@ -1,6 +1,6 @@
 // -*- Mode: Go; indent-tabs-mode: t -*-
 //
-// Copyright (C) 2019-2024 IOTech Ltd
+// Copyright (C) 2019-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -8,16 +8,35 @@ package autoevent
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
 	"sync"
+	"time"
 
+	"github.com/OneOfOne/xxhash"
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 	"github.com/panjf2000/ants/v2"
 
 	"github.com/edgexfoundry/device-sdk-go/v4/internal/cache"
 	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+// defaultCoalescingWindow is used when Device.AutoEventCoalescingWindow is not configured, the coalescing is opt-in
+const defaultCoalescingWindow time.Duration = 0
+
+// phase distributions of Device.AutoEventPhaseDistribution
+const (
+	phaseDistributionNone   = "none"
+	phaseDistributionRandom = "random"
+	phaseDistributionHash   = "hash"
 )
 
 type manager struct {
@@ -74,32 +93,144 @@ func (m *manager) StartAutoEvents() {
 			continue
 		}
 		if _, ok := m.executorMap[d.Name]; !ok {
-			executors := m.triggerExecutors(d.Name, d.AutoEvents, m.dic)
+			executors := m.triggerExecutors(d, m.dic)
 			m.executorMap[d.Name] = executors
 		}
 	}
 }
 
-func (m *manager) triggerExecutors(deviceName string, autoEvents []models.AutoEvent, dic *di.Container) []*Executor {
+func (m *manager) triggerExecutors(device models.Device, dic *di.Container) []*Executor {
 	var executors []*Executor
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	deviceName, autoEvents := device.Name, device.AutoEvents
+
+	var batcher *readBatcher
+	if len(autoEvents) > 1 {
+		batcher = m.newReadBatcher(deviceName, dic)
+	}
+	maxBackoff := parseDurationConfig("Device.AutoEventMaxBackoff", container.ConfigurationFrom(dic.Get).Device.AutoEventMaxBackoff, lc)
+	location := autoEventLocation(dic)
 
 	for _, autoEvent := range autoEvents {
-		executor, err := NewExecutor(deviceName, autoEvent, m.pool)
+		executor, err := NewExecutor(device, autoEvent, location, m.pool)
 		if err != nil {
 			lc.Errorf("failed to create executor of AutoEvent %s for Device %s: %v", autoEvent.SourceName, deviceName, err)
 			// skip this AutoEvent if it causes error during creation
 			continue
 		}
+		executor.batcher = batcher
+		executor.maxBackoff = maxBackoff
 		executors = append(executors, executor)
+	}
+
+	// all the interval executors of a device start with the same phase so that the AutoEvents sharing the same
+	// tick can be coalesced into one driver call, while the wall-clock schedules are not shifted
+	now := time.Now()
+	start := now.Add(phaseOffset(deviceName, executors, dic))
+	for _, executor := range executors {
+		executor.start = now
+		if _, ok := executor.schedule.(*intervalSchedule); ok {
+			executor.start = start
+		}
 		go executor.Run(m.ctx, m.wg, m.autoeventBuffer, dic)
 	}
 	return executors
 }
 
+// phaseOffset returns the start phase of the interval AutoEvents of the device according to
+// Device.AutoEventPhaseDistribution, within Device.AutoEventMaxJitter or the shortest interval.
+func phaseOffset(deviceName string, executors []*Executor, dic *di.Container) time.Duration {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get)
+
+	var fraction float64
+	switch config.Device.AutoEventPhaseDistribution {
+	case "", phaseDistributionNone:
+		return 0
+	case phaseDistributionRandom:
+		fraction = rand.Float64() // nolint: gosec
+	case phaseDistributionHash:
+		fraction = float64(xxhash.ChecksumString64(deviceName)) / float64(math.MaxUint64)
+	default:
+		lc.Errorf("unknown Device.AutoEventPhaseDistribution %s, AutoEvents of Device %s are not spread",
+			config.Device.AutoEventPhaseDistribution, deviceName)
+		return 0
+	}
+
+	maxJitter := parseDurationConfig("Device.AutoEventMaxJitter", config.Device.AutoEventMaxJitter, lc)
+	if maxJitter <= 0 {
+		for _, executor := range executors {
+			if s, ok := executor.schedule.(*intervalSchedule); ok && (maxJitter <= 0 || s.duration < maxJitter) {
+				maxJitter = s.duration
+			}
+		}
+	}
+	return time.Duration(fraction * float64(maxJitter))
+}
+
+// autoEventLocation returns the location of Device.AutoEventTimeZone, or the local time zone if it is not configured.
+func autoEventLocation(dic *di.Container) *time.Location {
+	timeZone := container.ConfigurationFrom(dic.Get).Device.AutoEventTimeZone
+	if timeZone == "" {
+		return time.Local
+	}
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+		lc.Errorf("failed to load Device.AutoEventTimeZone %s, the local time zone is used: %v", timeZone, err)
+		return time.Local
+	}
+	return location
+}
+
+func parseDurationConfig(name string, value string, lc logger.LoggingClient) time.Duration {
+	if value == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		lc.Errorf("failed to parse %s %s: %v", name, value, err)
+		return 0
+	}
+	return d
+}
+
+// DeviceRequestSucceeded makes the AutoEvents of the device return to their normal cadence if they are backing off.
+func (m *manager) DeviceRequestSucceeded(deviceName string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, executor := range m.executorMap[deviceName] {
+		executor.resetBackoff()
+	}
+}
+
+// newReadBatcher returns the batcher to coalesce the AutoEvent reads of the device, or nil
+// if the coalescing is disabled, which is the default unless Device.AutoEventCoalescingWindow is set.
+func (m *manager) newReadBatcher(deviceName string, dic *di.Container) *readBatcher {
+	config := container.ConfigurationFrom(dic.Get)
+	window := defaultCoalescingWindow
+	if config.Device.AutoEventCoalescingWindow != "" {
+		var err error
+		window, err = time.ParseDuration(config.Device.AutoEventCoalescingWindow)
+		if err != nil {
+			lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+			lc.Errorf("failed to parse Device.AutoEventCoalescingWindow %s, AutoEvent reads of Device %s are not coalesced: %v",
+				config.Device.AutoEventCoalescingWindow, deviceName, err)
+			return nil
+		}
+	}
+	if window <= 0 {
+		return nil
+	}
+	return newReadBatcher(m.ctx, deviceName, window, dic)
+}
+
 func (m *manager) RestartForDevice(deviceName string) {
 	lc := bootstrapContainer.LoggingClientFrom(m.dic.Get)
 
+	// the AutoEvents paused through the REST API stay paused when the device is updated
+	paused := m.pausedSources(deviceName)
 	m.StopForDevice(deviceName)
 	d, ok := cache.Devices().ForName(deviceName)
 	if !ok {
@@ -113,10 +244,28 @@ func (m *manager) RestartForDevice(deviceName string) {
 
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
-	executors := m.triggerExecutors(deviceName, d.AutoEvents, m.dic)
+	executors := m.triggerExecutors(d, m.dic)
+	for _, executor := range executors {
+		if paused[executor.sourceName] {
+			executor.Pause()
+		}
+	}
 	m.executorMap[deviceName] = executors
 }
 
+func (m *manager) pausedSources(deviceName string) map[string]bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	paused := make(map[string]bool)
+	for _, executor := range m.executorMap[deviceName] {
+		if executor.paused.Load() {
+			paused[executor.sourceName] = true
+		}
+	}
+	return paused
+}
+
 func (m *manager) StopForDevice(deviceName string) {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
@@ -129,3 +278,70 @@ func (m *manager) StopForDevice(deviceName string) {
 		delete(m.executorMap, deviceName)
 	}
 }
+
+func (m *manager) AutoEventStatuses(deviceName string) []sdkModels.AutoEventStatus {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	deviceNames := []string{deviceName}
+	if deviceName == "" {
+		deviceNames = make([]string, 0, len(m.executorMap))
+		for name := range m.executorMap {
+			deviceNames = append(deviceNames, name)
+		}
+		sort.Strings(deviceNames)
+	}
+	statuses := make([]sdkModels.AutoEventStatus, 0)
+	for _, name := range deviceNames {
+		for _, executor := range m.executorMap[name] {
+			statuses = append(statuses, executor.Status())
+		}
+	}
+	return statuses
+}
+
+func (m *manager) PauseAutoEvent(deviceName string, sourceName string) error {
+	return m.forSource(deviceName, sourceName, func(executor *Executor) errors.EdgeX {
+		executor.Pause()
+		return nil
+	})
+}
+
+func (m *manager) ResumeAutoEvent(deviceName string, sourceName string) error {
+	return m.forSource(deviceName, sourceName, func(executor *Executor) errors.EdgeX {
+		executor.Resume()
+		return nil
+	})
+}
+
+func (m *manager) TriggerAutoEvent(deviceName string, sourceName string) error {
+	return m.forSource(deviceName, sourceName, func(executor *Executor) errors.EdgeX {
+		if !executor.Trigger() {
+			return errors.NewCommonEdgeX(errors.KindStatusConflict,
+				fmt.Sprintf("AutoEvent %s of Device %s is not running", sourceName, deviceName), nil)
+		}
+		return nil
+	})
+}
+
+// forSource applies the action to the executors of the AutoEvents reading the source of the device.
+func (m *manager) forSource(deviceName string, sourceName string, action func(executor *Executor) errors.EdgeX) errors.EdgeX {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	found := false
+	for _, executor := range m.executorMap[deviceName] {
+		if executor.sourceName != sourceName {
+			continue
+		}
+		found = true
+		if err := action(executor); err != nil {
+			return err
+		}
+	}
+	if !found {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist,
+			fmt.Sprintf("no AutoEvent of source %s is running for Device %s", sourceName, deviceName), nil)
+	}
+	return nil
+}
@@ -0,0 +1,285 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package autoevent
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	edgexErrors "github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/panjf2000/ants/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/config"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/interfaces/mocks"
+)
+
+func phaseDic(distribution string, maxJitter string) *di.Container {
+	return di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) any {
+			return &config.ConfigurationStruct{
+				Device: config.DeviceInfo{
+					AutoEventPhaseDistribution: distribution,
+					AutoEventMaxJitter:         maxJitter,
+				},
+			}
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) any {
+			return logger.NewMockClient()
+		},
+	})
+}
+
+// testExecutors creates the Executors of the intervals, an interval starting with @ is the schedule of the AutoEvent.
+func testExecutors(t *testing.T, intervals ...string) []*Executor {
+	pool, err := ants.NewPool(runtime.GOMAXPROCS(0))
+	require.NoError(t, err)
+	t.Cleanup(pool.Release)
+
+	var executors []*Executor
+	for _, interval := range intervals {
+		device := models.Device{Name: "device"}
+		if strings.HasPrefix(interval, "@") {
+			device.Properties = map[string]any{sdkCommon.AutoEventsProperty: map[string]any{"source": map[string]any{scheduleKey: interval}}}
+			interval = "24h"
+		}
+		e, err := NewExecutor(device, models.AutoEvent{SourceName: "source", Interval: interval}, time.UTC, pool)
+		require.NoError(t, err)
+		executors = append(executors, e)
+	}
+	return executors
+}
+
+func TestPhaseOffset(t *testing.T) {
+	executors := testExecutors(t, "10s", "1m", "@daily")
+
+	tests := []struct {
+		name         string
+		distribution string
+		maxJitter    string
+		maxOffset    time.Duration
+	}{
+		{"not spread by default", "", "", 0},
+		{"none", phaseDistributionNone, "", 0},
+		{"unknown distribution", "sometimes", "", 0},
+		{"random within shortest interval", phaseDistributionRandom, "", 10 * time.Second},
+		{"hash within shortest interval", phaseDistributionHash, "", 10 * time.Second},
+		{"hash within max jitter", phaseDistributionHash, "1s", time.Second},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := phaseDic(testCase.distribution, testCase.maxJitter)
+			for _, deviceName := range []string{"device-1", "device-2", "device-3"} {
+				offset := phaseOffset(deviceName, executors, dic)
+				assert.GreaterOrEqual(t, offset, time.Duration(0))
+				assert.LessOrEqual(t, offset, testCase.maxOffset)
+			}
+		})
+	}
+
+	// the hash distribution is stable and spreads the devices
+	dic := phaseDic(phaseDistributionHash, "")
+	assert.Equal(t, phaseOffset("device-1", executors, dic), phaseOffset("device-1", executors, dic))
+	assert.NotEqual(t, phaseOffset("device-1", executors, dic), phaseOffset("device-2", executors, dic))
+}
+
+func TestAutoEventLocation(t *testing.T) {
+	tests := []struct {
+		name             string
+		timeZone         string
+		expectedLocation string
+	}{
+		{"local by default", "", time.Local.String()},
+		{"configured", "Europe/Paris", "Europe/Paris"},
+		{"invalid", "Nowhere/Unknown", time.Local.String()},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := phaseDic("", "")
+			container.ConfigurationFrom(dic.Get).Device.AutoEventTimeZone = testCase.timeZone
+			assert.Equal(t, testCase.expectedLocation, autoEventLocation(dic).String())
+		})
+	}
+}
+
+func TestExecutor_BackoffDeadline(t *testing.T) {
+	e := testExecutors(t, "10s")[0]
+	fireTime := date(1, 0, 0, 0)
+
+	// without maxBackoff the failures are only counted
+	assert.Equal(t, fireTime.Add(10*time.Second), e.backoffDeadline(fireTime, fireTime.Add(10*time.Second)))
+
+	e.failures.Store(0)
+	e.maxBackoff = time.Minute
+	expectedPeriods := []time.Duration{20 * time.Second, 40 * time.Second, 70 * time.Second, 70 * time.Second}
+	for _, expected := range expectedPeriods {
+		assert.Equal(t, fireTime.Add(expected), e.backoffDeadline(fireTime, fireTime.Add(10*time.Second)))
+	}
+	assert.Equal(t, int64(4), e.failures.Load())
+}
+
+func TestManager_DeviceRequestSucceeded(t *testing.T) {
+	executors := testExecutors(t, "10s", "1m")
+	m := &manager{executorMap: map[string][]*Executor{"device": executors}}
+	executors[0].failures.Store(3)
+
+	m.DeviceRequestSucceeded("device")
+	m.DeviceRequestSucceeded("unknown")
+
+	assert.Equal(t, int64(0), executors[0].failures.Load())
+	assert.Len(t, executors[0].recovered, 1)
+	// the executors which are not backing off are not woken up
+	assert.Len(t, executors[1].recovered, 0)
+}
+
+func TestExecutor_Run_Backoff(t *testing.T) {
+	driver := &mocks.ProtocolDriver{}
+	driver.On("HandleReadCommands", testDevice, mock.Anything, mock.Anything).Return(nil, errors.New("device unreachable"))
+	dic := mockBatcherDic(t, driver)
+
+	executors := testExecutors(t, "10s")
+	e := executors[0]
+	e.deviceName = testDevice
+	e.sourceName = testResource
+	e.maxBackoff = time.Minute
+	clock := newFakeClock(date(1, 0, 0, 0))
+	e.clock = clock
+	m := &manager{executorMap: map[string][]*Executor{testDevice: executors}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	go func() {
+		e.Run(ctx, &wg, make(chan bool, 1), dic)
+		close(done)
+	}()
+
+	<-clock.waiting
+	assert.Equal(t, date(1, 0, 0, 10), clock.lastDeadline())
+	// the period doubles after every failure
+	for _, expected := range []time.Time{date(1, 0, 0, 30), date(1, 0, 1, 10)} {
+		clock.advance(clock.lastDeadline().Sub(clock.Now()))
+		<-clock.waiting
+		assert.Equal(t, expected, clock.lastDeadline())
+	}
+
+	// the device is back, so the next read follows the normal cadence
+	m.DeviceRequestSucceeded(testDevice)
+	<-clock.waiting
+	assert.Equal(t, date(1, 0, 0, 40), clock.lastDeadline())
+
+	cancel()
+	<-done
+}
+
+func TestManager_ControlAutoEvent(t *testing.T) {
+	executors := testExecutors(t, "10s")
+	other := testExecutors(t, "1m")
+	other[0].deviceName = "another"
+	m := &manager{executorMap: map[string][]*Executor{"device": executors, "another": other}}
+
+	require.NoError(t, m.PauseAutoEvent("device", "source"))
+	assert.True(t, executors[0].paused.Load())
+	require.NoError(t, m.ResumeAutoEvent("device", "source"))
+	assert.False(t, executors[0].paused.Load())
+
+	tests := []struct {
+		name         string
+		deviceName   string
+		sourceName   string
+		action       func(deviceName string, sourceName string) error
+		expectedKind edgexErrors.ErrKind
+	}{
+		{"pause unknown device", "unknown", "source", m.PauseAutoEvent, edgexErrors.KindEntityDoesNotExist},
+		{"resume unknown source", "device", "unknown", m.ResumeAutoEvent, edgexErrors.KindEntityDoesNotExist},
+		{"trigger stopped executor", "device", "source", m.TriggerAutoEvent, edgexErrors.KindStatusConflict},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.action(testCase.deviceName, testCase.sourceName)
+			require.Error(t, err)
+			assert.Equal(t, testCase.expectedKind, edgexErrors.Kind(err))
+		})
+	}
+
+	statuses := m.AutoEventStatuses("")
+	require.Len(t, statuses, 2)
+	assert.Equal(t, "another", statuses[0].DeviceName)
+	assert.Equal(t, "1m", statuses[0].Interval)
+	assert.Equal(t, "device", statuses[1].DeviceName)
+	assert.Len(t, m.AutoEventStatuses("device"), 1)
+	assert.Empty(t, m.AutoEventStatuses("unknown"))
+}
+
+func TestExecutor_Run_PauseAndTrigger(t *testing.T) {
+	driver := &mocks.ProtocolDriver{}
+	driver.On("HandleReadCommands", testDevice, mock.Anything, mock.Anything).Return(nil, errors.New("device unreachable"))
+	dic := mockBatcherDic(t, driver)
+
+	executors := testExecutors(t, "10s")
+	e := executors[0]
+	e.deviceName = testDevice
+	e.sourceName = testResource
+	clock := newFakeClock(date(1, 0, 0, 0))
+	e.clock = clock
+	m := &manager{executorMap: map[string][]*Executor{testDevice: executors}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	go func() {
+		e.Run(ctx, &wg, make(chan bool, 1), dic)
+		close(done)
+	}()
+	<-clock.waiting
+	assert.True(t, e.Status().Running)
+
+	// a paused AutoEvent skips its scheduled reads
+	require.NoError(t, m.PauseAutoEvent(testDevice, testResource))
+	clock.advance(10 * time.Second)
+	<-clock.waiting
+	status := e.Status()
+	assert.True(t, status.Paused)
+	assert.Equal(t, int64(0), status.ReadCount)
+	assert.Equal(t, date(1, 0, 0, 20).UnixNano(), status.NextFire)
+
+	// but it can still be triggered, without shifting its schedule or backing off
+	require.NoError(t, m.TriggerAutoEvent(testDevice, testResource))
+	<-clock.waiting
+	status = e.Status()
+	assert.Equal(t, int64(1), status.ReadCount)
+	assert.Equal(t, int64(1), status.FailureCount)
+	assert.Equal(t, int64(0), status.ConsecutiveFailures)
+	assert.NotEmpty(t, status.LastError)
+	assert.Equal(t, date(1, 0, 0, 20), clock.lastDeadline())
+
+	require.NoError(t, m.ResumeAutoEvent(testDevice, testResource))
+	clock.advance(10 * time.Second)
+	<-clock.waiting
+	status = e.Status()
+	assert.False(t, status.Paused)
+	assert.Equal(t, int64(2), status.ReadCount)
+	assert.Equal(t, int64(1), status.ConsecutiveFailures)
+	assert.Equal(t, date(1, 0, 0, 20).UnixNano(), status.LastFired)
+
+	cancel()
+	<-done
+	assert.False(t, e.Status().Running)
+}
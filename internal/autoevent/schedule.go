@@ -0,0 +1,298 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package autoevent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/spf13/cast"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+)
+
+const alignedPrefix = "@aligned"
+
+// keys of the settings of an AutoEvent in the ds-autoevents property of its device
+const (
+	scheduleKey  = "schedule"
+	windowsKey   = "windows"
+	deadbandKey  = "deadband"
+	heartbeatKey = "heartbeat"
+	timeZoneKey  = "timezone"
+)
+
+// clock provides the current time and timers to the Executor, so that the scheduling can be tested deterministically.
+type clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// schedule computes when an AutoEvent fires next.
+type schedule interface {
+	// next returns the first fire time strictly after the given time, or the zero time if there is none.
+	next(after time.Time) time.Time
+}
+
+// intervalSchedule fires every duration counted from the previous fire time.
+type intervalSchedule struct {
+	duration time.Duration
+}
+
+func (s *intervalSchedule) next(after time.Time) time.Time {
+	return after.Add(s.duration)
+}
+
+// alignedSchedule fires on the wall-clock boundaries of the duration, counted from the local midnight,
+// e.g. every quarter hour on the quarter hour for 15m. The boundaries of durations longer than a day are counted
+// from the local midnight of 1970-01-01, e.g. every other day at midnight for 48h. A boundary in the gap of a
+// daylight saving time change fires once the clock is moved forward.
+type alignedSchedule struct {
+	duration time.Duration
+	location *time.Location
+}
+
+// alignedEpoch is the wall-clock origin of the boundaries of durations longer than a day
+var alignedEpoch = time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func (s *alignedSchedule) next(after time.Time) time.Time {
+	// the boundaries are computed on the wall-clock time expressed in UTC, which has no daylight saving time
+	t := after.In(s.location)
+	wall := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	for {
+		wall = s.nextBoundary(wall)
+		next := time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(), s.location)
+		// a wall-clock time repeated when the clock is moved back may resolve to its first occurrence
+		if next.After(after) {
+			return next
+		}
+	}
+}
+
+// nextBoundary returns the first boundary of the duration strictly after the wall-clock time
+func (s *alignedSchedule) nextBoundary(wall time.Time) time.Time {
+	if s.duration > 24*time.Hour {
+		return alignedEpoch.Add((wall.Sub(alignedEpoch)/s.duration + 1) * s.duration)
+	}
+	midnight := time.Date(wall.Year(), wall.Month(), wall.Day(), 0, 0, 0, 0, time.UTC)
+	next := midnight.Add((wall.Sub(midnight)/s.duration + 1) * s.duration)
+	nextMidnight := midnight.AddDate(0, 0, 1)
+	if next.After(nextMidnight) {
+		// the boundaries restart at each midnight when the duration does not divide the day
+		return nextMidnight
+	}
+	return next
+}
+
+// activeWindow restricts an AutoEvent to a daily time range on some days of the week.
+// A range ending before it starts spans midnight, e.g. 22:00-06:00.
+type activeWindow struct {
+	start, end time.Duration
+	// days is the bit set of the weekdays on which the window starts, all days if zero
+	days     uint64
+	location *time.Location
+}
+
+type activeWindows []activeWindow
+
+// contains reports whether the time is in any of the windows, or true if there is no window.
+func (w activeWindows) contains(t time.Time) bool {
+	if len(w) == 0 {
+		return true
+	}
+	for _, window := range w {
+		if window.contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w activeWindow) contains(t time.Time) bool {
+	t = t.In(w.location)
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, w.location)
+	offset := t.Sub(midnight)
+	if w.start <= w.end {
+		return w.onDay(t.Weekday()) && offset >= w.start && offset < w.end
+	}
+	// the window spans midnight, so either it started today or it started yesterday
+	if offset >= w.start {
+		return w.onDay(t.Weekday())
+	}
+	return offset < w.end && w.onDay((t.Weekday()+6)%7)
+}
+
+func (w activeWindow) onDay(day time.Weekday) bool {
+	return w.days == 0 || w.days&(1<<uint(day)) != 0
+}
+
+// parseWindow parses an active window like "08:00-18:00" or "08:00-18:00 mon-fri".
+func parseWindow(s string, location *time.Location) (activeWindow, error) {
+	fields := strings.Fields(s)
+	if len(fields) < 1 || len(fields) > 2 {
+		return activeWindow{}, fmt.Errorf("invalid active window %q", s)
+	}
+	startPart, endPart, ok := strings.Cut(fields[0], "-")
+	if !ok {
+		return activeWindow{}, fmt.Errorf("invalid time range of active window %q", s)
+	}
+	window := activeWindow{location: location}
+	var err error
+	if window.start, err = parseClockTime(startPart); err != nil {
+		return activeWindow{}, err
+	}
+	if window.end, err = parseClockTime(endPart); err != nil {
+		return activeWindow{}, err
+	}
+	if len(fields) == 2 {
+		if window.days, err = dowField.parse(fields[1]); err != nil {
+			return activeWindow{}, err
+		}
+		if window.days&(1<<7) != 0 {
+			window.days |= 1
+		}
+	}
+	return window, nil
+}
+
+func parseClockTime(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		if s == "24:00" {
+			return 24 * time.Hour, nil
+		}
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// scheduleOptions are the options of an AutoEvent defined in the ds-autoevents property of its device.
+type scheduleOptions struct {
+	// spec is the schedule replacing the Interval of the AutoEvent, empty if the Interval is used
+	spec    string
+	windows activeWindows
+	// deadband overrides the OnChangeThreshold of the AutoEvent
+	deadband *changeThreshold
+	// heartbeat is the number of consecutive suppressed OnChange cycles after which an event is sent anyway
+	heartbeat int
+}
+
+// autoEventSettings returns the settings of the AutoEvent of the source in the ds-autoevents property of the
+// device, which maps the SourceNames of the AutoEvents to their settings. It returns nil if there is none.
+func autoEventSettings(device models.Device, sourceName string) (map[string]any, error) {
+	v, ok := device.Properties[sdkCommon.AutoEventsProperty]
+	if !ok {
+		return nil, nil
+	}
+	autoEvents, err := cast.ToStringMapE(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s property of device %s: %w", sdkCommon.AutoEventsProperty, device.Name, err)
+	}
+	settings, ok := autoEvents[sourceName]
+	if !ok {
+		return nil, nil
+	}
+	m, err := cast.ToStringMapE(settings)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s settings of AutoEvent %s: %w", sdkCommon.AutoEventsProperty, sourceName, err)
+	}
+	return m, nil
+}
+
+// parseSchedule parses the schedule of an AutoEvent. The Interval of the AutoEvent is a duration string, as it is
+// validated by core-metadata, and the settings of the AutoEvent in the ds-autoevents property of the device add:
+//   - "schedule" replaces the Interval by a wall-clock schedule, either "@aligned <duration>" to fire on the
+//     wall-clock boundaries of the duration, e.g. "@aligned 15m", or a cron expression or descriptor, e.g.
+//     "0 2 * * *" or "@daily"
+//   - "windows" restricts the AutoEvent to active windows "<HH:MM-HH:MM> [days]", e.g. "08:00-18:00 mon-fri"
+//   - "deadband" sets the OnChange threshold, as an absolute value or a percentage, e.g. "2%"
+//   - "heartbeat" sends an OnChange event after N consecutive suppressed cycles, e.g. 10
+//   - "timezone" is the IANA time zone of the wall-clock times, e.g. "Europe/Paris", which defaults to the given location
+func parseSchedule(interval string, settings map[string]any, location *time.Location) (schedule, scheduleOptions, error) {
+	var options scheduleOptions
+	if v, ok := settings[timeZoneKey]; ok {
+		var err error
+		if location, err = time.LoadLocation(cast.ToString(v)); err != nil {
+			return nil, options, fmt.Errorf("invalid time zone %v: %w", v, err)
+		}
+	}
+	if v, ok := settings[windowsKey]; ok {
+		windows, err := cast.ToStringSliceE(v)
+		if s, isString := v.(string); isString {
+			// a single window, which contains spaces
+			windows, err = []string{s}, nil
+		}
+		if err != nil {
+			return nil, options, fmt.Errorf("invalid active windows %v: %w", v, err)
+		}
+		for _, w := range windows {
+			window, err := parseWindow(w, location)
+			if err != nil {
+				return nil, options, err
+			}
+			options.windows = append(options.windows, window)
+		}
+	}
+	if v, ok := settings[deadbandKey]; ok {
+		threshold, err := parseThreshold(cast.ToString(v))
+		if err != nil {
+			return nil, options, err
+		}
+		options.deadband = &threshold
+	}
+	if v, ok := settings[heartbeatKey]; ok {
+		heartbeat, err := cast.ToIntE(v)
+		if err != nil || heartbeat <= 0 {
+			return nil, options, fmt.Errorf("invalid heartbeat %v, expected a positive number of cycles", v)
+		}
+		options.heartbeat = heartbeat
+	}
+
+	options.spec = strings.TrimSpace(cast.ToString(settings[scheduleKey]))
+	if options.spec == "" {
+		d, err := parsePositiveDuration(interval)
+		if err != nil {
+			return nil, options, err
+		}
+		return &intervalSchedule{duration: d}, options, nil
+	}
+	if duration, ok := strings.CutPrefix(options.spec, alignedPrefix+" "); ok {
+		d, err := parsePositiveDuration(strings.TrimSpace(duration))
+		if err != nil {
+			return nil, options, err
+		}
+		return &alignedSchedule{duration: d, location: location}, options, nil
+	}
+	cron, err := parseCron(options.spec, location)
+	if err != nil {
+		return nil, options, err
+	}
+	return cron, options, nil
+}
+
+func parsePositiveDuration(s string) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("duration %s must be positive", s)
+	}
+	return d, nil
+}
@@ -0,0 +1,345 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package autoevent
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	msgMocks "github.com/edgexfoundry/go-mod-messaging/v4/messaging/mocks"
+	"github.com/panjf2000/ants/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/interfaces/mocks"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+// fakeClock only moves forward when advanced by the test.
+type fakeClock struct {
+	mutex     sync.Mutex
+	now       time.Time
+	waiters   []fakeWaiter
+	waiting   chan struct{}
+	deadlines []time.Time
+}
+
+type fakeWaiter struct {
+	deadline time.Time
+	ch       chan time.Time
+}
+
+func newFakeClock(now time.Time) *fakeClock {
+	return &fakeClock{now: now, waiting: make(chan struct{}, 16)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	ch := make(chan time.Time, 1)
+	c.deadlines = append(c.deadlines, c.now.Add(d))
+	if d <= 0 {
+		ch <- c.now
+	} else {
+		c.waiters = append(c.waiters, fakeWaiter{deadline: c.now.Add(d), ch: ch})
+	}
+	c.waiting <- struct{}{}
+	return ch
+}
+
+func (c *fakeClock) lastDeadline() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.deadlines[len(c.deadlines)-1]
+}
+
+// advance moves the clock forward and fires the expired timers.
+func (c *fakeClock) advance(d time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.now = c.now.Add(d)
+	var pending []fakeWaiter
+	for _, w := range c.waiters {
+		if w.deadline.After(c.now) {
+			pending = append(pending, w)
+		} else {
+			w.ch <- c.now
+		}
+	}
+	c.waiters = pending
+}
+
+func date(day int, hour int, minute int, second int) time.Time {
+	// 2026-06-01 is a Monday
+	return time.Date(2026, time.June, day, hour, minute, second, 0, time.UTC)
+}
+
+func TestParseCron_Next(t *testing.T) {
+	tests := []struct {
+		name     string
+		expr     string
+		after    time.Time
+		expected time.Time
+	}{
+		{"every day at 02:00", "0 2 * * *", date(1, 10, 0, 0), date(2, 2, 0, 0)},
+		{"every day at 02:00, just before", "0 2 * * *", date(1, 1, 59, 59), date(1, 2, 0, 0)},
+		{"every quarter hour", "*/15 * * * *", date(1, 10, 7, 0), date(1, 10, 15, 0)},
+		{"weekdays only", "30 9 * * mon-fri", date(5, 10, 0, 0), date(8, 9, 30, 0)},
+		{"sunday as 7", "0 0 * * 7", date(1, 0, 0, 0), date(7, 0, 0, 0)},
+		{"with seconds", "*/10 * * * * *", date(1, 10, 0, 5), date(1, 10, 0, 10)},
+		{"list and range", "0 8-10,14 * * *", date(1, 10, 30, 0), date(1, 14, 0, 0)},
+		{"month name", "0 0 1 jan *", date(1, 0, 0, 0), time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"day of month or day of week", "0 0 15 * mon", date(2, 0, 0, 0), date(8, 0, 0, 0)},
+		{"descriptor", "@hourly", date(1, 10, 30, 0), date(1, 11, 0, 0)},
+		{"never", "0 0 30 2 *", date(1, 0, 0, 0), time.Time{}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := parseCron(testCase.expr, time.UTC)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, s.next(testCase.after))
+		})
+	}
+}
+
+func TestAlignedSchedule_Next(t *testing.T) {
+	tests := []struct {
+		name     string
+		duration time.Duration
+		after    time.Time
+		expected time.Time
+	}{
+		{"quarter hour", 15 * time.Minute, date(1, 10, 7, 30), date(1, 10, 15, 0)},
+		{"on a boundary", 15 * time.Minute, date(1, 10, 15, 0), date(1, 10, 30, 0)},
+		{"daily", 24 * time.Hour, date(1, 10, 0, 0), date(2, 0, 0, 0)},
+		{"restarts at midnight", 7 * time.Hour, date(1, 22, 0, 0), date(2, 0, 0, 0)},
+		{"every other day", 48 * time.Hour, date(1, 10, 0, 0), date(2, 0, 0, 0)},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := &alignedSchedule{duration: testCase.duration, location: time.UTC}
+			assert.Equal(t, testCase.expected, s.next(testCase.after))
+		})
+	}
+}
+
+func TestAlignedSchedule_Next_Location(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	local := func(location *time.Location, month time.Month, day int, hour int, minute int) time.Time {
+		return time.Date(2026, month, day, hour, minute, 0, 0, location)
+	}
+
+	tests := []struct {
+		name     string
+		duration time.Duration
+		location *time.Location
+		after    time.Time
+		expected []time.Time
+	}{
+		// the clock is moved forward from 02:00 to 03:00 on 2026-03-29 and back from 03:00 to 02:00 on 2026-10-25
+		{"clock moved forward", 6 * time.Hour, paris, local(paris, time.March, 29, 0, 0),
+			[]time.Time{local(paris, time.March, 29, 6, 0), local(paris, time.March, 29, 12, 0), local(paris, time.March, 29, 18, 0)}},
+		{"clock moved back", 6 * time.Hour, paris, local(paris, time.October, 25, 0, 0),
+			[]time.Time{local(paris, time.October, 25, 6, 0), local(paris, time.October, 25, 12, 0), local(paris, time.October, 25, 18, 0)}},
+		{"boundary in the gap", 30 * time.Minute, paris, local(paris, time.March, 29, 1, 45),
+			[]time.Time{local(paris, time.March, 29, 3, 0), local(paris, time.March, 29, 3, 30)}},
+		{"repeated hour", time.Hour, paris, local(paris, time.October, 25, 1, 30),
+			[]time.Time{local(paris, time.October, 25, 2, 0), local(paris, time.October, 25, 2, 0).Add(time.Hour), local(paris, time.October, 25, 4, 0)}},
+		{"every other day", 48 * time.Hour, tokyo, local(tokyo, time.June, 1, 10, 0),
+			[]time.Time{local(tokyo, time.June, 2, 0, 0), local(tokyo, time.June, 4, 0, 0)}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := &alignedSchedule{duration: testCase.duration, location: testCase.location}
+			after := testCase.after
+			for _, expected := range testCase.expected {
+				after = s.next(after)
+				assert.True(t, expected.Equal(after), "expected %v, got %v", expected, after)
+			}
+		})
+	}
+}
+
+func TestActiveWindow_Contains(t *testing.T) {
+	tests := []struct {
+		name     string
+		window   string
+		time     time.Time
+		expected bool
+	}{
+		{"inside", "08:00-18:00", date(1, 12, 0, 0), true},
+		{"start is inclusive", "08:00-18:00", date(1, 8, 0, 0), true},
+		{"end is exclusive", "08:00-18:00", date(1, 18, 0, 0), false},
+		{"weekday", "08:00-18:00 mon-fri", date(5, 12, 0, 0), true},
+		{"weekend", "08:00-18:00 mon-fri", date(6, 12, 0, 0), false},
+		{"over midnight, evening", "22:00-06:00 fri", date(5, 23, 0, 0), true},
+		{"over midnight, next morning", "22:00-06:00 fri", date(6, 5, 0, 0), true},
+		{"over midnight, day not started", "22:00-06:00 fri", date(5, 5, 0, 0), false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			w, err := parseWindow(testCase.window, time.UTC)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, w.contains(testCase.time))
+		})
+	}
+}
+
+func TestParseSchedule(t *testing.T) {
+	tests := []struct {
+		name             string
+		interval         string
+		settings         map[string]any
+		expectedSchedule schedule
+		expectedWindows  int
+		errorExpected    bool
+	}{
+		{"duration", "10s", nil, &intervalSchedule{duration: 10 * time.Second}, 0, false},
+		{"aligned", "1h", map[string]any{scheduleKey: "@aligned 15m"}, &alignedSchedule{duration: 15 * time.Minute, location: time.UTC}, 0, false},
+		{"cron", "24h", map[string]any{scheduleKey: "0 2 * * *"}, nil, 0, false},
+		{"descriptor", "24h", map[string]any{scheduleKey: "@daily"}, nil, 0, false},
+		{"window", "1m", map[string]any{windowsKey: "08:00-12:00 mon-fri"}, nil, 1, false},
+		{"windows", "1m", map[string]any{windowsKey: []any{"08:00-12:00 mon-fri", "13:00-18:00 mon-fri"}}, nil, 2, false},
+		{"time zone", "1h", map[string]any{scheduleKey: "@aligned 15m", timeZoneKey: "Asia/Tokyo"}, nil, 0, false},
+		{"invalid duration", "10", nil, nil, 0, true},
+		{"zero duration", "0s", nil, nil, 0, true},
+		{"invalid aligned duration", "1h", map[string]any{scheduleKey: "@aligned 15"}, nil, 0, true},
+		{"invalid cron", "24h", map[string]any{scheduleKey: "0 25 * * *"}, nil, 0, true},
+		{"invalid descriptor", "24h", map[string]any{scheduleKey: "@sometimes"}, nil, 0, true},
+		{"invalid window", "1m", map[string]any{windowsKey: "8-18"}, nil, 0, true},
+		{"invalid time zone", "1h", map[string]any{timeZoneKey: "Nowhere/Unknown"}, nil, 0, true},
+		{"deadband and heartbeat", "1m", map[string]any{deadbandKey: "2%", heartbeatKey: 10}, &intervalSchedule{duration: time.Minute}, 0, false},
+		{"invalid deadband", "1m", map[string]any{deadbandKey: "-1"}, nil, 0, true},
+		{"invalid heartbeat", "1m", map[string]any{heartbeatKey: 0}, nil, 0, true},
+		{"options in Interval", "1m; heartbeat 10", nil, nil, 0, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, options, err := parseSchedule(testCase.interval, testCase.settings, time.UTC)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, s)
+			if testCase.expectedSchedule != nil {
+				assert.Equal(t, testCase.expectedSchedule, s)
+			}
+			assert.Len(t, options.windows, testCase.expectedWindows)
+		})
+	}
+}
+
+func TestParseSchedule_TimeZone(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	// the time zone of the AutoEvent overrides the given location
+	s, options, err := parseSchedule("24h", map[string]any{scheduleKey: "0 2 * * *", windowsKey: "01:00-03:00", timeZoneKey: "Asia/Tokyo"}, time.UTC)
+	require.NoError(t, err)
+	next := s.next(date(1, 0, 0, 0))
+	assert.True(t, next.Equal(time.Date(2026, time.June, 2, 2, 0, 0, 0, tokyo)))
+	assert.True(t, options.windows.contains(next))
+
+	// the cron schedule is in the given location otherwise
+	s, _, err = parseSchedule("24h", map[string]any{scheduleKey: "0 2 * * *"}, tokyo)
+	require.NoError(t, err)
+	assert.True(t, s.next(date(1, 0, 0, 0)).Equal(time.Date(2026, time.June, 2, 2, 0, 0, 0, tokyo)))
+}
+
+func TestAutoEventSettings(t *testing.T) {
+	settings := map[string]any{scheduleKey: "@daily"}
+	tests := []struct {
+		name             string
+		properties       map[string]any
+		expectedSettings map[string]any
+		errorExpected    bool
+	}{
+		{"no property", nil, nil, false},
+		{"other AutoEvent", map[string]any{sdkCommon.AutoEventsProperty: map[string]any{"other": settings}}, nil, false},
+		{"AutoEvent", map[string]any{sdkCommon.AutoEventsProperty: map[string]any{testResource: settings}}, settings, false},
+		{"invalid property", map[string]any{sdkCommon.AutoEventsProperty: "@daily"}, nil, true},
+		{"invalid settings", map[string]any{sdkCommon.AutoEventsProperty: map[string]any{testResource: "@daily"}}, nil, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := autoEventSettings(models.Device{Name: testDevice, Properties: testCase.properties}, testResource)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedSettings, s)
+		})
+	}
+}
+
+func TestExecutor_Run_ActiveWindow(t *testing.T) {
+	driver := &mocks.ProtocolDriver{}
+	driver.On("HandleReadCommands", testDevice, mock.Anything, mock.Anything).
+		Return([]*sdkModels.CommandValue{{DeviceResourceName: testResource, Type: common.ValueTypeInt32, Value: int32(1)}}, nil)
+	dic := mockBatcherDic(t, driver)
+	mcMock := &msgMocks.MessageClient{}
+	mcMock.On("PublishWithSizeLimit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.MessagingClientName: func(get di.Get) any {
+			return mcMock
+		},
+	})
+
+	pool, err := ants.NewPool(runtime.GOMAXPROCS(0))
+	require.NoError(t, err)
+	defer pool.Release()
+	device := models.Device{
+		Name: testDevice,
+		Properties: map[string]any{sdkCommon.AutoEventsProperty: map[string]any{
+			testResource: map[string]any{scheduleKey: "@aligned 30m", windowsKey: []string{"08:00-09:00"}},
+		}},
+	}
+	e, err := NewExecutor(device, models.AutoEvent{SourceName: testResource, Interval: "1h"}, time.UTC, pool)
+	require.NoError(t, err)
+	assert.Equal(t, "@aligned 30m", e.Status().Schedule)
+	clock := newFakeClock(date(1, 7, 10, 0))
+	e.clock = clock
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	go func() {
+		e.Run(ctx, &wg, make(chan bool, 1), dic)
+		close(done)
+	}()
+
+	// ticks at 07:30, 08:00, 08:30, 09:00 and 09:30, only the ones at 08:00 and 08:30 are in the window
+	for i := 0; i < 5; i++ {
+		<-clock.waiting
+		clock.advance(30 * time.Minute)
+	}
+	<-clock.waiting
+	driver.AssertNumberOfCalls(t, "HandleReadCommands", 2)
+
+	cancel()
+	<-done
+}
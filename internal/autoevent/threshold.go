@@ -0,0 +1,67 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package autoevent
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/cache"
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+)
+
+// changeThreshold is the deadband of a numeric reading, the reading is only reported by an OnChange
+// AutoEvent when it moves further than the threshold away from the last reported value.
+type changeThreshold struct {
+	value float64
+	// percent specifies that the value is a percentage of the last reported value
+	percent bool
+}
+
+// parseThreshold parses an absolute threshold like "0.5" or a percentage like "2%".
+func parseThreshold(s string) (changeThreshold, error) {
+	valueString, percent := strings.CutSuffix(strings.TrimSpace(s), "%")
+	value, err := strconv.ParseFloat(strings.TrimSpace(valueString), 64)
+	if err != nil || value < 0 || math.IsNaN(value) {
+		return changeThreshold{}, fmt.Errorf("invalid OnChange threshold %q, expected a non-negative number or percentage", s)
+	}
+	return changeThreshold{value: value, percent: percent}, nil
+}
+
+// exceeded reports whether the change from the last reported value is beyond the threshold.
+// Any change of a zero value exceeds a percentage threshold.
+func (t changeThreshold) exceeded(last float64, current float64) bool {
+	diff := math.Abs(current - last)
+	if t.percent {
+		return diff > math.Abs(last)*t.value/100
+	}
+	return diff > t.value
+}
+
+// resourceThreshold returns the threshold defined by the ds-onchangethreshold attribute of the DeviceResource.
+func resourceThreshold(profileName string, resourceName string) (changeThreshold, bool) {
+	if profileName == "" {
+		return changeThreshold{}, false
+	}
+	dr, ok := cache.Profiles().DeviceResource(profileName, resourceName)
+	if !ok {
+		return changeThreshold{}, false
+	}
+	v, ok := dr.Attributes[sdkCommon.OnChangeThresholdAttribute]
+	if !ok {
+		return changeThreshold{}, false
+	}
+	threshold, err := parseThreshold(cast.ToString(v))
+	if err != nil {
+		return changeThreshold{}, false
+	}
+	return threshold, true
+}
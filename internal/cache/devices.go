@@ -1,6 +1,6 @@
 // -*- Mode: Go; indent-tabs-mode: t -*-
 //
-// Copyright (C) 2020-2025 IOTech Ltd
+// Copyright (C) 2020-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -212,11 +212,16 @@ func (d *deviceCache) SetLastConnectedByName(name string) {
 	d.mutex.RLock()
 	defer d.mutex.RUnlock()
 
-	g := d.lastConnected[name]
-	g.Update(currentTimestamp())
+	// the device may be unknown, e.g. if the AsyncValues of a removed device are received
+	if g, ok := d.lastConnected[name]; ok {
+		g.Update(currentTimestamp())
+	}
 }
 
 func (d *deviceCache) GetLastConnectedByName(name string) int64 {
-	g := d.lastConnected[name]
+	g, ok := d.lastConnected[name]
+	if !ok {
+		return 0
+	}
 	return g.Value()
 }
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021-2023 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -176,6 +176,9 @@ func Test_deviceCache_SetLastConnectedByName(t *testing.T) {
 	dc.SetLastConnectedByName(TestDevice)
 	lastConnectedTime := dc.GetLastConnectedByName(TestDevice)
 	require.Equal(t, currentTimeInstant, lastConnectedTime)
+
+	dc.SetLastConnectedByName("unknown")
+	require.Equal(t, int64(0), dc.GetLastConnectedByName("unknown"))
 }
 
 func Test_deviceCache_GetLastConnectedByName(t *testing.T) {
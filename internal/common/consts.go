@@ -1,16 +1,171 @@
 // -*- mode: Go; indent-tabs-mode: t -*-
 //
 // Copyright (C) 2017-2018 Canonical Ltd
-// Copyright (C) 2018-2022 IOTech Ltd
+// Copyright (C) 2018-2026 IOTech Ltd
 // Copyright (c) 2019 Intel Corporation
 //
 // SPDX-License-Identifier: Apache-2.0
 
 package common
 
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
 const (
 	URLRawQuery       = "urlRawQuery"
 	SDKReservedPrefix = "ds-"
+	Source            = "source"
+)
+
+// REST routes provided by the SDK in addition to the core contracts
+const (
+	// ApiEventBufferRoute reports the backlog of the store-and-forward event buffer
+	ApiEventBufferRoute = common.ApiBase + "/eventbuffer"
+	// ApiAutoEventRoute reports the runtime status of the AutoEvents of all the devices
+	ApiAutoEventRoute = common.ApiBase + "/autoevent"
+	// ApiAutoEventByDeviceNameRoute reports the runtime status of the AutoEvents of a device
+	ApiAutoEventByDeviceNameRoute = ApiAutoEventRoute + "/" + common.Device + "/" + common.Name + "/:" + common.Name
+	// ApiAutoEventSourceRoute identifies the AutoEvent of a device by its source
+	ApiAutoEventSourceRoute = ApiAutoEventByDeviceNameRoute + "/" + Source + "/:" + common.SourceName
+	// ApiAutoEventPauseRoute, ApiAutoEventResumeRoute and ApiAutoEventTriggerRoute control the AutoEvent of a device
+	// at runtime without updating Core Metadata
+	ApiAutoEventPauseRoute   = ApiAutoEventSourceRoute + "/pause"
+	ApiAutoEventResumeRoute  = ApiAutoEventSourceRoute + "/resume"
+	ApiAutoEventTriggerRoute = ApiAutoEventSourceRoute + "/trigger"
+	// ApiBatchCommandRoute executes the read and write commands of multiple devices in one request
+	ApiBatchCommandRoute = common.ApiBase + "/" + common.Device + "/batch"
+	// ApiFaultInjectionRoute reports, overrides and resets the faults injected into the commands of the ProtocolDriver
+	ApiFaultInjectionRoute = common.ApiBase + "/faultinjection"
+)
+
+// MessageBus topics subscribed by the SDK in addition to the core contracts
+const (
+	// BatchCommandRequestTopic receives the batch command requests, next to the command requests of
+	// common.CommandRequestSubscribeTopic. <DeviceServiceName> is appended.
+	BatchCommandRequestTopic = "device/batchcommand/request"
+)
+
+// DeviceResource attributes interpreted by the SDK
+const (
+	// CommandTimeoutAttribute overrides Device.CommandTimeout for the read or write of a DeviceResource
+	CommandTimeoutAttribute = SDKReservedPrefix + "commandtimeout"
+	// OnChangeThresholdAttribute overrides the OnChange threshold of the AutoEvents for a DeviceResource,
+	// as an absolute value like "0.5" or a percentage of the last reported value like "2%"
+	OnChangeThresholdAttribute = SDKReservedPrefix + "onchangethreshold"
+	// ReadExpressionAttribute is an arithmetic expression applied to a read value after the fixed transforms,
+	// e.g. "value * value * 0.002 + 1.5" or "hi * 65536 + lo" to combine the values of the DeviceResources hi and lo.
+	// The expressions are evaluated in float64, so the Int64 and Uint64 DeviceResources can't have one.
+	ReadExpressionAttribute = SDKReservedPrefix + "readexpression"
+	// WriteExpressionAttribute is the inverse of ReadExpressionAttribute, applied to a written value before the fixed transforms
+	WriteExpressionAttribute = SDKReservedPrefix + "writeexpression"
+	// VirtualResourceAttribute makes a DeviceResource virtual, i.e. computed by the SDK instead of read by the driver,
+	// with one of the functions "sum", "average", "min", "max", "delta" or "expression"
+	VirtualResourceAttribute = SDKReservedPrefix + "virtual"
+	// VirtualSourcesAttribute lists the DeviceResources a virtual DeviceResource is computed from, comma-separated.
+	// It defaults to the DeviceResources referred by the ReadExpressionAttribute of an "expression" virtual DeviceResource.
+	VirtualSourcesAttribute = SDKReservedPrefix + "virtualsources"
+	// CalibrationAttribute is the calibration table of a DeviceResource, as "x:y" points like "0:-40, 512:25, 1023:80"
+	// or a list of [x, y] points, which is linearly interpolated between the points
+	CalibrationAttribute = SDKReservedPrefix + "calibration"
+	// CalibrationModeAttribute is CalibrationClamp (default) or CalibrationExtrapolate for the values out of the table
+	CalibrationModeAttribute = SDKReservedPrefix + "calibrationmode"
+	// BitFieldWordAttribute makes a DeviceResource a bit field of the integer DeviceResource it names, i.e. decoded
+	// from the raw value of that word on read and merged into it with a read-modify-write on write
+	BitFieldWordAttribute = SDKReservedPrefix + "bitfieldword"
+	// BitFieldOffsetAttribute is the position of the least significant bit of the bit field in the word, 0 by default
+	BitFieldOffsetAttribute = SDKReservedPrefix + "bitfieldoffset"
+	// BitFieldWidthAttribute is the number of bits of the bit field, 1 by default
+	BitFieldWidthAttribute = SDKReservedPrefix + "bitfieldwidth"
+	// TargetUnitAttribute is the unit the read values of a DeviceResource are converted to from its Units, and the
+	// written values are converted from
+	TargetUnitAttribute = SDKReservedPrefix + "targetunit"
+)
+
+// DeviceResource attributes of the validation rules of the read values, each with an action of the ValidationAction values
+const (
+	// ValidMinimumAttribute and ValidMaximumAttribute define the plausibility range of the read values
+	ValidMinimumAttribute     = SDKReservedPrefix + "validmin"
+	ValidMaximumAttribute     = SDKReservedPrefix + "validmax"
+	ValidRangeActionAttribute = SDKReservedPrefix + "validrangeaction"
+	// MaxRateOfChangeAttribute is the maximum change per second between consecutive read values
+	MaxRateOfChangeAttribute       = SDKReservedPrefix + "maxrateofchange"
+	MaxRateOfChangeActionAttribute = SDKReservedPrefix + "maxrateofchangeaction"
+	// StuckCountAttribute is the number of consecutive identical read values from which the value is considered stuck
+	StuckCountAttribute  = SDKReservedPrefix + "stuckcount"
+	StuckActionAttribute = SDKReservedPrefix + "stuckaction"
+)
+
+// actions on the read values failing a validation rule
+const (
+	// ValidationActionBad marks the quality of the reading bad, which is the default action
+	ValidationActionBad = "bad"
+	// ValidationActionDrop drops the reading from the Event. A GET command whose readings are all dropped
+	// responds without Event rather than failing.
+	ValidationActionDrop = "drop"
+	// ValidationActionEvent publishes a System Event and keeps the reading as is
+	ValidationActionEvent = "event"
+	// ValidationActionDown marks the device operating state DOWN and the quality of the reading bad
+	ValidationActionDown = "down"
+	// ReadingValidationSystemEventAction is the action of the System Event published by ValidationActionEvent
+	ReadingValidationSystemEventAction = "readingvalidation"
+)
+
+// modes of the calibration table
+const (
+	CalibrationClamp       = "clamp"
+	CalibrationExtrapolate = "extrapolate"
+)
+
+// VerifyWriteParameter enables the read-back verification of a SET command. It is a query parameter, which overrides
+// the DeviceResource attribute or DeviceCommand tag of the same name, and its value is "true", "false" or
+// VerifyWriteRestore to write back the previous values if the written values are not verified.
+const (
+	VerifyWriteParameter = SDKReservedPrefix + "verify"
+	VerifyWriteRestore   = "restore"
+)
+
+// UnitsParameter overrides the target units of a command. It is a query parameter, which overrides the UnitsProperty
+// of the device and the TargetUnitAttribute of the DeviceResources, and its value is a comma-separated list of
+// either units like "degC", applied to the DeviceResources of the same quantity, or "resource:unit" pairs.
+const UnitsParameter = SDKReservedPrefix + "units"
+
+// Device properties interpreted by the SDK
+const (
+	// MaxConcurrentCommandsProperty overrides Device.MaxConcurrentCommands for a single device
+	MaxConcurrentCommandsProperty = SDKReservedPrefix + "maxconcurrentcommands"
+	// CalibrationProperty overrides the calibration tables of a single device, as a map of DeviceResource names to
+	// either a table or an object with the "points" table and the "mode"
+	CalibrationProperty = SDKReservedPrefix + "calibration"
+	// OriginPolicyProperty overrides Writable.Reading.OriginPolicy for a single device
+	OriginPolicyProperty = SDKReservedPrefix + "originpolicy"
+	// UnitsProperty overrides the TargetUnitAttribute of the DeviceResources for a single device, either in the
+	// format of UnitsParameter or as a map of DeviceResource names to units
+	UnitsProperty = SDKReservedPrefix + "units"
+	// AutoEventsProperty schedules the AutoEvents of a single device beyond their Interval, as a map of AutoEvent
+	// SourceNames to the "schedule", "windows", "deadband", "heartbeat" and "timezone" of the AutoEvent
+	AutoEventsProperty = SDKReservedPrefix + "autoevents"
+)
+
+// policies of the Origin of the Events and Readings
+const (
+	// OriginPolicyDriver keeps the Origin set by the ProtocolDriver, and falls back to the SDK clock. It's the default.
+	OriginPolicyDriver = "driver"
+	// OriginPolicySDK stamps the Event and all its Readings with the SDK clock
+	OriginPolicySDK = "sdk"
+	// OriginPolicyAligned stamps the Event and all its Readings with the scheduled time of the AutoEvent, and falls
+	// back to the SDK clock for the other reads
+	OriginPolicyAligned = "aligned"
+	// OriginPolicyDevice keeps the Origin set by the ProtocolDriver from the device clock, corrected by the estimated
+	// offset of the device clock to the SDK clock
+	OriginPolicyDevice = "device"
+)
+
+// Event tags reporting the origin policy, which are only added for the policies other than OriginPolicyDriver
+const (
+	OriginPolicyTag = SDKReservedPrefix + "originpolicy"
+	// ClockOffsetTag is the correction in nanoseconds applied to the device clock by OriginPolicyDevice
+	ClockOffsetTag = SDKReservedPrefix + "clockoffset"
 )
 
 // SDKVersion indicates the version of the SDK - will be overwritten by build
@@ -0,0 +1,132 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+
+	gometrics "github.com/rcrowley/go-metrics"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/eventbuffer"
+)
+
+const (
+	eventBufferBacklogName    = "EventBufferBacklog"
+	eventBufferBacklogAgeName = "EventBufferBacklogAge"
+	eventBufferDroppedName    = "EventBufferDropped"
+
+	defaultEventBufferRetryInterval = 5 * time.Second
+)
+
+// InitializeEventBuffer opens the store-and-forward event buffer if Device.EventBuffer is enabled, and starts
+// replaying the buffered events to the MessageBus. It returns false if the buffer is enabled but cannot be opened.
+func InitializeEventBuffer(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) bool {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	configuration := container.ConfigurationFrom(dic.Get)
+	bufferConfig := configuration.Device.EventBuffer
+	if !bufferConfig.Enabled {
+		return true
+	}
+
+	retryInterval := defaultEventBufferRetryInterval
+	if bufferConfig.RetryInterval != "" {
+		var err error
+		retryInterval, err = time.ParseDuration(bufferConfig.RetryInterval)
+		if err != nil || retryInterval <= 0 {
+			lc.Errorf("Invalid Device.EventBuffer.RetryInterval %s", bufferConfig.RetryInterval)
+			return false
+		}
+	}
+
+	buffer, err := eventbuffer.Open(bufferConfig.Dir, bufferConfig.MaxSize, bufferConfig.SegmentSize)
+	if err != nil {
+		lc.Errorf("Failed to open event buffer: %v", err)
+		return false
+	}
+	dic.Update(di.ServiceConstructorMap{
+		container.EventBufferName: func(get di.Get) any {
+			return buffer
+		},
+	})
+	registerEventBufferMetrics(buffer, lc, dic)
+	lc.Infof("Event buffer opened in %s with %d event(s) to replay", bufferConfig.Dir, buffer.Len())
+
+	mc := bootstrapContainer.MessagingClientFrom(dic.Get)
+	publish := func(record eventbuffer.Record) error {
+		err := mc.PublishWithSizeLimit(record.Envelope, record.Topic, configuration.MaxEventSize)
+		if err == nil {
+			incrementSentMetrics(record.Readings)
+		}
+		return err
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		buffer.Forward(ctx, retryInterval, publish, lc)
+		if err := buffer.Close(); err != nil {
+			lc.Errorf("Failed to close event buffer: %v", err)
+		}
+	}()
+	return true
+}
+
+func bufferEvent(buffer *eventbuffer.Buffer, envelope types.MessageEnvelope, topic string, event *dtos.Event, maxEventSize int64, lc logger.LoggingClient) {
+	// an event rejected for its size would block the replay of the buffer forever
+	if maxEventSize > 0 {
+		data, err := json.Marshal(envelope)
+		if err == nil && int64(len(data)) > maxEventSize*1024 {
+			lc.Errorf("Event(profileName: %s, deviceName: %s, sourceName: %s, id: %s) exceeds MaxEventSize(%d KB) and is not buffered",
+				event.ProfileName, event.DeviceName, event.SourceName, event.Id, maxEventSize)
+			return
+		}
+	}
+	err := buffer.Append(topic, envelope, len(event.Readings))
+	if err != nil {
+		lc.Errorf("Failed to buffer event(profileName: %s, deviceName: %s, sourceName: %s, id: %s), the event is dropped: %v",
+			event.ProfileName, event.DeviceName, event.SourceName, event.Id, err)
+		return
+	}
+	lc.Debugf("Event(profileName: %s, deviceName: %s, sourceName: %s, id: %s) buffered to be published on topic: %s",
+		event.ProfileName, event.DeviceName, event.SourceName, event.Id, topic)
+}
+
+func registerEventBufferMetrics(buffer *eventbuffer.Buffer, lc logger.LoggingClient, dic *di.Container) {
+	metricsManager := bootstrapContainer.MetricsManagerFrom(dic.Get)
+	if metricsManager == nil {
+		lc.Warn("MetricsManager not available to register Event Buffer metrics")
+		return
+	}
+
+	backlog := gometrics.NewFunctionalGauge(func() int64 {
+		return int64(buffer.Stats().Count)
+	})
+	// the age of the oldest buffered event in milliseconds
+	backlogAge := gometrics.NewFunctionalGauge(func() int64 {
+		stats := buffer.Stats()
+		if stats.OldestTimestamp == 0 {
+			return 0
+		}
+		return time.Since(time.Unix(0, stats.OldestTimestamp)).Milliseconds()
+	})
+	dropped := gometrics.NewFunctionalGauge(func() int64 {
+		return buffer.Stats().Dropped
+	})
+	registerMetric(metricsManager, lc, eventBufferBacklogName, backlog)
+	registerMetric(metricsManager, lc, eventBufferBacklogAgeName, backlogAge)
+	registerMetric(metricsManager, lc, eventBufferDroppedName, dropped)
+}
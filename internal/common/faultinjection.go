@@ -0,0 +1,69 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"sync"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/config"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/faultinjection"
+)
+
+// InitializeFaultInjection wraps the ProtocolDriver of the DIC with a faultinjection.Injector if
+// Device.EnableFaultInjection is true. The faults are the ones of Writable.FaultInjection, unless they are
+// overridden by the fault injection REST API, and the hanging commands are released when the context is done. The
+// injector is the ContextProtocolDriver of the DIC even if the ProtocolDriver doesn't implement it, so that the
+// faults are injected within the deadline of the commands.
+func InitializeFaultInjection(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	if !container.ConfigurationFrom(dic.Get).Device.EnableFaultInjection {
+		return
+	}
+
+	settings := func() faultinjection.Settings {
+		return faultInjectionSettings(container.ConfigurationFrom(dic.Get).Writable.FaultInjection)
+	}
+	injector := faultinjection.NewInjector(container.ProtocolDriverFrom(dic.Get), settings, lc)
+	dic.Update(di.ServiceConstructorMap{
+		container.ProtocolDriverName: func(get di.Get) any {
+			return injector
+		},
+		container.ContextProtocolDriverName: func(get di.Get) any {
+			return injector
+		},
+		container.FaultInjectorName: func(get di.Get) any {
+			return injector
+		},
+	})
+	lc.Warn("Fault injection is enabled, the commands of the ProtocolDriver may fail on purpose")
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-ctx.Done()
+		injector.Close()
+	}()
+}
+
+// faultInjectionSettings converts the fault injection configuration to the Settings of the fault injector
+func faultInjectionSettings(info config.FaultInjectionInfo) faultinjection.Settings {
+	return faultinjection.Settings{
+		Devices:               info.Devices,
+		Resources:             info.Resources,
+		ErrorRate:             info.ErrorRate,
+		Latency:               info.Latency,
+		HangRate:              info.HangRate,
+		HangDuration:          info.HangDuration,
+		MalformedValueRate:    info.MalformedValueRate,
+		WrongResourceNameRate: info.WrongResourceNameRate,
+	}
+}
@@ -0,0 +1,72 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/recording"
+)
+
+// InitializeRecorder wraps the ProtocolDriver of the DIC with a recording.Recorder if Device.Recording is enabled,
+// so that the read and write commands are appended to the recording file until the context is done. The file is
+// closed once the commands being executed are recorded. It returns false if the recording is enabled but the file
+// cannot be opened.
+func InitializeRecorder(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) bool {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	recordingConfig := container.ConfigurationFrom(dic.Get).Device.Recording
+	if !recordingConfig.Enabled {
+		return true
+	}
+
+	if recordingConfig.File == "" {
+		lc.Error("Device.Recording.File must be specified when the recording is enabled")
+		return false
+	}
+	if err := os.MkdirAll(filepath.Dir(recordingConfig.File), 0750); err != nil {
+		lc.Errorf("Failed to create the directory of the recording file %s: %v", recordingConfig.File, err)
+		return false
+	}
+	file, err := os.OpenFile(recordingConfig.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0640)
+	if err != nil {
+		lc.Errorf("Failed to open the recording file %s: %v", recordingConfig.File, err)
+		return false
+	}
+
+	recorder := recording.NewRecorder(container.ProtocolDriverFrom(dic.Get), file, lc)
+	recorder.RedactProtocolProperties(recordingConfig.RedactProtocolProperties...)
+	constructors := di.ServiceConstructorMap{
+		container.ProtocolDriverName: func(get di.Get) any {
+			return recorder
+		},
+	}
+	if container.ContextProtocolDriverFrom(dic.Get) != nil {
+		constructors[container.ContextProtocolDriverName] = func(get di.Get) any {
+			return recorder
+		}
+	}
+	dic.Update(constructors)
+	lc.Infof("Recording the commands of the ProtocolDriver to %s", recordingConfig.File)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-ctx.Done()
+		recorder.StopRecording()
+		if err := file.Close(); err != nil {
+			lc.Errorf("Failed to close the recording file %s: %v", recordingConfig.File, err)
+		}
+	}()
+	return true
+}
@@ -0,0 +1,76 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/config"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/interfaces/mocks"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/recording"
+)
+
+func TestInitializeRecorder(t *testing.T) {
+	tests := []struct {
+		name     string
+		enabled  bool
+		file     string
+		success  bool
+		recorded bool
+	}{
+		{"disabled", false, "", true, false},
+		{"enabled", true, filepath.Join(t.TempDir(), "recording", "commands.jsonl"), true, true},
+		{"enabled without file", true, "", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			driver := mocks.NewProtocolDriver(t)
+			dic := NewMockDIC()
+			dic.Update(di.ServiceConstructorMap{
+				container.ConfigurationName: func(get di.Get) any {
+					return &config.ConfigurationStruct{
+						Device: config.DeviceInfo{Recording: config.RecordingInfo{Enabled: tt.enabled, File: tt.file}},
+					}
+				},
+				container.ProtocolDriverName: func(get di.Get) any {
+					return driver
+				},
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			var wg sync.WaitGroup
+			require.Equal(t, tt.success, InitializeRecorder(ctx, &wg, dic))
+			if !tt.recorded {
+				cancel()
+				assert.Equal(t, driver, container.ProtocolDriverFrom(dic.Get))
+				return
+			}
+
+			driver.On("HandleReadCommands", "device", mock.Anything, mock.Anything).Return(nil, nil)
+			_, err := container.ProtocolDriverFrom(dic.Get).HandleReadCommands("device", nil, []sdkModels.CommandRequest{{DeviceResourceName: "resource"}})
+			require.NoError(t, err)
+			assert.Nil(t, container.ContextProtocolDriverFrom(dic.Get), "expect no ContextProtocolDriver if the driver doesn't implement it")
+			cancel()
+			wg.Wait()
+
+			records, err := recording.ReadFile(tt.file)
+			require.NoError(t, err)
+			require.Len(t, records, 1)
+			assert.Equal(t, "device", records[0].DeviceName)
+		})
+	}
+}
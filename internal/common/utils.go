@@ -1,7 +1,7 @@
 // -*- Mode: Go; indent-tabs-mode: t -*-
 //
 // Copyright (C) 2017-2018 Canonical Ltd
-// Copyright (C) 2018-2025 IOTech Ltd
+// Copyright (C) 2018-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -9,6 +9,7 @@ package common
 
 import (
 	"context"
+	"sync"
 
 	"github.com/edgexfoundry/device-sdk-go/v4/internal/cache"
 	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
@@ -36,6 +37,9 @@ const (
 var eventsSent gometrics.Counter
 var readingsSent gometrics.Counter
 
+// sentMetricsMutex guards the sent metrics, which are replaced when a device service of the process is bootstrapped
+var sentMetricsMutex sync.RWMutex
+
 func UpdateOperatingState(name string, state string, lc logger.LoggingClient, dc interfaces.DeviceClient) {
 	device := dtos.UpdateDevice{
 		Name:           &name,
@@ -64,24 +68,44 @@ func SendEvent(event *dtos.Event, correlationID string, dic *di.Container) {
 	publishTopic := common.NewPathBuilder().EnableNameFieldEscape(configuration.Service.EnableNameFieldEscape).
 		SetPath(configuration.MessageBus.GetBaseTopicPrefix()).SetPath(common.EventsPublishTopic).SetPath(DeviceServiceEventPrefix).
 		SetNameFieldPath(serviceName).SetNameFieldPath(event.ProfileName).SetNameFieldPath(event.DeviceName).SetNameFieldPath(event.SourceName).BuildPath()
-	err := mc.PublishWithSizeLimit(envelope, publishTopic, configuration.MaxEventSize)
+	publish := func() error {
+		return mc.PublishWithSizeLimit(envelope, publishTopic, configuration.MaxEventSize)
+	}
+	var err error
+	if buffer := container.EventBufferFrom(dic.Get); buffer != nil {
+		// the events are queued behind the backlog to keep them in order
+		sent, err = buffer.Send(publish, func() {
+			bufferEvent(buffer, envelope, publishTopic, event, configuration.MaxEventSize, lc)
+		})
+	} else {
+		err = publish()
+		sent = err == nil
+	}
 	if err != nil {
 		lc.Errorf("Failed to publish event to MessageBus: %s", err)
+	}
+	if !sent {
 		return
 	}
 	lc.Debugf("Event(profileName: %s, deviceName: %s, sourceName: %s, id: %s) published to MessageBus on topic: %s",
 		event.ProfileName, event.DeviceName, event.SourceName, event.Id, publishTopic)
-	sent = true
+	incrementSentMetrics(len(event.Readings))
+}
 
-	if sent && eventsSent != nil && readingsSent != nil {
+func incrementSentMetrics(readings int) {
+	sentMetricsMutex.RLock()
+	defer sentMetricsMutex.RUnlock()
+	if eventsSent != nil && readingsSent != nil {
 		eventsSent.Inc(1)
-		readingsSent.Inc(int64(len(event.Readings)))
+		readingsSent.Inc(int64(readings))
 	}
 }
 
 func InitializeSentMetrics(lc logger.LoggingClient, dic *di.Container) {
+	sentMetricsMutex.Lock()
 	eventsSent = gometrics.NewCounter()
 	readingsSent = gometrics.NewCounter()
+	sentMetricsMutex.Unlock()
 
 	metricsManager := bootstrapContainer.MetricsManagerFrom(dic.Get)
 	if metricsManager != nil {
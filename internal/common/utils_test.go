@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2022-2023 IOTech Ltd
+// Copyright (C) 2022-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -8,7 +8,9 @@ package common
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces/mocks"
@@ -352,3 +354,48 @@ func TestAddEventTags(t *testing.T) {
 		})
 	}
 }
+
+func TestSendEvent_EventBuffer(t *testing.T) {
+	event := buildEvent()
+	dic := NewMockDIC()
+	mcMock := &msgMocks.MessageClient{}
+	// the MessageBus is unavailable for the first event and the first replay
+	mcMock.On("PublishWithSizeLimit", mock.Anything, mock.Anything, int64(0)).Return(errors.New("connection lost")).Twice()
+	mcMock.On("PublishWithSizeLimit", mock.Anything, mock.Anything, int64(0)).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Device: config.DeviceInfo{
+					EventBuffer: config.EventBufferInfo{
+						Enabled:       true,
+						Dir:           t.TempDir(),
+						MaxSize:       1024 * 1024,
+						SegmentSize:   64 * 1024,
+						RetryInterval: "10ms",
+					},
+				},
+			}
+		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return mcMock
+		},
+	})
+	InitializeSentMetrics(logger.NewMockClient(), dic)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	require.True(t, InitializeEventBuffer(ctx, &wg, dic))
+	buffer := container.EventBufferFrom(dic.Get)
+	require.NotNil(t, buffer)
+
+	SendEvent(&event, testUUIDString, dic)
+	// the following events are queued behind the backlog
+	SendEvent(&event, testUUIDString, dic)
+
+	require.Eventually(t, func() bool { return buffer.Len() == 0 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, int64(2), eventsSent.Count())
+	assert.Equal(t, int64(2), readingsSent.Count())
+
+	cancel()
+	wg.Wait()
+}
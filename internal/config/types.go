@@ -1,7 +1,7 @@
 // -*- mode: Go; indent-tabs-mode: t -*-
 //
 // Copyright (C) 2017-2018 Canonical Ltd
-// Copyright (C) 2018-2025 IOTech Ltd
+// Copyright (C) 2018-2026 IOTech Ltd
 // Copyright (c) 2021 Intel Corporation
 //
 // SPDX-License-Identifier: Apache-2.0
@@ -19,12 +19,17 @@ type WritableInfo struct {
 	InsecureSecrets config.InsecureSecrets
 	Reading         Reading
 	Telemetry       config.TelemetryInfo
+	// FaultInjection specifies the faults injected into the commands when Device.EnableFaultInjection is true
+	FaultInjection FaultInjectionInfo
 }
 
 // Reading is a struct which contains reading configuration settings.
 type Reading struct {
 	// ReadingUnits specifies whether or not to indicate the units of measure for the value in the reading
 	ReadingUnits bool
+	// OriginPolicy is how the Origin of the Events and Readings is set, either "driver" (default), "sdk", "aligned"
+	// to the schedule of the AutoEvents, or "device" for the device clock corrected for its offset
+	OriginPolicy string
 }
 
 // DeviceInfo is a struct which contains device specific configuration settings.
@@ -57,6 +62,39 @@ type DeviceInfo struct {
 	AllowedFails uint
 	// DeviceDownTimeout specifies the duration in seconds that the Device Service will try to contact a device if it is marked as down.
 	DeviceDownTimeout uint
+	// CommandTimeout specifies the maximum duration of a single read or write command issued to the ProtocolDriver.
+	// It represents as a duration string, and no deadline is applied if it is empty or zero.
+	CommandTimeout string
+	// MaxConcurrentCommands limits the number of read and write commands that the ProtocolDriver executes
+	// concurrently for a single device, 1 serializes the commands and 0 means unlimited. It can be overridden
+	// per device with the ds-maxconcurrentcommands property in the device Properties or Protocols.
+	MaxConcurrentCommands int
+	// AutoEventCoalescingWindow specifies the window, represented as a duration string, within which the AutoEvents of
+	// a device firing together are read with a single batched driver call, e.g. "10ms". It defaults to zero, which
+	// disables the coalescing.
+	AutoEventCoalescingWindow string
+	// AutoEventPhaseDistribution spreads the start phases of the interval AutoEvents of the devices, so that the devices
+	// are not all polled at the same time. It is "none" (default), "random", or "hash" for a stable phase derived from
+	// the device name. All the AutoEvents of a device share the same phase.
+	AutoEventPhaseDistribution string
+	// AutoEventMaxJitter limits the start phase offset, represented as a duration string. The shortest interval of the
+	// AutoEvents of the device is used if it is empty.
+	AutoEventMaxJitter string
+	// AutoEventMaxBackoff enables the exponential backoff of an AutoEvent after consecutive read failures, and caps
+	// the delay added to its schedule. It represents as a duration string, and zero or empty disables the backoff.
+	AutoEventMaxBackoff string
+	// AutoEventTimeZone specifies the IANA time zone, e.g. "Europe/Paris", of the wall-clock schedules and active
+	// windows of the AutoEvents, which can be overridden per AutoEvent in the ds-autoevents device property. The local
+	// time zone of the service is used if it is empty.
+	AutoEventTimeZone string
+	// MaxBatchCommandConcurrency limits the number of commands of a batch command request which are executed
+	// concurrently. It defaults to 8 if it is zero.
+	MaxBatchCommandConcurrency int
+	EventBuffer                EventBufferInfo
+	Recording                  RecordingInfo
+	// EnableFaultInjection wraps the ProtocolDriver with a fault injector, whose faults are specified by
+	// Writable.FaultInjection or the fault injection REST API. It must not be enabled in production.
+	EnableFaultInjection bool
 }
 
 // DiscoveryInfo is a struct which contains configuration of device auto discovery.
@@ -68,6 +106,53 @@ type DiscoveryInfo struct {
 	Interval string
 }
 
+// EventBufferInfo is a struct which contains configuration of the store-and-forward buffer of the events
+// which failed to be published to the MessageBus.
+type EventBufferInfo struct {
+	// Enabled controls whether or not the events failed to be published are buffered on disk and replayed.
+	Enabled bool
+	// Dir specifies the directory which contains the segment files of the buffer.
+	Dir string
+	// MaxSize is the maximum disk usage of the buffer in bytes. The oldest events are dropped once it is exceeded.
+	MaxSize int64
+	// SegmentSize is the size in bytes of a single segment file, which is the unit of dropping the oldest events.
+	SegmentSize int64
+	// RetryInterval indicates how often the publishing of the buffered events is retried while the MessageBus
+	// is unavailable. It represents as a duration string.
+	RetryInterval string
+}
+
+// RecordingInfo is a struct which contains configuration of the recording of the read and write commands
+// executed by the ProtocolDriver, which can be replayed to reproduce the behavior of the devices.
+type RecordingInfo struct {
+	// Enabled controls whether or not the commands are recorded.
+	Enabled bool
+	// File specifies the file which the commands are appended to, as JSON lines.
+	File string
+	// RedactProtocolProperties lists the protocol properties whose values are redacted from the recording, like
+	// the credentials of the devices. "*" redacts all of them.
+	RedactProtocolProperties []string
+}
+
+// FaultInjectionInfo is a struct which contains the faults injected into the read and write commands of the
+// ProtocolDriver. The rates are the probabilities between 0 and 1 that a command or a read value is faulty.
+type FaultInjectionInfo struct {
+	// Devices and Resources limit the faults to the commands of the devices and DeviceResources, all if empty.
+	Devices   []string
+	Resources []string
+	// ErrorRate is the probability that a command fails.
+	ErrorRate float64
+	// Latency is the duration added to the commands. It represents as a duration string.
+	Latency string
+	// HangRate is the probability that a command hangs until its deadline, or for HangDuration if it's not empty.
+	HangRate     float64
+	HangDuration string
+	// MalformedValueRate is the probability that a read value doesn't match its value type.
+	MalformedValueRate float64
+	// WrongResourceNameRate is the probability that a read value has a wrong DeviceResource name.
+	WrongResourceNameRate float64
+}
+
 // Telemetry provides metrics (on a given device service) to system management.
 type Telemetry struct {
 	Alloc,
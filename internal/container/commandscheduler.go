@@ -0,0 +1,179 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+
+	gometrics "github.com/rcrowley/go-metrics"
+)
+
+const (
+	deviceNameText         = "{DeviceName}"
+	commandQueueDepthName  = "CommandQueueDepth-" + deviceNameText
+	commandWaitTimeName    = "CommandWaitTime-" + deviceNameText
+	commandMetricDeviceTag = "device"
+)
+
+// CommandSchedulerName contains the name of the device command scheduler in the DIC.
+var CommandSchedulerName = di.TypeInstanceToName(CommandScheduler{})
+
+// CommandSchedulerFrom helper function queries the DIC and returns the device command scheduler, nil if it is not
+// registered.
+func CommandSchedulerFrom(get di.Get) *CommandScheduler {
+	scheduler, ok := get(CommandSchedulerName).(*CommandScheduler)
+	if !ok {
+		return nil
+	}
+	return scheduler
+}
+
+// CommandScheduler limits the number of read and write commands concurrently executed by the ProtocolDriver for
+// each device. The commands waiting for a slot are granted one in their arrival order.
+type CommandScheduler struct {
+	mutex  sync.Mutex
+	queues map[string]*commandQueue
+}
+
+type commandQueue struct {
+	limit      int
+	active     int
+	waiters    []chan struct{}
+	queueDepth gometrics.Gauge
+	waitTime   gometrics.Timer
+}
+
+// NewCommandScheduler creates and initializes a new command scheduler.
+func NewCommandScheduler() *CommandScheduler {
+	return &CommandScheduler{queues: make(map[string]*commandQueue)}
+}
+
+// Acquire blocks until the device has a free command slot or the context is done, a limit lower than or equal
+// to 0 disables the scheduling of the device commands. The returned function must be called to release the slot
+// once the driver call returns.
+// A changed limit resizes the queue of the device in place: the commands holding a slot keep it, and the waiting
+// commands are only granted a slot once the active commands are below the new limit.
+func (s *CommandScheduler) Acquire(ctx context.Context, deviceName string, limit int, dic *di.Container) (func(), error) {
+	if limit <= 0 {
+		return func() {}, nil
+	}
+
+	start := time.Now()
+	s.mutex.Lock()
+	q := s.queue(deviceName, limit, dic)
+	if q.active < q.limit && len(q.waiters) == 0 {
+		q.active++
+		s.mutex.Unlock()
+		q.waitTime.UpdateSince(start)
+		return s.releaseFunc(q), nil
+	}
+	ready := make(chan struct{})
+	q.waiters = append(q.waiters, ready)
+	q.queueDepth.Update(int64(len(q.waiters)))
+	s.mutex.Unlock()
+	defer q.waitTime.UpdateSince(start)
+
+	select {
+	case <-ready:
+		return s.releaseFunc(q), nil
+	case <-ctx.Done():
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		select {
+		case <-ready:
+			// the slot was granted while the context was done
+			q.active--
+			q.grant()
+		default:
+			q.waiters = slices.DeleteFunc(q.waiters, func(c chan struct{}) bool { return c == ready })
+			q.queueDepth.Update(int64(len(q.waiters)))
+		}
+		return nil, ctx.Err()
+	}
+}
+
+// Remove drops the command queue of the device and unregisters its metrics.
+func (s *CommandScheduler) Remove(deviceName string, dic *di.Container) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.queues[deviceName]; !ok {
+		return
+	}
+	delete(s.queues, deviceName)
+
+	metricsManager := bootstrapContainer.MetricsManagerFrom(dic.Get)
+	if metricsManager == nil {
+		return
+	}
+	metricsManager.Unregister(strings.Replace(commandQueueDepthName, deviceNameText, deviceName, 1))
+	metricsManager.Unregister(strings.Replace(commandWaitTimeName, deviceNameText, deviceName, 1))
+}
+
+// queue returns the command queue of the device, and applies the limit to it. The caller must hold the mutex.
+func (s *CommandScheduler) queue(deviceName string, limit int, dic *di.Container) *commandQueue {
+	q, ok := s.queues[deviceName]
+	if !ok {
+		q = &commandQueue{
+			limit:      limit,
+			queueDepth: gometrics.NewGauge(),
+			waitTime:   gometrics.NewTimer(),
+		}
+		registerCommandMetric(commandQueueDepthName, deviceName, q.queueDepth, dic)
+		registerCommandMetric(commandWaitTimeName, deviceName, q.waitTime, dic)
+		s.queues[deviceName] = q
+	} else if q.limit != limit {
+		q.limit = limit
+		q.grant()
+	}
+	return q
+}
+
+func (s *CommandScheduler) releaseFunc(q *commandQueue) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.mutex.Lock()
+			defer s.mutex.Unlock()
+			q.active--
+			q.grant()
+		})
+	}
+}
+
+// grant hands the free slots to the waiting commands. The caller must hold the mutex of the scheduler.
+func (q *commandQueue) grant() {
+	for q.active < q.limit && len(q.waiters) > 0 {
+		close(q.waiters[0])
+		q.waiters = q.waiters[1:]
+		q.active++
+	}
+	q.queueDepth.Update(int64(len(q.waiters)))
+}
+
+func registerCommandMetric(metricName string, deviceName string, metric any, dic *di.Container) {
+	metricsManager := bootstrapContainer.MetricsManagerFrom(dic.Get)
+	if metricsManager == nil {
+		return
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	registeredName := strings.Replace(metricName, deviceNameText, deviceName, 1)
+
+	err := metricsManager.Register(registeredName, metric, map[string]string{commandMetricDeviceTag: deviceName})
+	if err != nil {
+		lc.Warnf("Unable to register %s metric. Metric will not be reported : %s", registeredName, err.Error())
+	} else {
+		lc.Infof("%s metric has been registered and will be reported (if enabled)", registeredName)
+	}
+}
@@ -0,0 +1,169 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDeviceName = "test-device"
+
+func schedulerDic() *di.Container {
+	return di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) any {
+			return logger.NewMockClient()
+		},
+	})
+}
+
+// acquireAsync acquires a command slot in a goroutine, the returned channel receives the release function
+// once the slot has been granted.
+func acquireAsync(t *testing.T, s *CommandScheduler, limit int, dic *di.Container) chan func() {
+	acquired := make(chan func(), 1)
+	go func() {
+		release, err := s.Acquire(context.Background(), testDeviceName, limit, dic)
+		assert.NoError(t, err)
+		acquired <- release
+	}()
+	return acquired
+}
+
+func requireWaiting(t *testing.T, acquired chan func()) {
+	select {
+	case release := <-acquired:
+		release()
+		t.Fatal("command should wait for a free slot")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func requireAcquired(t *testing.T, acquired chan func()) func() {
+	select {
+	case release := <-acquired:
+		return release
+	case <-time.After(time.Second):
+		t.Fatal("command should acquire a slot once released")
+		return nil
+	}
+}
+
+func TestCommandScheduler_Serialize(t *testing.T) {
+	dic := schedulerDic()
+	s := NewCommandScheduler()
+
+	release, err := s.Acquire(context.Background(), testDeviceName, 1, dic)
+	require.NoError(t, err)
+
+	acquired := acquireAsync(t, s, 1, dic)
+	requireWaiting(t, acquired)
+	assert.Equal(t, int64(1), s.queues[testDeviceName].queueDepth.Value())
+
+	release()
+	requireAcquired(t, acquired)()
+	assert.Equal(t, int64(0), s.queues[testDeviceName].queueDepth.Value())
+
+	// other devices are not affected
+	otherRelease, err := s.Acquire(context.Background(), "other-device", 1, dic)
+	require.NoError(t, err)
+	otherRelease()
+}
+
+func TestCommandScheduler_WaitTimeout(t *testing.T) {
+	dic := schedulerDic()
+	s := NewCommandScheduler()
+
+	release, err := s.Acquire(context.Background(), testDeviceName, 1, dic)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = s.Acquire(ctx, testDeviceName, 1, dic)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int64(0), s.queues[testDeviceName].queueDepth.Value())
+	assert.Equal(t, int64(2), s.queues[testDeviceName].waitTime.Count())
+
+	// the slot is still usable once the timed out command gave up waiting
+	release()
+	release, err = s.Acquire(context.Background(), testDeviceName, 1, dic)
+	require.NoError(t, err)
+	release()
+}
+
+func TestCommandScheduler_Unlimited(t *testing.T) {
+	dic := schedulerDic()
+	s := NewCommandScheduler()
+
+	for range 3 {
+		_, err := s.Acquire(context.Background(), testDeviceName, 0, dic)
+		require.NoError(t, err)
+	}
+	assert.Empty(t, s.queues)
+}
+
+func TestCommandScheduler_LimitIncreased(t *testing.T) {
+	dic := schedulerDic()
+	s := NewCommandScheduler()
+
+	release, err := s.Acquire(context.Background(), testDeviceName, 1, dic)
+	require.NoError(t, err)
+	waiting := acquireAsync(t, s, 1, dic)
+	requireWaiting(t, waiting)
+
+	// the waiting command is granted the new slot, and the limit of 2 still applies
+	acquired := acquireAsync(t, s, 2, dic)
+	r1 := requireAcquired(t, waiting)
+	requireWaiting(t, acquired)
+
+	release()
+	r2 := requireAcquired(t, acquired)
+	r1()
+	r2()
+}
+
+func TestCommandScheduler_LimitDecreased(t *testing.T) {
+	dic := schedulerDic()
+	s := NewCommandScheduler()
+
+	r1, err := s.Acquire(context.Background(), testDeviceName, 2, dic)
+	require.NoError(t, err)
+	r2, err := s.Acquire(context.Background(), testDeviceName, 2, dic)
+	require.NoError(t, err)
+
+	// the commands holding a slot keep it, the waiting command runs once the active commands are below the new limit
+	acquired := acquireAsync(t, s, 1, dic)
+	requireWaiting(t, acquired)
+	r1()
+	requireWaiting(t, acquired)
+	r2()
+	release := requireAcquired(t, acquired)
+
+	requireWaiting(t, acquireAsync(t, s, 1, dic))
+	release()
+}
+
+func TestCommandScheduler_Remove(t *testing.T) {
+	dic := schedulerDic()
+	s := NewCommandScheduler()
+
+	release, err := s.Acquire(context.Background(), testDeviceName, 1, dic)
+	require.NoError(t, err)
+	release()
+	// releasing twice does not free a second slot
+	release()
+	assert.Equal(t, 0, s.queues[testDeviceName].active)
+
+	s.Remove(testDeviceName, dic)
+	assert.Empty(t, s.queues)
+}
@@ -1,6 +1,6 @@
 // -*- Mode: Go; indent-tabs-mode: t -*-
 //
-// Copyright (C) 2020-2025 IOTech Ltd
+// Copyright (C) 2020-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -10,6 +10,8 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/eventbuffer"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/faultinjection"
 	"github.com/edgexfoundry/device-sdk-go/v4/pkg/interfaces"
 )
 
@@ -25,6 +27,15 @@ var AutoEventManagerName = di.TypeInstanceToName((*interfaces.AutoEventManager)(
 // ExtendedProtocolDriverName contains the name of extended protocol driver implementation in the DIC.
 var ExtendedProtocolDriverName = di.TypeInstanceToName((*interfaces.ExtendedProtocolDriver)(nil))
 
+// ContextProtocolDriverName contains the name of context-aware protocol driver implementation in the DIC.
+var ContextProtocolDriverName = di.TypeInstanceToName((*interfaces.ContextProtocolDriver)(nil))
+
+// DeviceRequestObserver is implemented by the components which need to know when a request to a device succeeded,
+// like the AutoEvent manager resetting the backoff of the AutoEvents of the device.
+type DeviceRequestObserver interface {
+	DeviceRequestSucceeded(deviceName string)
+}
+
 // DeviceServiceFrom helper function queries the DIC and returns device service struct.
 func DeviceServiceFrom(get di.Get) *models.DeviceService {
 	return get(DeviceServiceName).(*models.DeviceService)
@@ -49,6 +60,15 @@ func ExtendedProtocolDriverFrom(get di.Get) interfaces.ExtendedProtocolDriver {
 	return nil
 }
 
+// ContextProtocolDriverFrom helper function queries the DIC and returns context-aware protocol driver implementation.
+func ContextProtocolDriverFrom(get di.Get) interfaces.ContextProtocolDriver {
+	casted, ok := get(ContextProtocolDriverName).(interfaces.ContextProtocolDriver)
+	if ok {
+		return casted
+	}
+	return nil
+}
+
 // DiscoveryRequestIdName contains the name of discovery request id implementation in the DIC.
 var DiscoveryRequestIdName = di.TypeInstanceToName(new(string))
 
@@ -68,3 +88,27 @@ var AllowedRequestFailuresTrackerName = di.TypeInstanceToName(AllowedFailuresTra
 func AllowedRequestFailuresTrackerFrom(get di.Get) AllowedFailuresTracker {
 	return get(AllowedRequestFailuresTrackerName).(AllowedFailuresTracker)
 }
+
+// EventBufferName contains the name of the store-and-forward event buffer in the DIC.
+var EventBufferName = di.TypeInstanceToName(eventbuffer.Buffer{})
+
+// EventBufferFrom helper function queries the DIC and returns the event buffer, nil if it is not enabled.
+func EventBufferFrom(get di.Get) *eventbuffer.Buffer {
+	buffer, ok := get(EventBufferName).(*eventbuffer.Buffer)
+	if !ok {
+		return nil
+	}
+	return buffer
+}
+
+// FaultInjectorName contains the name of the fault injector of the ProtocolDriver in the DIC.
+var FaultInjectorName = di.TypeInstanceToName(faultinjection.Injector{})
+
+// FaultInjectorFrom helper function queries the DIC and returns the fault injector, nil if it is not enabled.
+func FaultInjectorFrom(get di.Get) *faultinjection.Injector {
+	injector, ok := get(FaultInjectorName).(*faultinjection.Injector)
+	if !ok {
+		return nil
+	}
+	return injector
+}
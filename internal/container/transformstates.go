@@ -0,0 +1,106 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+)
+
+// TransformStatesName contains the name of the transform states in the DIC.
+var TransformStatesName = di.TypeInstanceToName(TransformStates{})
+
+// TransformStatesFrom helper function queries the DIC and returns the transform states, nil if they are not
+// registered.
+func TransformStatesFrom(get di.Get) *TransformStates {
+	states, ok := get(TransformStatesName).(*TransformStates)
+	if !ok {
+		return nil
+	}
+	return states
+}
+
+// TransformStates holds the state kept by the read transforms across the readings of each device, like the previous
+// value of a delta virtual DeviceResource, by device name and key. The states of a device must be removed once the
+// device or its profile is updated or removed.
+type TransformStates struct {
+	mutex   sync.Mutex
+	devices map[string]map[string]any
+	// parent is the TransformStates updated by a batch view, whose devices are the states of the keys before their
+	// update by the batch
+	parent *TransformStates
+}
+
+// NewTransformStates creates and initializes new transform states.
+func NewTransformStates() *TransformStates {
+	return &TransformStates{devices: make(map[string]map[string]any)}
+}
+
+// Batch returns a view of the TransformStates for the readings of a single read which are converted to several
+// Events, in which each key of a device is updated once. The later updates of the key through the view are called
+// with the state before the first one and don't store their result, so that they compute the same result. Nil
+// TransformStates return nil.
+func (s *TransformStates) Batch() *TransformStates {
+	if s == nil {
+		return nil
+	}
+	return &TransformStates{devices: make(map[string]map[string]any), parent: s}
+}
+
+// Update calls the function with the state of the key of the device, nil if there is none, and stores the returned
+// state. The function is called with the lock held, so it must not call the TransformStates, and it must return a
+// new state rather than modify the given one. Nil TransformStates keep no state.
+func (s *TransformStates) Update(deviceName string, key string, update func(state any) any) {
+	if s == nil {
+		update(nil)
+		return
+	}
+	if s.parent != nil {
+		s.updateBatch(deviceName, key, update)
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	states, ok := s.devices[deviceName]
+	if !ok {
+		states = make(map[string]any)
+		s.devices[deviceName] = states
+	}
+	states[key] = update(states[key])
+}
+
+func (s *TransformStates) updateBatch(deviceName string, key string, update func(state any) any) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	previous, ok := s.devices[deviceName]
+	if !ok {
+		previous = make(map[string]any)
+		s.devices[deviceName] = previous
+	}
+	if state, ok := previous[key]; ok {
+		update(state)
+		return
+	}
+	s.parent.Update(deviceName, key, func(state any) any {
+		previous[key] = state
+		return update(state)
+	})
+}
+
+// RemoveDevice drops the states of the device.
+func (s *TransformStates) RemoveDevice(deviceName string) {
+	if s == nil {
+		return
+	}
+
+	s.parent.RemoveDevice(deviceName)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.devices, deviceName)
+}
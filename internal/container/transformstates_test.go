@@ -0,0 +1,95 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransformStates(t *testing.T) {
+	states := NewTransformStates()
+	increment := func(state any) any {
+		count, _ := state.(int)
+		return count + 1
+	}
+	current := func(deviceName string, key string) any {
+		var value any
+		states.Update(deviceName, key, func(state any) any {
+			value = state
+			return state
+		})
+		return value
+	}
+
+	states.Update("device", "key", increment)
+	states.Update("device", "key", increment)
+	states.Update("device", "other", increment)
+	states.Update("otherDevice", "key", increment)
+	assert.Equal(t, 2, current("device", "key"))
+	assert.Equal(t, 1, current("device", "other"))
+	assert.Equal(t, 1, current("otherDevice", "key"))
+
+	states.RemoveDevice("device")
+	assert.Nil(t, current("device", "key"))
+	assert.Nil(t, current("device", "other"))
+	assert.Equal(t, 1, current("otherDevice", "key"))
+}
+
+func TestTransformStates_Batch(t *testing.T) {
+	states := NewTransformStates()
+	states.Update("device", "key", func(any) any { return 1 })
+	batch := states.Batch()
+	increment := func(state any) any {
+		count, _ := state.(int)
+		return count + 1
+	}
+
+	// every update of a key through the batch computes the same result from the state before the batch
+	var results []any
+	for range 3 {
+		batch.Update("device", "key", func(state any) any {
+			results = append(results, increment(state))
+			return increment(state)
+		})
+		batch.Update("device", "new", func(state any) any {
+			results = append(results, increment(state))
+			return increment(state)
+		})
+	}
+	assert.Equal(t, []any{2, 1, 2, 1, 2, 1}, results)
+
+	var values []any
+	for _, key := range []string{"key", "new"} {
+		states.Update("device", key, func(state any) any {
+			values = append(values, state)
+			return state
+		})
+	}
+	assert.Equal(t, []any{2, 1}, values)
+
+	batch.RemoveDevice("device")
+	states.Update("device", "key", func(state any) any {
+		assert.Nil(t, state)
+		return state
+	})
+	var nilStates *TransformStates
+	assert.Nil(t, nilStates.Batch())
+}
+
+func TestTransformStates_Nil(t *testing.T) {
+	var states *TransformStates
+	called := false
+	states.Update("device", "key", func(state any) any {
+		called = true
+		assert.Nil(t, state)
+		return 1
+	})
+	assert.True(t, called)
+	states.RemoveDevice("device")
+}
@@ -0,0 +1,94 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/cache"
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+// MultiAutoEventStatusesResponse reports the runtime status of AutoEvents.
+type MultiAutoEventStatusesResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	TotalCount             uint32                      `json:"totalCount"`
+	AutoEvents             []sdkModels.AutoEventStatus `json:"autoEvents"`
+}
+
+func (c *RestController) AutoEventStatuses(e echo.Context) error {
+	request := e.Request()
+	writer := e.Response()
+
+	statuses := container.AutoEventManagerFrom(c.dic.Get).AutoEventStatuses("")
+	response := MultiAutoEventStatusesResponse{
+		BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+		TotalCount:   uint32(len(statuses)),
+		AutoEvents:   statuses,
+	}
+	return c.sendResponse(writer, request, sdkCommon.ApiAutoEventRoute, response, http.StatusOK)
+}
+
+func (c *RestController) AutoEventStatusesByDeviceName(e echo.Context) error {
+	request := e.Request()
+	writer := e.Response()
+
+	// URL parameters
+	deviceName := e.Param(common.Name)
+	if _, ok := cache.Devices().ForName(deviceName); !ok {
+		edgexErr := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("failed to find Device %s", deviceName), nil)
+		return c.sendEdgexError(writer, request, edgexErr, sdkCommon.ApiAutoEventByDeviceNameRoute)
+	}
+
+	statuses := container.AutoEventManagerFrom(c.dic.Get).AutoEventStatuses(deviceName)
+	response := MultiAutoEventStatusesResponse{
+		BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+		TotalCount:   uint32(len(statuses)),
+		AutoEvents:   statuses,
+	}
+	return c.sendResponse(writer, request, sdkCommon.ApiAutoEventByDeviceNameRoute, response, http.StatusOK)
+}
+
+func (c *RestController) PauseAutoEvent(e echo.Context) error {
+	return c.controlAutoEvent(e, sdkCommon.ApiAutoEventPauseRoute, http.StatusOK,
+		container.AutoEventManagerFrom(c.dic.Get).PauseAutoEvent)
+}
+
+func (c *RestController) ResumeAutoEvent(e echo.Context) error {
+	return c.controlAutoEvent(e, sdkCommon.ApiAutoEventResumeRoute, http.StatusOK,
+		container.AutoEventManagerFrom(c.dic.Get).ResumeAutoEvent)
+}
+
+// TriggerAutoEvent responds once the AutoEvent is triggered, the event is read and sent asynchronously
+func (c *RestController) TriggerAutoEvent(e echo.Context) error {
+	return c.controlAutoEvent(e, sdkCommon.ApiAutoEventTriggerRoute, http.StatusAccepted,
+		container.AutoEventManagerFrom(c.dic.Get).TriggerAutoEvent)
+}
+
+func (c *RestController) controlAutoEvent(e echo.Context, route string, statusCode int,
+	action func(deviceName string, sourceName string) error) error {
+	request := e.Request()
+	writer := e.Response()
+
+	// URL parameters
+	deviceName := e.Param(common.Name)
+	sourceName := e.Param(common.SourceName)
+	if err := action(deviceName, sourceName); err != nil {
+		return c.sendEdgexError(writer, request, errors.NewCommonEdgeXWrapper(err), route)
+	}
+
+	res := commonDTO.NewBaseResponse("", "", statusCode)
+	return c.sendResponse(writer, request, route, res, statusCode)
+}
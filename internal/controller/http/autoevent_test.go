@@ -0,0 +1,125 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/cache"
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/interfaces/mocks"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+func TestRestController_AutoEventStatusesByDeviceName(t *testing.T) {
+	dic := mockDic()
+	edgexErr := cache.InitCache(testService, testService, dic)
+	require.NoError(t, edgexErr)
+
+	statuses := []sdkModels.AutoEventStatus{{DeviceName: testDevice, SourceName: testResource, Interval: "10s", Running: true}}
+	manager := &mocks.AutoEventManager{}
+	manager.On("AutoEventStatuses", testDevice).Return(statuses)
+	dic.Update(di.ServiceConstructorMap{
+		container.AutoEventManagerName: func(get di.Get) any {
+			return manager
+		},
+	})
+	e := echo.New()
+	controller := NewRestController(e, dic, testService)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		expectedStatusCode int
+		expectedCount      uint32
+	}{
+		{"valid", testDevice, http.StatusOK, 1},
+		{"invalid - device not found", "unknown", http.StatusNotFound, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, sdkCommon.ApiAutoEventByDeviceNameRoute, http.NoBody)
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.deviceName)
+
+			err := controller.AutoEventStatusesByDeviceName(c)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode)
+
+			var res MultiAutoEventStatusesResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedCount, res.TotalCount)
+			if testCase.expectedCount > 0 {
+				assert.Equal(t, statuses, res.AutoEvents)
+			}
+		})
+	}
+}
+
+func TestRestController_ControlAutoEvent(t *testing.T) {
+	manager := &mocks.AutoEventManager{}
+	manager.On("PauseAutoEvent", testDevice, testResource).Return(nil)
+	manager.On("ResumeAutoEvent", testDevice, testResource).Return(nil)
+	manager.On("TriggerAutoEvent", testDevice, testResource).Return(nil)
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+	manager.On("PauseAutoEvent", testDevice, "unknown").Return(notFound)
+	manager.On("TriggerAutoEvent", testDevice, "stopped").Return(errors.NewCommonEdgeX(errors.KindStatusConflict, "not running", nil))
+
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.AutoEventManagerName: func(get di.Get) any {
+			return manager
+		},
+	})
+	e := echo.New()
+	controller := NewRestController(e, dic, testService)
+
+	tests := []struct {
+		name               string
+		handler            echo.HandlerFunc
+		sourceName         string
+		expectedStatusCode int
+	}{
+		{"valid - pause", controller.PauseAutoEvent, testResource, http.StatusOK},
+		{"valid - resume", controller.ResumeAutoEvent, testResource, http.StatusOK},
+		{"valid - trigger", controller.TriggerAutoEvent, testResource, http.StatusAccepted},
+		{"invalid - AutoEvent not found", controller.PauseAutoEvent, "unknown", http.StatusNotFound},
+		{"invalid - AutoEvent not running", controller.TriggerAutoEvent, "stopped", http.StatusConflict},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, sdkCommon.ApiAutoEventSourceRoute, http.NoBody)
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name, common.SourceName)
+			c.SetParamValues(testDevice, testCase.sourceName)
+
+			err := testCase.handler(c)
+			require.NoError(t, err)
+
+			var res commonDTO.BaseResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode)
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode)
+		})
+	}
+	manager.AssertExpectations(t)
+}
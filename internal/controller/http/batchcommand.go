@@ -0,0 +1,45 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/application"
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+)
+
+// BatchCommand responds with 207 Multi-Status, and the status of each command is reported in its result
+func (c *RestController) BatchCommand(e echo.Context) error {
+	r := e.Request()
+	w := e.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	var req application.BatchCommandRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		edgexErr := errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse request body", err)
+		return c.sendEdgexError(w, r, edgexErr, sdkCommon.ApiBatchCommandRoute)
+	}
+
+	results, edgexErr := application.ExecuteBatchCommands(r.Context(), req.Commands, c.dic)
+	if edgexErr != nil {
+		return c.sendEdgexError(w, r, edgexErr, sdkCommon.ApiBatchCommandRoute)
+	}
+
+	res := application.BatchCommandResponse{
+		BaseResponse: commonDTO.NewBaseResponse(req.RequestId, "", http.StatusMultiStatus),
+		Results:      results,
+	}
+	return c.sendResponse(w, r, sdkCommon.ApiBatchCommandRoute, res, http.StatusMultiStatus)
+}
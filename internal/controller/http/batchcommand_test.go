@@ -0,0 +1,103 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	messagingMocks "github.com/edgexfoundry/go-mod-messaging/v4/messaging/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/application"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/cache"
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+)
+
+func TestRestController_BatchCommand(t *testing.T) {
+	e := echo.New()
+	dic := mockDic()
+	sdkCommon.InitializeSentMetrics(logger.NewMockClient(), dic)
+	edgexErr := cache.InitCache(testService, testService, dic)
+	require.NoError(t, edgexErr)
+	// the event of the set command is pushed
+	published := make(chan struct{}, 1)
+	messagingClientMock := &messagingMocks.MessageClient{}
+	messagingClientMock.On("PublishWithSizeLimit", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		published <- struct{}{}
+	}).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.MessagingClientName: func(get di.Get) any {
+			return messagingClientMock
+		},
+	})
+	controller := NewRestController(e, dic, testService)
+
+	commands := []application.BatchCommand{
+		{DeviceName: testDevice, CommandName: testResource, Method: "get"},
+		{DeviceName: testDevice, CommandName: testResource, Method: "get", QueryParams: map[string]string{common.ReturnEvent: common.ValueFalse}},
+		{DeviceName: testDevice, CommandName: testResource, Method: "SET", Settings: map[string]any{testResource: "value"}},
+		{DeviceName: "notFound", CommandName: testResource, Method: "get"},
+		{DeviceName: driverErrorDevice, CommandName: testResource, Method: "get"},
+		{DeviceName: testDevice, CommandName: testResource, Method: "delete"},
+	}
+	expectedStatusCodes := []int{http.StatusOK, http.StatusOK, http.StatusOK, http.StatusNotFound, http.StatusInternalServerError, http.StatusBadRequest}
+	validRequest, err := json.Marshal(application.BatchCommandRequest{Commands: commands})
+	require.NoError(t, err)
+	emptyRequest, err := json.Marshal(application.BatchCommandRequest{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		request            []byte
+		expectedStatusCode int
+	}{
+		{"valid", validRequest, http.StatusMultiStatus},
+		{"invalid - no command", emptyRequest, http.StatusBadRequest},
+		{"invalid - request body is not JSON", []byte("invalid"), http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, sdkCommon.ApiBatchCommandRoute, bytes.NewReader(testCase.request))
+			recorder := httptest.NewRecorder()
+
+			err := controller.BatchCommand(e.NewContext(req, recorder))
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode)
+			if testCase.expectedStatusCode != http.StatusMultiStatus {
+				return
+			}
+
+			var res application.BatchCommandResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			require.Len(t, res.Results, len(commands))
+			for i, result := range res.Results {
+				assert.Equal(t, commands[i].DeviceName, result.DeviceName)
+				assert.Equal(t, expectedStatusCodes[i], result.StatusCode, "status code of command %d not as expected", i)
+				if result.StatusCode != http.StatusOK {
+					assert.NotEmpty(t, result.Message)
+				}
+			}
+			// the event is returned by the get command unless ds-returnevent is false
+			require.NotNil(t, res.Results[0].Event)
+			assert.Equal(t, testDevice, res.Results[0].Event.DeviceName)
+			assert.Nil(t, res.Results[1].Event)
+			assert.Equal(t, "set", res.Results[2].Method)
+			<-published
+		})
+	}
+}
@@ -1,6 +1,6 @@
 // -*- Mode: Go; indent-tabs-mode: t -*-
 //
-// Copyright (C) 2020-2023 IOTech Ltd
+// Copyright (C) 2020-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -21,6 +21,7 @@ import (
 
 	"github.com/edgexfoundry/device-sdk-go/v4/internal/application"
 	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/transformer"
 
 	"github.com/labstack/echo/v4"
 )
@@ -38,6 +39,10 @@ func (c *RestController) GetCommand(e echo.Context) error {
 	if err != nil {
 		return c.sendEdgexError(w, r, err, common.ApiDeviceNameCommandNameRoute)
 	}
+	ctx, err = transformer.WithRequestedUnits(ctx, reserved.Get(sdkCommon.UnitsParameter))
+	if err != nil {
+		return c.sendEdgexError(w, r, err, common.ApiDeviceNameCommandNameRoute)
+	}
 
 	regexCmd := true
 	if useRegex := reserved.Get(common.RegexCommand); useRegex == common.ValueFalse {
@@ -48,6 +53,11 @@ func (c *RestController) GetCommand(e echo.Context) error {
 	if err != nil {
 		return c.sendEdgexError(w, r, err, common.ApiDeviceNameCommandNameRoute)
 	}
+	// no Event is returned nor pushed if all the readings are dropped
+	if event == nil {
+		res := commonDTO.NewBaseResponse("", application.DroppedReadingsMessage, http.StatusOK)
+		return c.sendResponse(w, r, common.ApiDeviceNameCommandNameRoute, res, http.StatusOK)
+	}
 
 	// push event to CoreData if specified (default false)
 	if pushEvent := reserved.Get(common.PushEvent); pushEvent == common.ValueTrue {
@@ -76,7 +86,11 @@ func (c *RestController) SetCommand(e echo.Context) error {
 	commandName := e.Param(common.Command)
 
 	// parse query parameter
-	queryParams, _, err := filterQueryParams(r.URL.RawQuery)
+	queryParams, reserved, err := filterQueryParams(r.URL.RawQuery)
+	if err != nil {
+		return c.sendEdgexError(w, r, err, common.ApiDeviceNameCommandNameRoute)
+	}
+	ctx, err = transformer.WithRequestedUnits(ctx, reserved.Get(sdkCommon.UnitsParameter))
 	if err != nil {
 		return c.sendEdgexError(w, r, err, common.ApiDeviceNameCommandNameRoute)
 	}
@@ -86,9 +100,19 @@ func (c *RestController) SetCommand(e echo.Context) error {
 		return c.sendEdgexError(w, r, err, common.ApiDeviceNameCommandNameRoute)
 	}
 
-	event, err := application.SetCommand(ctx, deviceName, commandName, queryParams, requestParamsMap, c.dic)
+	event, verification, err := application.SetCommand(ctx, deviceName, commandName, queryParams, requestParamsMap,
+		reserved.Get(sdkCommon.VerifyWriteParameter), c.dic)
 	if err != nil {
-		return c.sendEdgexError(w, r, err, common.ApiDeviceNameCommandNameRoute)
+		if verification == nil {
+			return c.sendEdgexError(w, r, err, common.ApiDeviceNameCommandNameRoute)
+		}
+		// the written values are not verified, so the outcome of each DeviceResource is reported with the error
+		c.lc.Error(err.Error(), common.CorrelationHeader, r.Header.Get(common.CorrelationHeader))
+		res := application.SetCommandResponse{
+			BaseResponse: commonDTO.NewBaseResponse("", err.Error(), err.Code()),
+			Verification: verification,
+		}
+		return c.sendResponse(w, r, common.ApiDeviceNameCommandNameRoute, res, err.Code())
 	}
 
 	if event != nil {
@@ -96,7 +120,10 @@ func (c *RestController) SetCommand(e echo.Context) error {
 		go sdkCommon.SendEvent(event, correlationId, c.dic)
 	}
 
-	res := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	res := application.SetCommandResponse{
+		BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+		Verification: verification,
+	}
 	return c.sendResponse(w, r, common.ApiDeviceNameCommandNameRoute, res, http.StatusOK)
 }
 
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2022-2025 IOTech Ltd
+// Copyright (C) 2022-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -14,6 +14,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	bootstrapMocks "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces/mocks"
@@ -30,6 +31,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/application"
 	"github.com/edgexfoundry/device-sdk-go/v4/internal/cache"
 	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
 	"github.com/edgexfoundry/device-sdk-go/v4/internal/config"
@@ -58,6 +60,7 @@ const (
 	readOnlyResource  = "ro-resource"
 	writeOnlyResource = "wo-resource"
 	objectResource    = "object-resource"
+	validatedResource = "validated-resource"
 
 	testRegexResource = "^t.+-resource"
 )
@@ -128,6 +131,17 @@ func mockDic() *di.Container {
 					ReadWrite: common.ReadWrite_RW,
 				},
 			},
+			dtos.DeviceResource{
+				Name: validatedResource,
+				Properties: dtos.ResourceProperties{
+					ValueType: common.ValueTypeInt16,
+					ReadWrite: common.ReadWrite_R,
+				},
+				Attributes: map[string]any{
+					sdkCommon.ValidMaximumAttribute:     10,
+					sdkCommon.ValidRangeActionAttribute: sdkCommon.ValidationActionDrop,
+				},
+			},
 		},
 		DeviceCommands: []dtos.DeviceCommand{
 			dtos.DeviceCommand{
@@ -315,6 +329,80 @@ func TestRestController_GetCommand_ServiceLocked(t *testing.T) {
 	assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
 }
 
+func TestRestController_GetCommand_NoValue(t *testing.T) {
+	e := echo.New()
+	dic := mockDic()
+	driver := &mocks.ProtocolDriver{}
+	driver.On("HandleReadCommands", testDevice, mock.Anything, mock.Anything).Return([]*sdkModels.CommandValue{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.ProtocolDriverName: func(get di.Get) any {
+			return driver
+		},
+	})
+
+	edgexErr := cache.InitCache(testService, testService, dic)
+	require.NoError(t, edgexErr)
+
+	controller := NewRestController(e, dic, testService)
+	assert.NotNil(t, controller)
+
+	req := httptest.NewRequest(http.MethodGet, common.ApiDeviceNameCommandNameRoute, http.NoBody)
+
+	// Act
+	recorder := httptest.NewRecorder()
+	c := e.NewContext(req, recorder)
+	c.SetParamNames(common.Name, common.Command)
+	c.SetParamValues(testDevice, testResource)
+	err := controller.GetCommand(c)
+	assert.NoError(t, err)
+
+	var res responses.EventResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, recorder.Result().StatusCode, "HTTP status code not as expected")
+	assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+}
+
+func TestRestController_GetCommand_DroppedReadings(t *testing.T) {
+	e := echo.New()
+	dic := mockDic()
+	cv, err := sdkModels.NewCommandValue(validatedResource, common.ValueTypeInt16, int16(100))
+	require.NoError(t, err)
+	driver := &mocks.ProtocolDriver{}
+	driver.On("HandleReadCommands", testDevice, mock.Anything, mock.Anything).Return([]*sdkModels.CommandValue{cv}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.ProtocolDriverName: func(get di.Get) any {
+			return driver
+		},
+	})
+
+	edgexErr := cache.InitCache(testService, testService, dic)
+	require.NoError(t, edgexErr)
+
+	controller := NewRestController(e, dic, testService)
+	assert.NotNil(t, controller)
+
+	req := httptest.NewRequest(http.MethodGet, common.ApiDeviceNameCommandNameRoute, http.NoBody)
+
+	// Act
+	recorder := httptest.NewRecorder()
+	c := e.NewContext(req, recorder)
+	c.SetParamNames(common.Name, common.Command)
+	c.SetParamValues(testDevice, validatedResource)
+	err = controller.GetCommand(c)
+	assert.NoError(t, err)
+
+	var res commonDTO.BaseResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+	assert.Equal(t, application.DroppedReadingsMessage, res.Message)
+}
+
 func TestRestController_GetCommand_ReturnEvent(t *testing.T) {
 	e := echo.New()
 	dic := mockDic()
@@ -343,6 +431,33 @@ func TestRestController_GetCommand_ReturnEvent(t *testing.T) {
 	assert.Empty(t, recorder.Body.Bytes())
 }
 
+func TestRestController_GetCommand_InvalidUnits(t *testing.T) {
+	e := echo.New()
+	dic := mockDic()
+
+	edgexErr := cache.InitCache(testService, testService, dic)
+	require.NoError(t, edgexErr)
+
+	controller := NewRestController(e, dic, testService)
+	assert.NotNil(t, controller)
+
+	req := httptest.NewRequest(http.MethodGet, common.ApiDeviceNameCommandNameRoute, http.NoBody)
+
+	query := req.URL.Query()
+	query.Add(sdkCommon.UnitsParameter, "furlong")
+	req.URL.RawQuery = query.Encode()
+	// Act
+	recorder := httptest.NewRecorder()
+	c := e.NewContext(req, recorder)
+	c.SetParamNames(common.Name, common.Command)
+	c.SetParamValues(testDevice, testResource)
+	err := controller.GetCommand(c)
+	assert.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, recorder.Result().StatusCode, "HTTP status code not as expected")
+}
+
 func TestRestController_SetCommand(t *testing.T) {
 	e := echo.New()
 	validRequest := map[string]any{testResource: "value", writeOnlyResource: "value"}
@@ -480,3 +595,192 @@ func TestRestController_SetCommand_ServiceLocked(t *testing.T) {
 	assert.Equal(t, http.StatusLocked, res.StatusCode, "Response status code not as expected")
 	assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
 }
+
+type contextDriver struct {
+	*mocks.ProtocolDriver
+}
+
+func (d contextDriver) HandleReadCommandsWithContext(ctx context.Context, _ string, _ map[string]models.ProtocolProperties, _ []sdkModels.CommandRequest) ([]*sdkModels.CommandValue, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (d contextDriver) HandleWriteCommandsWithContext(ctx context.Context, _ string, _ map[string]models.ProtocolProperties, _ []sdkModels.CommandRequest, _ []*sdkModels.CommandValue) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestRestController_Command_Timeout(t *testing.T) {
+	e := echo.New()
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) any {
+			return &config.ConfigurationStruct{
+				Device: config.DeviceInfo{
+					MaxCmdOps:      1,
+					CommandTimeout: "10ms",
+				},
+			}
+		},
+	})
+
+	edgexErr := cache.InitCache(testService, testService, dic)
+	require.NoError(t, edgexErr)
+
+	hangingDriver := &mocks.ProtocolDriver{}
+	hangingDriver.On("HandleReadCommands", testDevice, mock.Anything, mock.Anything).After(time.Second).Return(nil, nil)
+	hangingDriver.On("HandleWriteCommands", testDevice, mock.Anything, mock.Anything, mock.Anything).After(time.Second).Return(nil)
+
+	controller := NewRestController(e, dic, testService)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name      string
+		method    string
+		ctxDriver bool
+	}{
+		{"GET - ProtocolDriver", http.MethodGet, false},
+		{"SET - ProtocolDriver", http.MethodPut, false},
+		{"GET - ContextProtocolDriver", http.MethodGet, true},
+		{"SET - ContextProtocolDriver", http.MethodPut, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic.Update(di.ServiceConstructorMap{
+				container.ProtocolDriverName: func(get di.Get) any {
+					return hangingDriver
+				},
+				container.ContextProtocolDriverName: func(get di.Get) any {
+					if testCase.ctxDriver {
+						return contextDriver{hangingDriver}
+					}
+					return nil
+				},
+			})
+
+			req := httptest.NewRequest(testCase.method, common.ApiDeviceNameCommandNameRoute, strings.NewReader("{}"))
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name, common.Command)
+			c.SetParamValues(testDevice, testResource)
+
+			start := time.Now()
+			var err error
+			if testCase.method == http.MethodGet {
+				err = controller.GetCommand(c)
+			} else {
+				err = controller.SetCommand(c)
+			}
+			require.NoError(t, err)
+
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			assert.Less(t, time.Since(start), time.Second, "command should return once the deadline exceeded")
+			assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode, "Response status code not as expected")
+			assert.Contains(t, res.Message, "deadline")
+		})
+	}
+}
+
+func TestRestController_SetCommand_Verify(t *testing.T) {
+	e := echo.New()
+	dic := mockDic()
+	sdkCommon.InitializeSentMetrics(logger.NewMockClient(), dic)
+	err := cache.InitCache(testService, testService, dic)
+	require.NoError(t, err)
+
+	// the device always reads back "test"
+	readBack := &sdkModels.CommandValue{DeviceResourceName: testResource, Type: common.ValueTypeString, Value: "test"}
+	messagingClientMock := &messagingMocks.MessageClient{}
+	messagingClientMock.On("PublishWithSizeLimit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.MessagingClientName: func(get di.Get) any {
+			return messagingClientMock
+		},
+	})
+	controller := NewRestController(e, dic, testService)
+
+	tests := []struct {
+		name               string
+		commandName        string
+		request            map[string]any
+		verify             string
+		expectedStatusCode int
+		expectedStatus     string
+		expectedRestored   bool
+		expectedWrites     int
+	}{
+		{"valid - not verified", testResource, map[string]any{testResource: "value"}, "", http.StatusOK, "", false, 1},
+		{"valid - verified", testResource, map[string]any{testResource: "test"}, common.ValueTrue, http.StatusOK, application.WriteVerified, false, 1},
+		{"valid - write-only resource skipped", writeOnlyResource, map[string]any{writeOnlyResource: "value"}, common.ValueTrue, http.StatusOK, application.WriteSkipped, false, 1},
+		{"invalid - mismatch", testResource, map[string]any{testResource: "value"}, common.ValueTrue, http.StatusConflict, application.WriteMismatch, false, 1},
+		{"invalid - mismatch restored", testResource, map[string]any{testResource: "value"}, sdkCommon.VerifyWriteRestore, http.StatusConflict, application.WriteMismatch, true, 2},
+		{"invalid - unknown verify mode", testResource, map[string]any{testResource: "value"}, "maybe", http.StatusBadRequest, "", false, 0},
+		{"invalid - read back failed", testResource, map[string]any{testResource: "value"}, common.ValueTrue, http.StatusInternalServerError, application.WriteMismatch, false, 1},
+	}
+	configuration := container.ConfigurationFrom(dic.Get)
+	configuration.Device.AllowedFails = 3
+	failsTracker := container.AllowedRequestFailuresTrackerFrom(dic.Get)
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			failsTracker.Set(testDevice, int(configuration.Device.AllowedFails))
+			readErr := testCase.expectedStatusCode == http.StatusInternalServerError
+			driver := &mocks.ProtocolDriver{}
+			if readErr {
+				driver.On("HandleReadCommands", testDevice, mock.Anything, mock.Anything).Return(nil, errors.New("ProtocolDriver returned error"))
+			} else {
+				driver.On("HandleReadCommands", testDevice, mock.Anything, mock.Anything).Return([]*sdkModels.CommandValue{readBack}, nil)
+			}
+			driver.On("HandleWriteCommands", testDevice, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			dic.Update(di.ServiceConstructorMap{
+				container.ProtocolDriverName: func(get di.Get) any {
+					return driver
+				},
+			})
+
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+			target := common.ApiDeviceNameCommandNameRoute
+			if testCase.verify != "" {
+				target += "?" + sdkCommon.VerifyWriteParameter + "=" + testCase.verify
+			}
+			req := httptest.NewRequest(http.MethodPut, target, strings.NewReader(string(jsonData)))
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name, common.Command)
+			c.SetParamValues(testDevice, testCase.commandName)
+
+			err = controller.SetCommand(c)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode)
+
+			var res application.SetCommandResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode)
+			driver.AssertNumberOfCalls(t, "HandleWriteCommands", testCase.expectedWrites)
+			// the device has responded if the written values are read back, even if they don't match
+			expectedFails := int(configuration.Device.AllowedFails)
+			if testCase.expectedStatusCode != http.StatusOK && testCase.expectedStatusCode != http.StatusConflict {
+				expectedFails--
+			}
+			assert.Equal(t, expectedFails, failsTracker.Value(testDevice))
+			if testCase.expectedStatus == "" {
+				assert.Empty(t, res.Verification)
+				return
+			}
+			require.Len(t, res.Verification, 1)
+			assert.Equal(t, testCase.expectedStatus, res.Verification[0].Status)
+			assert.Equal(t, testCase.expectedRestored, res.Verification[0].Restored)
+			if readErr {
+				assert.Contains(t, res.Verification[0].Message, "failed to read back")
+				return
+			}
+			if testCase.expectedStatus == application.WriteMismatch {
+				assert.Equal(t, "value", res.Verification[0].Expected)
+				assert.Equal(t, "test", res.Verification[0].Actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+	"time"
+
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/labstack/echo/v4"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+)
+
+// EventBufferResponse reports the backlog of the store-and-forward event buffer.
+type EventBufferResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	Enabled                bool  `json:"enabled"`
+	Count                  int   `json:"count"`
+	Bytes                  int64 `json:"bytes"`
+	OldestTimestamp        int64 `json:"oldestTimestamp,omitempty"`
+	// OldestAge is the age of the oldest buffered event in milliseconds
+	OldestAge int64 `json:"oldestAge"`
+	Dropped   int64 `json:"dropped"`
+}
+
+func (c *RestController) EventBuffer(e echo.Context) error {
+	request := e.Request()
+	writer := e.Response()
+
+	response := EventBufferResponse{BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK)}
+	buffer := container.EventBufferFrom(c.dic.Get)
+	if buffer != nil {
+		stats := buffer.Stats()
+		response.Enabled = true
+		response.Count = stats.Count
+		response.Bytes = stats.Bytes
+		response.OldestTimestamp = stats.OldestTimestamp
+		response.Dropped = stats.Dropped
+		if stats.OldestTimestamp != 0 {
+			response.OldestAge = time.Since(time.Unix(0, stats.OldestTimestamp)).Milliseconds()
+		}
+	}
+	return c.sendResponse(writer, request, sdkCommon.ApiEventBufferRoute, response, http.StatusOK)
+}
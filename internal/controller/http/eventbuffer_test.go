@@ -0,0 +1,70 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/eventbuffer"
+)
+
+func TestRestController_EventBuffer(t *testing.T) {
+	buffer, err := eventbuffer.Open(t.TempDir(), 1024*1024, 1024)
+	require.NoError(t, err)
+	defer buffer.Close()
+	require.NoError(t, buffer.Append("topic", types.MessageEnvelope{Payload: []byte("event")}, 1))
+
+	tests := []struct {
+		name            string
+		buffer          *eventbuffer.Buffer
+		expectedEnabled bool
+		expectedCount   int
+	}{
+		{"disabled", nil, false, 0},
+		{"enabled", buffer, true, 1},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := mockDic()
+			if testCase.buffer != nil {
+				dic.Update(di.ServiceConstructorMap{
+					container.EventBufferName: func(get di.Get) any {
+						return testCase.buffer
+					},
+				})
+			}
+			e := echo.New()
+			controller := NewRestController(e, dic, testService)
+
+			req := httptest.NewRequest(http.MethodGet, sdkCommon.ApiEventBufferRoute, http.NoBody)
+			recorder := httptest.NewRecorder()
+			err := controller.EventBuffer(e.NewContext(req, recorder))
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+
+			var res EventBufferResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedEnabled, res.Enabled)
+			assert.Equal(t, testCase.expectedCount, res.Count)
+			if testCase.expectedCount > 0 {
+				assert.NotZero(t, res.OldestTimestamp)
+				assert.NotZero(t, res.Bytes)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/labstack/echo/v4"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/faultinjection"
+)
+
+// FaultInjectionResponse reports the faults injected into the commands of the ProtocolDriver.
+type FaultInjectionResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	// Overridden is true if the faults are the ones of the REST API instead of Writable.FaultInjection
+	Overridden bool                    `json:"overridden"`
+	Settings   faultinjection.Settings `json:"settings"`
+}
+
+// faultInjector returns the fault injector, or the error response if the fault injection isn't enabled
+func (c *RestController) faultInjector(e echo.Context) (*faultinjection.Injector, error) {
+	injector := container.FaultInjectorFrom(c.dic.Get)
+	if injector == nil {
+		edgexErr := errors.NewCommonEdgeX(errors.KindNotAllowed, "fault injection is not enabled by Device.EnableFaultInjection", nil)
+		return nil, c.sendEdgexError(e.Response(), e.Request(), edgexErr, sdkCommon.ApiFaultInjectionRoute)
+	}
+	return injector, nil
+}
+
+func (c *RestController) sendFaultInjection(e echo.Context, injector *faultinjection.Injector) error {
+	settings, overridden := injector.Settings()
+	response := FaultInjectionResponse{
+		BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+		Overridden:   overridden,
+		Settings:     settings,
+	}
+	return c.sendResponse(e.Response(), e.Request(), sdkCommon.ApiFaultInjectionRoute, response, http.StatusOK)
+}
+
+func (c *RestController) FaultInjection(e echo.Context) error {
+	injector, err := c.faultInjector(e)
+	if injector == nil {
+		return err
+	}
+	return c.sendFaultInjection(e, injector)
+}
+
+func (c *RestController) OverrideFaultInjection(e echo.Context) error {
+	request := e.Request()
+	writer := e.Response()
+
+	injector, err := c.faultInjector(e)
+	if injector == nil {
+		return err
+	}
+
+	var settings faultinjection.Settings
+	if err = json.NewDecoder(request.Body).Decode(&settings); err != nil {
+		edgexErr := errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse request body", err)
+		return c.sendEdgexError(writer, request, edgexErr, sdkCommon.ApiFaultInjectionRoute)
+	}
+	if err = injector.Override(&settings); err != nil {
+		edgexErr := errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid fault injection settings", err)
+		return c.sendEdgexError(writer, request, edgexErr, sdkCommon.ApiFaultInjectionRoute)
+	}
+	c.lc.Warnf("Fault injection settings overridden: %+v", settings)
+	return c.sendFaultInjection(e, injector)
+}
+
+func (c *RestController) ResetFaultInjection(e echo.Context) error {
+	injector, err := c.faultInjector(e)
+	if injector == nil {
+		return err
+	}
+	_ = injector.Override(nil)
+	c.lc.Info("Fault injection settings reset to Writable.FaultInjection")
+	return c.sendFaultInjection(e, injector)
+}
@@ -0,0 +1,84 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/faultinjection"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/interfaces/mocks"
+)
+
+func TestRestController_FaultInjection(t *testing.T) {
+	writable := faultinjection.Settings{Latency: "10ms"}
+	injector := faultinjection.NewInjector(mocks.NewProtocolDriver(t), func() faultinjection.Settings { return writable }, logger.NewMockClient())
+
+	tests := []struct {
+		name               string
+		injector           *faultinjection.Injector
+		method             string
+		body               string
+		expectedStatus     int
+		expectedOverridden bool
+		expectedSettings   faultinjection.Settings
+	}{
+		{"not enabled", nil, http.MethodGet, "", http.StatusMethodNotAllowed, false, faultinjection.Settings{}},
+		{"writable settings", injector, http.MethodGet, "", http.StatusOK, false, writable},
+		{"override", injector, http.MethodPut, `{"devices": ["device"], "errorRate": 0.5}`, http.StatusOK, true,
+			faultinjection.Settings{Devices: []string{"device"}, ErrorRate: 0.5}},
+		{"overridden settings", injector, http.MethodGet, "", http.StatusOK, true,
+			faultinjection.Settings{Devices: []string{"device"}, ErrorRate: 0.5}},
+		{"invalid rate", injector, http.MethodPut, `{"errorRate": 2}`, http.StatusBadRequest, false, faultinjection.Settings{}},
+		{"invalid body", injector, http.MethodPut, `{`, http.StatusBadRequest, false, faultinjection.Settings{}},
+		{"reset", injector, http.MethodDelete, "", http.StatusOK, false, writable},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := mockDic()
+			if testCase.injector != nil {
+				dic.Update(di.ServiceConstructorMap{
+					container.FaultInjectorName: func(get di.Get) any {
+						return testCase.injector
+					},
+				})
+			}
+			e := echo.New()
+			controller := NewRestController(e, dic, testService)
+			handlers := map[string]echo.HandlerFunc{
+				http.MethodGet:    controller.FaultInjection,
+				http.MethodPut:    controller.OverrideFaultInjection,
+				http.MethodDelete: controller.ResetFaultInjection,
+			}
+
+			req := httptest.NewRequest(testCase.method, sdkCommon.ApiFaultInjectionRoute, strings.NewReader(testCase.body))
+			recorder := httptest.NewRecorder()
+			err := handlers[testCase.method](e.NewContext(req, recorder))
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedStatus, recorder.Result().StatusCode)
+			if testCase.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var res FaultInjectionResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedOverridden, res.Overridden)
+			assert.Equal(t, testCase.expectedSettings, res.Settings)
+		})
+	}
+}
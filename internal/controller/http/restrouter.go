@@ -1,7 +1,7 @@
 // -*- Mode: Go; indent-tabs-mode: t -*-
 //
 // Copyright (C) 2017-2018 Canonical Ltd
-// Copyright (C) 2018-2025 IOTech Ltd
+// Copyright (C) 2018-2026 IOTech Ltd
 // Copyright (c) 2019 Intel Corporation
 //
 // SPDX-License-Identifier: Apache-2.0
@@ -24,6 +24,8 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	"github.com/labstack/echo/v4"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
 )
 
 type RestController struct {
@@ -65,6 +67,19 @@ func (c *RestController) InitRestRoutes(dic *di.Container) {
 	// device command
 	c.addReservedRoute(common.ApiDeviceNameCommandNameRoute, c.GetCommand, http.MethodGet, authenticationHook)
 	c.addReservedRoute(common.ApiDeviceNameCommandNameRoute, c.SetCommand, http.MethodPut, authenticationHook)
+	c.addReservedRoute(sdkCommon.ApiBatchCommandRoute, c.BatchCommand, http.MethodPost, authenticationHook)
+	// event buffer
+	c.addReservedRoute(sdkCommon.ApiEventBufferRoute, c.EventBuffer, http.MethodGet, authenticationHook)
+	// autoevent
+	c.addReservedRoute(sdkCommon.ApiAutoEventRoute, c.AutoEventStatuses, http.MethodGet, authenticationHook)
+	c.addReservedRoute(sdkCommon.ApiAutoEventByDeviceNameRoute, c.AutoEventStatusesByDeviceName, http.MethodGet, authenticationHook)
+	c.addReservedRoute(sdkCommon.ApiAutoEventPauseRoute, c.PauseAutoEvent, http.MethodPost, authenticationHook)
+	c.addReservedRoute(sdkCommon.ApiAutoEventResumeRoute, c.ResumeAutoEvent, http.MethodPost, authenticationHook)
+	c.addReservedRoute(sdkCommon.ApiAutoEventTriggerRoute, c.TriggerAutoEvent, http.MethodPost, authenticationHook)
+	// fault injection
+	c.addReservedRoute(sdkCommon.ApiFaultInjectionRoute, c.FaultInjection, http.MethodGet, authenticationHook)
+	c.addReservedRoute(sdkCommon.ApiFaultInjectionRoute, c.OverrideFaultInjection, http.MethodPut, authenticationHook)
+	c.addReservedRoute(sdkCommon.ApiFaultInjectionRoute, c.ResetFaultInjection, http.MethodDelete, authenticationHook)
 }
 
 func (c *RestController) addReservedRoute(route string, handler func(e echo.Context) error, method string,
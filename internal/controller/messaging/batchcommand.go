@@ -0,0 +1,118 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"net/http"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/application"
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+)
+
+func SubscribeBatchCommands(ctx context.Context, dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	configuration := container.ConfigurationFrom(dic.Get)
+	messageBusInfo := container.ConfigurationFrom(dic.Get).MessageBus
+	serviceName := container.DeviceServiceFrom(dic.Get).Name
+
+	requestTopic := common.NewPathBuilder().EnableNameFieldEscape(configuration.Service.EnableNameFieldEscape).
+		SetPath(messageBusInfo.GetBaseTopicPrefix()).SetPath(sdkCommon.BatchCommandRequestTopic).SetNameFieldPath(serviceName).BuildPath()
+	lc.Infof("Subscribing to batch command requests on topic: %s", requestTopic)
+
+	responseTopicPrefix := common.NewPathBuilder().EnableNameFieldEscape(configuration.Service.EnableNameFieldEscape).
+		SetPath(messageBusInfo.GetBaseTopicPrefix()).SetPath(common.ResponseTopic).SetNameFieldPath(serviceName).BuildPath()
+	lc.Infof("Responses to batch command requests will be published on topic: %s/<requestId>", responseTopicPrefix)
+
+	messages := make(chan types.MessageEnvelope, 1)
+	messageErrors := make(chan error, 1)
+	topics := []types.TopicChannel{
+		{
+			Topic:    requestTopic,
+			Messages: messages,
+		},
+	}
+
+	messageBus := bootstrapContainer.MessagingClientFrom(dic.Get)
+	err := messageBus.Subscribe(topics, messageErrors)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				lc.Infof("Exiting waiting for MessageBus '%s' topic messages", requestTopic)
+				return
+			case err = <-messageErrors:
+				lc.Error(err.Error())
+			case msgEnvelope := <-messages:
+				lc.Debugf("Batch command request received on message queue. Topic: %s, Correlation-id: %s", msgEnvelope.ReceivedTopic, msgEnvelope.CorrelationID)
+
+				responseTopic := common.BuildTopic(responseTopicPrefix, msgEnvelope.RequestID)
+				batchCommand(ctx, msgEnvelope, responseTopic, dic)
+
+				lc.Debugf("Batch command response published on message queue. Topic: %s, Correlation-id: %s", responseTopic, msgEnvelope.CorrelationID)
+			}
+		}
+	}()
+
+	return nil
+}
+
+func batchCommand(ctx context.Context, msgEnvelope types.MessageEnvelope, responseTopic string, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	messageBus := bootstrapContainer.MessagingClientFrom(dic.Get)
+	publishError := func(message string) {
+		responseEnvelope := types.NewMessageEnvelopeWithError(msgEnvelope.RequestID, message)
+		if err := messageBus.Publish(responseEnvelope, responseTopic); err != nil {
+			lc.Errorf("Failed to publish batch command error response: %s", err.Error())
+		}
+	}
+
+	request, err := types.GetMsgPayload[application.BatchCommandRequest](msgEnvelope)
+	if err != nil {
+		lc.Errorf("Failed to decode batch command request payload: %s", err.Error())
+		publishError(err.Error())
+		return
+	}
+
+	// TODO: fix properly in EdgeX 3.0
+	ctx = context.WithValue(ctx, common.CorrelationHeader, msgEnvelope.CorrelationID) // nolint: staticcheck
+	results, edgexErr := application.ExecuteBatchCommands(ctx, request.Commands, dic)
+	if edgexErr != nil {
+		lc.Errorf("Failed to process batch command request: %s", edgexErr.Error())
+		publishError(edgexErr.Error())
+		return
+	}
+
+	response := application.BatchCommandResponse{
+		BaseResponse: commonDTO.NewBaseResponse(msgEnvelope.RequestID, "", http.StatusMultiStatus),
+		Results:      results,
+	}
+	responseEnvelope, err := types.NewMessageEnvelopeForResponse(response, msgEnvelope.RequestID, msgEnvelope.CorrelationID, common.ContentTypeJSON)
+	if err != nil {
+		lc.Errorf("Failed to create response message envelope: %s", err.Error())
+		publishError(err.Error())
+		return
+	}
+
+	configuration := container.ConfigurationFrom(dic.Get)
+	err = messageBus.PublishWithSizeLimit(responseEnvelope, responseTopic, configuration.MaxEventSize)
+	if err != nil {
+		lc.Errorf("Failed to publish batch command response: %s", err.Error())
+		publishError(err.Error())
+	}
+}
@@ -0,0 +1,107 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	messagingMocks "github.com/edgexfoundry/go-mod-messaging/v4/messaging/mocks"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/application"
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/config"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+)
+
+func TestBatchCommand(t *testing.T) {
+	expectedRequestId := uuid.NewString()
+	expectedRequestTopic := common.BuildTopic(common.DefaultBaseTopic, sdkCommon.BatchCommandRequestTopic, testServiceName)
+	expectedResponseTopic := common.BuildTopic(common.DefaultBaseTopic, common.ResponseTopic, testServiceName, expectedRequestId)
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) any {
+			return &config.ConfigurationStruct{}
+		},
+		container.DeviceServiceName: func(get di.Get) any {
+			return &models.DeviceService{Name: testServiceName}
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) any {
+			return logger.NewMockClient()
+		},
+	})
+
+	tests := []struct {
+		name          string
+		request       any
+		expectedError bool
+	}{
+		{"valid - the results report the failed commands", application.BatchCommandRequest{Commands: []application.BatchCommand{
+			{DeviceName: testDeviceName, CommandName: "command", Method: "delete"},
+		}}, false},
+		{"invalid - no command", application.BatchCommandRequest{}, true},
+		{"invalid - message payload is not BatchCommandRequest", []byte("invalid"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			responses := make(chan types.MessageEnvelope, 1)
+			mockMessaging := &messagingMocks.MessageClient{}
+			mockMessaging.On("Subscribe", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				topics := args.Get(0).([]types.TopicChannel)
+				require.Len(t, topics, 1)
+				require.Equal(t, expectedRequestTopic, topics[0].Topic)
+				go func() {
+					topics[0].Messages <- types.MessageEnvelope{
+						RequestID:     expectedRequestId,
+						CorrelationID: uuid.NewString(),
+						ReceivedTopic: expectedRequestTopic,
+						ContentType:   common.ContentTypeJSON,
+						Payload:       tt.request,
+					}
+				}()
+			}).Return(nil)
+			publish := func(args mock.Arguments) {
+				responses <- args.Get(0).(types.MessageEnvelope)
+			}
+			mockMessaging.On("Publish", mock.Anything, expectedResponseTopic).Run(publish).Return(nil)
+			mockMessaging.On("PublishWithSizeLimit", mock.Anything, expectedResponseTopic, mock.Anything).Run(publish).Return(nil)
+			dic.Update(di.ServiceConstructorMap{
+				bootstrapContainer.MessagingClientName: func(get di.Get) any {
+					return mockMessaging
+				},
+			})
+
+			err := SubscribeBatchCommands(ctx, dic)
+			require.NoError(t, err)
+
+			response := <-responses
+			assert.Equal(t, expectedRequestId, response.RequestID)
+			if tt.expectedError {
+				assert.Equal(t, 1, response.ErrorCode)
+				return
+			}
+			assert.Equal(t, 0, response.ErrorCode)
+			res, decodeErr := types.GetMsgPayload[application.BatchCommandResponse](response)
+			require.NoError(t, decodeErr)
+			assert.Equal(t, http.StatusMultiStatus, res.StatusCode)
+			require.Len(t, res.Results, 1)
+			assert.Equal(t, http.StatusBadRequest, res.Results[0].StatusCode)
+		})
+	}
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2022-2025 IOTech Ltd
+// Copyright (C) 2022-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -14,7 +14,9 @@ import (
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
@@ -22,6 +24,7 @@ import (
 	"github.com/edgexfoundry/device-sdk-go/v4/internal/application"
 	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
 	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/transformer"
 )
 
 func SubscribeCommands(ctx context.Context, dic *di.Container) errors.EdgeX {
@@ -114,6 +117,15 @@ func getCommand(ctx context.Context, msgEnvelope types.MessageEnvelope, response
 
 	// TODO: fix properly in EdgeX 3.0
 	ctx = context.WithValue(ctx, common.CorrelationHeader, msgEnvelope.CorrelationID) // nolint: staticcheck
+	ctx, edgexErr := transformer.WithRequestedUnits(ctx, msgEnvelope.QueryParams[sdkCommon.UnitsParameter])
+	if edgexErr != nil {
+		lc.Errorf("Failed to process get device command %s for device %s: %s", commandName, deviceName, edgexErr.Error())
+		responseEnvelope = types.NewMessageEnvelopeWithError(msgEnvelope.RequestID, edgexErr.Error())
+		if err := messageBus.Publish(responseEnvelope, responseTopic); err != nil {
+			lc.Errorf("Failed to publish command error response: %s", err.Error())
+		}
+		return
+	}
 	event, edgexErr := application.GetCommand(ctx, deviceName, commandName, rawQuery, reserved[common.RegexCommand], dic)
 	if edgexErr != nil {
 		lc.Errorf("Failed to process get device command %s for device %s: %s", commandName, deviceName, edgexErr.Error())
@@ -128,7 +140,11 @@ func getCommand(ctx context.Context, msgEnvelope types.MessageEnvelope, response
 	var err error
 	var encoding string
 	var eventResponse any
-	if reserved[common.ReturnEvent] {
+	// no Event is returned nor pushed if all the readings are dropped
+	if event == nil {
+		encoding = common.ContentTypeJSON
+		eventResponse = commonDTO.NewBaseResponse(msgEnvelope.RequestID, application.DroppedReadingsMessage, http.StatusOK)
+	} else if reserved[common.ReturnEvent] {
 		resp := responses.NewEventResponse(msgEnvelope.RequestID, "", http.StatusOK, *event)
 		encoding = resp.GetEncodingContentType()
 		eventResponse = resp
@@ -159,7 +175,7 @@ func getCommand(ctx context.Context, msgEnvelope types.MessageEnvelope, response
 		return
 	}
 
-	if reserved[common.PushEvent] {
+	if reserved[common.PushEvent] && event != nil {
 		go sdkCommon.SendEvent(event, msgEnvelope.CorrelationID, dic)
 	}
 
@@ -171,6 +187,7 @@ func setCommand(ctx context.Context, msgEnvelope types.MessageEnvelope, response
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	messageBus := bootstrapContainer.MessagingClientFrom(dic.Get)
 	rawQuery, _ := filterQueryParams(msgEnvelope.QueryParams)
+	verify := msgEnvelope.QueryParams[sdkCommon.VerifyWriteParameter]
 	requestPayload, err := types.GetMsgPayload[map[string]any](msgEnvelope)
 	if err != nil {
 		lc.Errorf("Failed to decode set command request payload: %s", err.Error())
@@ -184,10 +201,19 @@ func setCommand(ctx context.Context, msgEnvelope types.MessageEnvelope, response
 
 	// TODO: fix properly in EdgeX 3.0
 	ctx = context.WithValue(ctx, common.CorrelationHeader, msgEnvelope.CorrelationID) // nolint: staticcheck
-	event, edgexErr := application.SetCommand(ctx, deviceName, commandName, rawQuery, requestPayload, dic)
+	ctx, edgexErr := transformer.WithRequestedUnits(ctx, msgEnvelope.QueryParams[sdkCommon.UnitsParameter])
 	if edgexErr != nil {
 		lc.Errorf("Failed to process set device command %s for device %s: %s", commandName, deviceName, edgexErr.Error())
 		responseEnvelope = types.NewMessageEnvelopeWithError(msgEnvelope.RequestID, edgexErr.Error())
+		if err := messageBus.Publish(responseEnvelope, responseTopic); err != nil {
+			lc.Errorf("Failed to publish command error response: %s", err.Error())
+		}
+		return
+	}
+	event, verification, edgexErr := application.SetCommand(ctx, deviceName, commandName, rawQuery, requestPayload, verify, dic)
+	if edgexErr != nil {
+		lc.Errorf("Failed to process set device command %s for device %s: %s", commandName, deviceName, edgexErr.Error())
+		responseEnvelope = setCommandErrorEnvelope(msgEnvelope, edgexErr, verification, lc)
 		err = messageBus.Publish(responseEnvelope, responseTopic)
 		if err != nil {
 			lc.Errorf("Failed to publish command response: %s", err.Error())
@@ -195,7 +221,15 @@ func setCommand(ctx context.Context, msgEnvelope types.MessageEnvelope, response
 		return
 	}
 
-	responseEnvelope, err = types.NewMessageEnvelopeForResponse(nil, msgEnvelope.RequestID, msgEnvelope.CorrelationID, common.ContentTypeJSON)
+	// the verification of the written values is reported in the verify modes
+	var setResponse any
+	if verification != nil {
+		setResponse = application.SetCommandResponse{
+			BaseResponse: commonDTO.NewBaseResponse(msgEnvelope.RequestID, "", http.StatusOK),
+			Verification: verification,
+		}
+	}
+	responseEnvelope, err = types.NewMessageEnvelopeForResponse(setResponse, msgEnvelope.RequestID, msgEnvelope.CorrelationID, common.ContentTypeJSON)
 	if err != nil {
 		lc.Errorf("Failed to create response message envelope: %s", err.Error())
 		responseEnvelope = types.NewMessageEnvelopeWithError(msgEnvelope.RequestID, err.Error())
@@ -244,3 +278,23 @@ func filterQueryParams(queries map[string]string) (string, map[string]bool) {
 
 	return strings.Join(rawQuery, "&"), reserved
 }
+
+// setCommandErrorEnvelope returns the error response of a SET command. When the written values are not verified,
+// the outcome of each DeviceResource is reported along with the error status code in a SetCommandResponse.
+func setCommandErrorEnvelope(msgEnvelope types.MessageEnvelope, edgexErr errors.EdgeX, verification []application.WriteVerification,
+	lc logger.LoggingClient) types.MessageEnvelope {
+	if verification == nil {
+		return types.NewMessageEnvelopeWithError(msgEnvelope.RequestID, edgexErr.Error())
+	}
+	res := application.SetCommandResponse{
+		BaseResponse: commonDTO.NewBaseResponse(msgEnvelope.RequestID, edgexErr.Error(), edgexErr.Code()),
+		Verification: verification,
+	}
+	responseEnvelope, err := types.NewMessageEnvelopeForResponse(res, msgEnvelope.RequestID, msgEnvelope.CorrelationID, common.ContentTypeJSON)
+	if err != nil {
+		lc.Errorf("Failed to create response message envelope: %s", err.Error())
+		return types.NewMessageEnvelopeWithError(msgEnvelope.RequestID, edgexErr.Error())
+	}
+	responseEnvelope.ErrorCode = 1
+	return responseEnvelope
+}
@@ -0,0 +1,44 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/application"
+)
+
+func TestSetCommandErrorEnvelope(t *testing.T) {
+	request := types.MessageEnvelope{RequestID: uuid.NewString(), CorrelationID: uuid.NewString()}
+
+	// an error without verification is reported as is
+	edgexErr := errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid request", nil)
+	envelope := setCommandErrorEnvelope(request, edgexErr, nil, logger.NewMockClient())
+	assert.Equal(t, request.RequestID, envelope.RequestID)
+	assert.Equal(t, 1, envelope.ErrorCode)
+	assert.Equal(t, edgexErr.Error(), envelope.Payload)
+
+	// a verification mismatch is reported along with its status code
+	edgexErr = errors.NewCommonEdgeX(errors.KindStatusConflict, "1 DeviceResource(s) don't match the written values", nil)
+	verification := []application.WriteVerification{{ResourceName: "r1", Status: application.WriteMismatch, Expected: "1", Actual: "2", Restored: true}}
+	envelope = setCommandErrorEnvelope(request, edgexErr, verification, logger.NewMockClient())
+	assert.Equal(t, request.RequestID, envelope.RequestID)
+	assert.Equal(t, request.CorrelationID, envelope.CorrelationID)
+	assert.Equal(t, 1, envelope.ErrorCode)
+	res, err := types.GetMsgPayload[application.SetCommandResponse](envelope)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, res.StatusCode)
+	assert.Equal(t, edgexErr.Error(), res.Message)
+	assert.Equal(t, verification, res.Verification)
+}
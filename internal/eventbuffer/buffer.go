@@ -0,0 +1,467 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package eventbuffer implements the store-and-forward buffer of the events which failed to be
+// published to the MessageBus. The buffer is a bounded log of segment files on disk, the oldest
+// segment is dropped once the configured size is exceeded.
+package eventbuffer
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+)
+
+const (
+	segmentFileExt = ".seg"
+	cursorFileName = "cursor"
+	// recordHeaderSize is the size of the length (uint32), timestamp (int64) and CRC32 (uint32) preceding each record
+	recordHeaderSize = 16
+)
+
+// Record is a MessageBus message kept in the Buffer until it has been published.
+type Record struct {
+	Topic    string
+	Envelope types.MessageEnvelope
+	// Readings is the number of readings of the buffered event
+	Readings int
+	// Timestamp is the time in nanoseconds when the record was appended to the Buffer
+	Timestamp int64
+
+	segment *segment
+	offset  int64
+}
+
+// Stats describes the backlog of the Buffer.
+type Stats struct {
+	// Count is the number of records waiting to be published
+	Count int
+	// Bytes is the disk usage of the segment files
+	Bytes int64
+	// OldestTimestamp is the time in nanoseconds when the oldest waiting record was appended, 0 if there is none
+	OldestTimestamp int64
+	// Dropped is the number of records dropped because the size limit was exceeded
+	Dropped int64
+}
+
+// storedRecord is the encoding of a Record in the segment files. The payload is kept apart from the
+// envelope so that it is published again exactly as it was encoded at first.
+type storedRecord struct {
+	Topic    string
+	Envelope types.MessageEnvelope
+	Payload  json.RawMessage `json:",omitempty"`
+	Binary   []byte          `json:",omitempty"`
+	Readings int
+}
+
+func encodeRecord(topic string, envelope types.MessageEnvelope, readings int) ([]byte, error) {
+	stored := storedRecord{Topic: topic, Envelope: envelope, Readings: readings}
+	if data, ok := envelope.Payload.([]byte); ok {
+		stored.Binary = data
+	} else {
+		payload, err := json.Marshal(envelope.Payload)
+		if err != nil {
+			return nil, err
+		}
+		stored.Payload = payload
+	}
+	stored.Envelope.Payload = nil
+	return json.Marshal(stored)
+}
+
+func decodeRecord(data []byte, record *Record) error {
+	var stored storedRecord
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return err
+	}
+	record.Topic = stored.Topic
+	record.Envelope = stored.Envelope
+	record.Readings = stored.Readings
+	if stored.Binary != nil {
+		record.Envelope.Payload = stored.Binary
+	} else {
+		record.Envelope.Payload = stored.Payload
+	}
+	return nil
+}
+
+type recordIndex struct {
+	offset    int64
+	timestamp int64
+}
+
+type segment struct {
+	seq     uint64
+	path    string
+	size    int64
+	records []recordIndex
+}
+
+// Buffer is a disk-backed FIFO of Records bounded by size.
+type Buffer struct {
+	mutex sync.Mutex
+	// sendMutex makes the backlog check and the appending of the senders atomic
+	sendMutex    sync.Mutex
+	dir          string
+	maxBytes     int64
+	segmentBytes int64
+	segments     []*segment
+	active       *os.File
+	dropped      int64
+	notify       chan struct{}
+}
+
+// Open opens the Buffer stored in dir, creating the directory if needed. The records left by a previous
+// run which have not been published yet are kept, and a truncated record at the end of a segment is discarded.
+func Open(dir string, maxBytes int64, segmentBytes int64) (*Buffer, error) {
+	if maxBytes <= 0 || segmentBytes <= 0 {
+		return nil, fmt.Errorf("invalid buffer size %d and segment size %d", maxBytes, segmentBytes)
+	}
+	if segmentBytes > maxBytes {
+		segmentBytes = maxBytes
+	}
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, fmt.Errorf("failed to create buffer directory %s: %w", dir, err)
+	}
+
+	b := &Buffer{
+		dir:          dir,
+		maxBytes:     maxBytes,
+		segmentBytes: segmentBytes,
+		notify:       make(chan struct{}, 1),
+	}
+	if err := b.load(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (b *Buffer) load() error {
+	entries, err := os.ReadDir(b.dir)
+	if err != nil {
+		return fmt.Errorf("failed to read buffer directory %s: %w", b.dir, err)
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, segmentFileExt) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, segmentFileExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		b.segments = append(b.segments, &segment{seq: seq, path: filepath.Join(b.dir, name)})
+	}
+	sort.Slice(b.segments, func(i, j int) bool { return b.segments[i].seq < b.segments[j].seq })
+
+	cursorSeq, cursorOffset := b.readCursor()
+	var kept []*segment
+	for _, s := range b.segments {
+		if s.seq < cursorSeq {
+			// fully published by a previous run
+			_ = os.Remove(s.path)
+			continue
+		}
+		if err := s.scan(); err != nil {
+			return err
+		}
+		if s.seq == cursorSeq {
+			for len(s.records) > 0 && s.records[0].offset < cursorOffset {
+				s.records = s.records[1:]
+			}
+		}
+		kept = append(kept, s)
+	}
+	b.segments = kept
+
+	if len(b.segments) > 0 {
+		last := b.segments[len(b.segments)-1]
+		if last.size < b.segmentBytes {
+			active, err := os.OpenFile(last.path, os.O_WRONLY|os.O_APPEND, 0640)
+			if err != nil {
+				return fmt.Errorf("failed to open buffer segment %s: %w", last.path, err)
+			}
+			b.active = active
+		}
+	}
+	return nil
+}
+
+// scan indexes the records of the segment and truncates the file after the last valid record.
+func (s *segment) scan() error {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("failed to read buffer segment %s: %w", s.path, err)
+	}
+	var offset int64
+	for int64(len(data))-offset >= recordHeaderSize {
+		length := int64(binary.BigEndian.Uint32(data[offset:]))
+		timestamp := int64(binary.BigEndian.Uint64(data[offset+4:]))
+		checksum := binary.BigEndian.Uint32(data[offset+12:])
+		end := offset + recordHeaderSize + length
+		if end > int64(len(data)) || crc32.ChecksumIEEE(data[offset+recordHeaderSize:end]) != checksum {
+			break
+		}
+		s.records = append(s.records, recordIndex{offset: offset, timestamp: timestamp})
+		offset = end
+	}
+	if offset != int64(len(data)) {
+		if err := os.Truncate(s.path, offset); err != nil {
+			return fmt.Errorf("failed to truncate buffer segment %s: %w", s.path, err)
+		}
+	}
+	s.size = offset
+	return nil
+}
+
+func (b *Buffer) readCursor() (uint64, int64) {
+	data, err := os.ReadFile(filepath.Join(b.dir, cursorFileName))
+	if err != nil {
+		return 0, 0
+	}
+	var seq uint64
+	var offset int64
+	if _, err := fmt.Sscanf(string(data), "%d %d", &seq, &offset); err != nil {
+		return 0, 0
+	}
+	return seq, offset
+}
+
+func (b *Buffer) writeCursor(seq uint64, offset int64) error {
+	path := filepath.Join(b.dir, cursorFileName)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(fmt.Sprintf("%d %d", seq, offset)), 0640); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Append adds the record to the end of the Buffer. The oldest segments are dropped when
+// the Buffer exceeds its maximum size.
+func (b *Buffer) Append(topic string, envelope types.MessageEnvelope, readings int) error {
+	data, err := encodeRecord(topic, envelope, readings)
+	if err != nil {
+		return fmt.Errorf("failed to encode buffered record: %w", err)
+	}
+	timestamp := time.Now().UnixNano()
+	header := make([]byte, recordHeaderSize)
+	binary.BigEndian.PutUint32(header, uint32(len(data))) // nolint: gosec
+	binary.BigEndian.PutUint64(header[4:], uint64(timestamp))
+	binary.BigEndian.PutUint32(header[12:], crc32.ChecksumIEEE(data))
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.active == nil || b.segments[len(b.segments)-1].size >= b.segmentBytes {
+		if err := b.rotate(); err != nil {
+			return err
+		}
+	}
+	current := b.segments[len(b.segments)-1]
+	if _, err := b.active.Write(append(header, data...)); err != nil {
+		return fmt.Errorf("failed to write buffer segment %s: %w", current.path, err)
+	}
+	current.records = append(current.records, recordIndex{offset: current.size, timestamp: timestamp})
+	current.size += int64(recordHeaderSize + len(data))
+
+	for b.bytes() > b.maxBytes && len(b.segments) > 1 {
+		oldest := b.segments[0]
+		b.dropped += int64(len(oldest.records))
+		b.segments = b.segments[1:]
+		_ = os.Remove(oldest.path)
+	}
+
+	select {
+	case b.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (b *Buffer) rotate() error {
+	if b.active != nil {
+		_ = b.active.Close()
+		b.active = nil
+	}
+	var seq uint64 = 1
+	if len(b.segments) > 0 {
+		seq = b.segments[len(b.segments)-1].seq + 1
+	}
+	path := filepath.Join(b.dir, fmt.Sprintf("%020d%s", seq, segmentFileExt))
+	active, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0640)
+	if err != nil {
+		return fmt.Errorf("failed to create buffer segment %s: %w", path, err)
+	}
+	b.active = active
+	b.segments = append(b.segments, &segment{seq: seq, path: path})
+	return nil
+}
+
+func (b *Buffer) bytes() int64 {
+	var total int64
+	for _, s := range b.segments {
+		total += s.size
+	}
+	return total
+}
+
+// Peek returns the oldest record waiting to be published, false if the Buffer is empty.
+func (b *Buffer) Peek() (Record, bool, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for _, s := range b.segments {
+		if len(s.records) == 0 {
+			continue
+		}
+		index := s.records[0]
+		record := Record{Timestamp: index.timestamp, segment: s, offset: index.offset}
+		data, err := readRecord(s.path, index.offset)
+		if err != nil {
+			return record, true, err
+		}
+		if err := decodeRecord(data, &record); err != nil {
+			return record, true, fmt.Errorf("failed to decode buffered record: %w", err)
+		}
+		return record, true, nil
+	}
+	return Record{}, false, nil
+}
+
+func readRecord(path string, offset int64) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open buffer segment %s: %w", path, err)
+	}
+	defer file.Close()
+
+	header := make([]byte, recordHeaderSize)
+	if _, err := file.ReadAt(header, offset); err != nil {
+		return nil, fmt.Errorf("failed to read buffer segment %s: %w", path, err)
+	}
+	data := make([]byte, binary.BigEndian.Uint32(header))
+	if _, err := file.ReadAt(data, offset+recordHeaderSize); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to read buffer segment %s: %w", path, err)
+	}
+	return data, nil
+}
+
+// Commit removes the record returned by Peek once it has been published. It is a no-op if the
+// record has been dropped in the meantime.
+func (b *Buffer) Commit(record Record) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if len(b.segments) == 0 {
+		return nil
+	}
+	i := 0
+	for i < len(b.segments) && len(b.segments[i].records) == 0 {
+		i++
+	}
+	if i == len(b.segments) {
+		return nil
+	}
+	s := b.segments[i]
+	if s != record.segment || s.records[0].offset != record.offset {
+		return nil
+	}
+	s.records = s.records[1:]
+
+	// drop the fully published segments except the active one
+	for len(b.segments) > 1 && len(b.segments[0].records) == 0 {
+		_ = os.Remove(b.segments[0].path)
+		b.segments = b.segments[1:]
+	}
+	next := b.segments[0]
+	nextOffset := next.size
+	if len(next.records) > 0 {
+		nextOffset = next.records[0].offset
+	}
+	return b.writeCursor(next.seq, nextOffset)
+}
+
+// Send publishes a new record with publish if the Buffer is empty. Otherwise, or if publish fails, the record is
+// appended behind the backlog with store, which calls Append. The backlog check and the appending are atomic, so
+// that no record is published ahead of a record appended before the check, while the publishing runs without lock,
+// so that the senders don't wait for each other while the MessageBus blocks. It returns whether the record was
+// published, and the error of publish if it failed.
+func (b *Buffer) Send(publish func() error, store func()) (bool, error) {
+	if b.storeBehindBacklog(store) {
+		return false, nil
+	}
+	if err := publish(); err != nil {
+		b.sendMutex.Lock()
+		defer b.sendMutex.Unlock()
+		store()
+		return false, err
+	}
+	return true, nil
+}
+
+// storeBehindBacklog stores the record with store if the Buffer isn't empty, and returns whether it was stored
+func (b *Buffer) storeBehindBacklog(store func()) bool {
+	b.sendMutex.Lock()
+	defer b.sendMutex.Unlock()
+
+	if b.Len() == 0 {
+		return false
+	}
+	store()
+	return true
+}
+
+// Len returns the number of records waiting to be published.
+func (b *Buffer) Len() int {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	count := 0
+	for _, s := range b.segments {
+		count += len(s.records)
+	}
+	return count
+}
+
+// Stats returns the current backlog of the Buffer.
+func (b *Buffer) Stats() Stats {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	stats := Stats{Bytes: b.bytes(), Dropped: b.dropped}
+	for _, s := range b.segments {
+		if len(s.records) > 0 && stats.OldestTimestamp == 0 {
+			stats.OldestTimestamp = s.records[0].timestamp
+		}
+		stats.Count += len(s.records)
+	}
+	return stats
+}
+
+// Close closes the active segment file. The records are kept on disk for the next run.
+func (b *Buffer) Close() error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.active == nil {
+		return nil
+	}
+	err := b.active.Close()
+	b.active = nil
+	return err
+}
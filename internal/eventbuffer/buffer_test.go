@@ -0,0 +1,283 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package eventbuffer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEnvelope(i int) types.MessageEnvelope {
+	return types.MessageEnvelope{CorrelationID: fmt.Sprintf("id-%d", i), Payload: []byte(fmt.Sprintf("event-%d", i))}
+}
+
+func drain(t *testing.T, b *Buffer) []string {
+	var ids []string
+	for {
+		record, ok, err := b.Peek()
+		require.NoError(t, err)
+		if !ok {
+			return ids
+		}
+		ids = append(ids, record.Envelope.CorrelationID)
+		require.NoError(t, b.Commit(record))
+	}
+}
+
+func TestBuffer_AppendPeekCommit(t *testing.T) {
+	b, err := Open(t.TempDir(), 1024*1024, 256)
+	require.NoError(t, err)
+	defer b.Close()
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, b.Append("topic", testEnvelope(i), i))
+	}
+	stats := b.Stats()
+	assert.Equal(t, 10, stats.Count)
+	assert.NotZero(t, stats.OldestTimestamp)
+
+	record, ok, err := b.Peek()
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "topic", record.Topic)
+	assert.Equal(t, []byte("event-0"), record.Envelope.Payload)
+	assert.Equal(t, 0, record.Readings)
+
+	// payloads other than bytes are published with the same JSON encoding
+	require.NoError(t, b.Append("topic", types.MessageEnvelope{CorrelationID: "id-10", Payload: map[string]any{"id": "event"}}, 1))
+
+	for i := 0; i < 10; i++ {
+		record, _, err := b.Peek()
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("id-%d", i), record.Envelope.CorrelationID)
+		require.NoError(t, b.Commit(record))
+	}
+	record, _, err = b.Peek()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"event"}`, string(record.Envelope.Payload.(json.RawMessage)))
+	require.NoError(t, b.Commit(record))
+
+	assert.Empty(t, drain(t, b))
+	assert.Equal(t, 0, b.Len())
+	assert.Zero(t, b.Stats().OldestTimestamp)
+}
+
+func TestBuffer_Reopen(t *testing.T) {
+	dir := t.TempDir()
+	b, err := Open(dir, 1024*1024, 256)
+	require.NoError(t, err)
+	for i := 0; i < 6; i++ {
+		require.NoError(t, b.Append("topic", testEnvelope(i), 1))
+	}
+	for i := 0; i < 2; i++ {
+		record, _, err := b.Peek()
+		require.NoError(t, err)
+		require.NoError(t, b.Commit(record))
+	}
+	require.NoError(t, b.Close())
+
+	// simulate a record partially written before a crash
+	segments, err := filepath.Glob(filepath.Join(dir, "*"+segmentFileExt))
+	require.NoError(t, err)
+	last := segments[len(segments)-1]
+	f, err := os.OpenFile(last, os.O_WRONLY|os.O_APPEND, 0640)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0, 0, 1})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	b, err = Open(dir, 1024*1024, 256)
+	require.NoError(t, err)
+	defer b.Close()
+	require.NoError(t, b.Append("topic", testEnvelope(6), 1))
+	assert.Equal(t, []string{"id-2", "id-3", "id-4", "id-5", "id-6"}, drain(t, b))
+}
+
+func TestBuffer_DropOldest(t *testing.T) {
+	b, err := Open(t.TempDir(), 400, 100)
+	require.NoError(t, err)
+	defer b.Close()
+
+	for i := 0; i < 50; i++ {
+		require.NoError(t, b.Append("topic", testEnvelope(i), 1))
+	}
+	stats := b.Stats()
+	assert.LessOrEqual(t, stats.Bytes, int64(400)+100)
+	assert.NotZero(t, stats.Dropped)
+	assert.Equal(t, 50, int(stats.Dropped)+stats.Count)
+
+	ids := drain(t, b)
+	assert.Equal(t, "id-49", ids[len(ids)-1])
+}
+
+func TestBuffer_Forward(t *testing.T) {
+	b, err := Open(t.TempDir(), 1024*1024, 1024)
+	require.NoError(t, err)
+	defer b.Close()
+
+	var mutex sync.Mutex
+	var published []string
+	failures := 2
+	publish := func(record Record) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if failures > 0 {
+			failures--
+			return errors.New("bus unavailable")
+		}
+		published = append(published, record.Envelope.CorrelationID)
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		b.Forward(ctx, time.Millisecond, publish, logger.NewMockClient())
+		close(done)
+	}()
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, b.Append("topic", testEnvelope(i), 1))
+	}
+	require.Eventually(t, func() bool { return b.Len() == 0 }, time.Second, time.Millisecond)
+	cancel()
+	<-done
+
+	assert.Equal(t, []string{"id-0", "id-1", "id-2"}, published)
+}
+
+func TestBuffer_Send(t *testing.T) {
+	b, err := Open(t.TempDir(), 1024*1024, 256)
+	require.NoError(t, err)
+	defer b.Close()
+
+	var published []string
+	send := func(i int, publishErr error) (bool, error) {
+		return b.Send(func() error {
+			if publishErr == nil {
+				published = append(published, testEnvelope(i).CorrelationID)
+			}
+			return publishErr
+		}, func() {
+			require.NoError(t, b.Append("topic", testEnvelope(i), 1))
+		})
+	}
+
+	// the record is published while the Buffer is empty
+	sent, err := send(0, nil)
+	require.NoError(t, err)
+	assert.True(t, sent)
+
+	// the record is appended if the publishing fails
+	sent, err = send(1, errors.New("failed"))
+	require.Error(t, err)
+	assert.False(t, sent)
+
+	// the record is appended behind the backlog without being published
+	sent, err = send(2, nil)
+	require.NoError(t, err)
+	assert.False(t, sent)
+
+	assert.Equal(t, []string{"id-0"}, published)
+	assert.Equal(t, []string{"id-1", "id-2"}, drain(t, b))
+}
+
+func TestBuffer_SendConcurrently(t *testing.T) {
+	b, err := Open(t.TempDir(), 1024*1024, 64*1024)
+	require.NoError(t, err)
+	defer b.Close()
+
+	var mutex sync.Mutex
+	var published []string
+	send := func(i int, publish func() error) (bool, error) {
+		return b.Send(func() error {
+			if err := publish(); err != nil {
+				return err
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			published = append(published, testEnvelope(i).CorrelationID)
+			return nil
+		}, func() {
+			require.NoError(t, b.Append("topic", testEnvelope(i), 1))
+		})
+	}
+	succeed := func() error { return nil }
+
+	// a blocked publishing doesn't block the other senders
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan bool)
+	go func() {
+		sent, _ := send(0, func() error {
+			close(started)
+			<-release
+			return nil
+		})
+		done <- sent
+	}()
+	<-started
+	sent, err := send(1, succeed)
+	require.NoError(t, err)
+	assert.True(t, sent)
+
+	// once a record has been appended, the later records are appended behind it
+	sent, err = send(2, func() error { return errors.New("failed") })
+	require.Error(t, err)
+	assert.False(t, sent)
+	sent, err = send(3, succeed)
+	require.NoError(t, err)
+	assert.False(t, sent)
+
+	close(release)
+	assert.True(t, <-done)
+	assert.Equal(t, []string{"id-1", "id-0"}, published)
+	assert.Equal(t, []string{"id-2", "id-3"}, drain(t, b))
+}
+
+func TestBuffer_SendRace(t *testing.T) {
+	b, err := Open(t.TempDir(), 1024*1024, 64*1024)
+	require.NoError(t, err)
+	defer b.Close()
+
+	// every record is either published or appended once
+	var published atomic.Int64
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sent, _ := b.Send(func() error {
+				if i%5 == 0 {
+					return errors.New("failed")
+				}
+				return nil
+			}, func() {
+				assert.NoError(t, b.Append("topic", testEnvelope(i), 1))
+			})
+			if sent {
+				published.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.NotZero(t, b.Len())
+	assert.Equal(t, 50, int(published.Load())+b.Len())
+}
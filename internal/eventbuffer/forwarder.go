@@ -0,0 +1,49 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package eventbuffer
+
+import (
+	"context"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+)
+
+// Forward publishes the buffered records in order until the context is done. While publishing fails,
+// the oldest record is retried every retryInterval so that the records are never reordered.
+func (b *Buffer) Forward(ctx context.Context, retryInterval time.Duration, publish func(Record) error, lc logger.LoggingClient) {
+	for {
+		record, ok, err := b.Peek()
+		switch {
+		case !ok:
+			select {
+			case <-ctx.Done():
+				return
+			case <-b.notify:
+			}
+			continue
+		case err != nil:
+			// an unreadable record would block the buffer forever, so it is skipped
+			lc.Errorf("Failed to read buffered event, the event is dropped: %v", err)
+		default:
+			if err := publish(record); err != nil {
+				lc.Debugf("Failed to publish buffered event to MessageBus, retry in %s: %v", retryInterval, err)
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(retryInterval):
+				}
+				continue
+			}
+			lc.Debugf("Buffered event published to MessageBus on topic: %s", record.Topic)
+		}
+
+		if err := b.Commit(record); err != nil {
+			lc.Errorf("Failed to update event buffer cursor: %v", err)
+		}
+	}
+}
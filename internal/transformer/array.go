@@ -0,0 +1,98 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transformer
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+func isNumericArrayValueType(cv *sdkModels.CommandValue) bool {
+	switch cv.Type {
+	case common.ValueTypeUint8Array:
+	case common.ValueTypeUint16Array:
+	case common.ValueTypeUint32Array:
+	case common.ValueTypeUint64Array:
+	case common.ValueTypeInt8Array:
+	case common.ValueTypeInt16Array:
+	case common.ValueTypeInt32Array:
+	case common.ValueTypeInt64Array:
+	case common.ValueTypeFloat32Array:
+	case common.ValueTypeFloat64Array:
+	default:
+		return false
+	}
+	return true
+}
+
+// transformArray applies the transform of a scalar value to each element of the numeric array value. It fails
+// with the error of the first element which fails, e.g. overflows, keeping the kind of the error and reporting the
+// index of the element. The elements are not transformed one by one on purpose: a read array with a failed element
+// is reported as a whole as a Bad quality reading, since the array of a DeviceResource is usually a single
+// measurement, like a waveform or a spectrum, and a written array is never partially written.
+func transformArray(value any, transform func(element any) (any, errors.EdgeX)) (any, errors.EdgeX) {
+	switch v := value.(type) {
+	case []uint8:
+		return transformElements(v, transform)
+	case []uint16:
+		return transformElements(v, transform)
+	case []uint32:
+		return transformElements(v, transform)
+	case []uint64:
+		return transformElements(v, transform)
+	case []int8:
+		return transformElements(v, transform)
+	case []int16:
+		return transformElements(v, transform)
+	case []int32:
+		return transformElements(v, transform)
+	case []int64:
+		return transformElements(v, transform)
+	case []float32:
+		return transformElements(v, transform)
+	case []float64:
+		return transformElements(v, transform)
+	}
+	return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported array value %T for transformation", value), nil)
+}
+
+func transformElements[T any](values []T, transform func(element any) (any, errors.EdgeX)) (any, errors.EdgeX) {
+	result := make([]T, len(values))
+	for i, v := range values {
+		transformed, err := transform(v)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to transform element %d of the array", i), err)
+		}
+		element, ok := transformed.(T)
+		if !ok {
+			errMsg := fmt.Sprintf("transformed element %d of the array is %T instead of %T", i, transformed, v)
+			return nil, errors.NewCommonEdgeX(errors.KindServerError, errMsg, nil)
+		}
+		result[i] = element
+	}
+	return result, nil
+}
+
+// checkNaNElement fails with errors.KindNaNError if the element of a float array is NaN
+func checkNaNElement(element any) errors.EdgeX {
+	var nan bool
+	switch v := element.(type) {
+	case float32:
+		nan = math.IsNaN(float64(v))
+	case float64:
+		nan = math.IsNaN(v)
+	}
+	if nan {
+		return errors.NewCommonEdgeX(errors.KindNaNError, "NaN element", nil)
+	}
+	return nil
+}
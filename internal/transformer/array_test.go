@@ -0,0 +1,134 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transformer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	contracts "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+func floatPointer(v float64) *float64 {
+	return &v
+}
+
+func TestTransformReadResult_Array(t *testing.T) {
+	mask := uint64(0x0F)
+	shift := int64(-4)
+	tests := []struct {
+		name         string
+		valueType    string
+		value        any
+		properties   contracts.ResourceProperties
+		expected     any
+		expectedKind errors.ErrKind
+	}{
+		{"valid - uint8 array scale", common.ValueTypeUint8Array, []uint8{1, 50}, contracts.ResourceProperties{Scale: floatPointer(5)}, []uint8{5, 250}, ""},
+		{"invalid - uint8 array scale overflow", common.ValueTypeUint8Array, []uint8{1, 52}, contracts.ResourceProperties{Scale: floatPointer(5)}, nil, errors.KindOverflowError},
+		{"valid - uint16 array mask", common.ValueTypeUint16Array, []uint16{0xAB, 0xCD}, contracts.ResourceProperties{Mask: &mask}, []uint16{0x0B, 0x0D}, ""},
+		{"valid - uint32 array shift", common.ValueTypeUint32Array, []uint32{0xAB, 0xCD}, contracts.ResourceProperties{Shift: &shift}, []uint32{0x0A, 0x0C}, ""},
+		{"valid - uint64 array offset", common.ValueTypeUint64Array, []uint64{1, 2}, contracts.ResourceProperties{Offset: floatPointer(10)}, []uint64{11, 12}, ""},
+		{"valid - int8 array base", common.ValueTypeInt8Array, []int8{1, 2}, contracts.ResourceProperties{Base: floatPointer(10)}, []int8{10, 100}, ""},
+		{"invalid - int8 array base overflow", common.ValueTypeInt8Array, []int8{1, 3}, contracts.ResourceProperties{Base: floatPointer(10)}, nil, errors.KindOverflowError},
+		{"valid - int16 array scale and offset", common.ValueTypeInt16Array, []int16{-2, 0, 2}, contracts.ResourceProperties{Scale: floatPointer(10), Offset: floatPointer(-5)}, []int16{-25, -5, 15}, ""},
+		{"invalid - int16 array offset overflow", common.ValueTypeInt16Array, []int16{0, math.MaxInt16}, contracts.ResourceProperties{Offset: floatPointer(1)}, nil, errors.KindOverflowError},
+		{"valid - int32 array mask not applied", common.ValueTypeInt32Array, []int32{0xAB}, contracts.ResourceProperties{Mask: &mask}, []int32{0xAB}, ""},
+		{"valid - int64 array scale", common.ValueTypeInt64Array, []int64{-1000000000, 1000000000}, contracts.ResourceProperties{Scale: floatPointer(1000000000)},
+			[]int64{-1000000000000000000, 1000000000000000000}, ""},
+		{"valid - float32 array scale and offset", common.ValueTypeFloat32Array, []float32{1.5, -2}, contracts.ResourceProperties{Scale: floatPointer(2), Offset: floatPointer(0.5)},
+			[]float32{3.5, -3.5}, ""},
+		{"invalid - float32 array scale overflow", common.ValueTypeFloat32Array, []float32{1, math.MaxFloat32 / 2}, contracts.ResourceProperties{Scale: floatPointer(3)}, nil, errors.KindOverflowError},
+		{"invalid - float32 array NaN", common.ValueTypeFloat32Array, []float32{1, float32(math.NaN())}, contracts.ResourceProperties{}, nil, errors.KindNaNError},
+		{"valid - float64 array offset", common.ValueTypeFloat64Array, []float64{1.25, 2.5}, contracts.ResourceProperties{Offset: floatPointer(-0.25)}, []float64{1, 2.25}, ""},
+		{"invalid - float64 array NaN", common.ValueTypeFloat64Array, []float64{math.NaN()}, contracts.ResourceProperties{Scale: floatPointer(2)}, nil, errors.KindNaNError},
+		{"valid - empty array", common.ValueTypeFloat64Array, []float64{}, contracts.ResourceProperties{Scale: floatPointer(2)}, []float64{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cv, err := models.NewCommandValue("array", tt.valueType, tt.value)
+			require.NoError(t, err)
+
+			edgexErr := TransformReadResult(cv, tt.properties, nil)
+			if tt.expectedKind != "" {
+				require.Error(t, edgexErr)
+				assert.Equal(t, tt.expectedKind, errors.Kind(edgexErr))
+				return
+			}
+			require.NoError(t, edgexErr)
+			assert.Equal(t, tt.expected, cv.Value)
+		})
+	}
+}
+
+func TestTransformReadResult_ArrayCalibration(t *testing.T) {
+	calibration, err := NewCalibration("0:0, 100:50", nil)
+	require.NoError(t, err)
+	cv, err := models.NewCommandValue("array", common.ValueTypeUint16Array, []uint16{0, 50, 200})
+	require.NoError(t, err)
+
+	edgexErr := TransformReadResult(cv, contracts.ResourceProperties{}, calibration)
+	require.NoError(t, edgexErr)
+	assert.Equal(t, []uint16{0, 25, 50}, cv.Value)
+}
+
+func TestTransformWriteParameter_Array(t *testing.T) {
+	tests := []struct {
+		name         string
+		valueType    string
+		value        any
+		properties   contracts.ResourceProperties
+		expected     any
+		expectedKind errors.ErrKind
+	}{
+		{"valid - uint8 array scale", common.ValueTypeUint8Array, []uint8{5, 250}, contracts.ResourceProperties{Scale: floatPointer(5)}, []uint8{1, 50}, ""},
+		{"valid - int16 array offset and scale", common.ValueTypeInt16Array, []int16{-25, 15}, contracts.ResourceProperties{Scale: floatPointer(10), Offset: floatPointer(-5)}, []int16{-2, 2}, ""},
+		{"invalid - int16 array offset overflow", common.ValueTypeInt16Array, []int16{0, math.MinInt16}, contracts.ResourceProperties{Offset: floatPointer(1)}, nil, errors.KindOverflowError},
+		{"valid - float32 array within range", common.ValueTypeFloat32Array, []float32{-1, 1}, contracts.ResourceProperties{Minimum: floatPointer(-1), Maximum: floatPointer(1)}, []float32{-1, 1}, ""},
+		{"invalid - float32 array above maximum", common.ValueTypeFloat32Array, []float32{0, 1.5}, contracts.ResourceProperties{Maximum: floatPointer(1)}, nil, errors.KindContractInvalid},
+		{"invalid - int32 array below minimum", common.ValueTypeInt32Array, []int32{-2, 0}, contracts.ResourceProperties{Minimum: floatPointer(-1)}, nil, errors.KindContractInvalid},
+		{"valid - float64 array base", common.ValueTypeFloat64Array, []float64{4, 8}, contracts.ResourceProperties{Base: floatPointer(2)}, []float64{2, 3}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cv, err := models.NewCommandValue("array", tt.valueType, tt.value)
+			require.NoError(t, err)
+
+			edgexErr := TransformWriteParameter(cv, tt.properties, nil)
+			if tt.expectedKind != "" {
+				require.Error(t, edgexErr)
+				assert.Equal(t, tt.expectedKind, errors.Kind(edgexErr))
+				return
+			}
+			require.NoError(t, edgexErr)
+			assert.Equal(t, tt.expected, cv.Value)
+		})
+	}
+}
+
+func Test_transformArray(t *testing.T) {
+	double := func(element any) (any, errors.EdgeX) {
+		return transformScale(element, 2, true)
+	}
+	res, err := transformArray([]int16{1, -2}, double)
+	require.NoError(t, err)
+	assert.Equal(t, []int16{2, -4}, res)
+
+	_, err = transformArray([]string{"1"}, double)
+	assert.Error(t, err, "expect non-numeric arrays to be rejected")
+
+	_, err = transformArray([]int8{1, 100}, double)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindOverflowError, errors.Kind(err))
+	assert.Contains(t, err.Error(), "element 1")
+}
@@ -0,0 +1,298 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transformer
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/spf13/cast"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/cache"
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+// BitField is a range of bits of an integer DeviceResource, the word, which is decoded to a Bool or integer
+// DeviceResource of its own
+type BitField struct {
+	// Name and ValueType are the ones of the bit field DeviceResource
+	Name      string
+	ValueType string
+	// Word is the name of the DeviceResource the bit field is part of
+	Word   string
+	Offset uint
+	Width  uint
+}
+
+// ResourceBitField returns the bit field of the DeviceResource, nil if the DeviceResource isn't a bit field
+func ResourceBitField(dr models.DeviceResource) (*BitField, errors.EdgeX) {
+	word, ok := dr.Attributes[sdkCommon.BitFieldWordAttribute]
+	if !ok {
+		return nil, nil
+	}
+	field := BitField{Name: dr.Name, ValueType: dr.Properties.ValueType, Word: cast.ToString(word), Width: 1}
+	if field.Word == "" || field.Word == dr.Name {
+		errMsg := fmt.Sprintf("invalid %s '%v' of DeviceResource %s", sdkCommon.BitFieldWordAttribute, word, dr.Name)
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+	}
+	if v, ok := dr.Attributes[sdkCommon.BitFieldOffsetAttribute]; ok {
+		offset, err := cast.ToUintE(v)
+		if err != nil {
+			errMsg := fmt.Sprintf("invalid %s of DeviceResource %s", sdkCommon.BitFieldOffsetAttribute, dr.Name)
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, err)
+		}
+		field.Offset = offset
+	}
+	if v, ok := dr.Attributes[sdkCommon.BitFieldWidthAttribute]; ok {
+		width, err := cast.ToUintE(v)
+		if err != nil || width == 0 || width > 64 {
+			errMsg := fmt.Sprintf("invalid %s of DeviceResource %s, expected 1 to 64 bits", sdkCommon.BitFieldWidthAttribute, dr.Name)
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, err)
+		}
+		field.Width = width
+	}
+
+	if field.ValueType == common.ValueTypeBool {
+		if field.Width != 1 {
+			errMsg := fmt.Sprintf("Bool bit field %s must be 1 bit wide", dr.Name)
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+		}
+	} else if size, _, ok := integerSize(field.ValueType); !ok {
+		errMsg := fmt.Sprintf("bit field %s must be of Bool or integer value type instead of %s", dr.Name, field.ValueType)
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+	} else if field.Width > size {
+		errMsg := fmt.Sprintf("%d-bit bit field %s doesn't fit in value type %s", field.Width, dr.Name, field.ValueType)
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+	}
+	return &field, nil
+}
+
+// Decode returns the CommandValue of the bit field decoded from the raw value of its word. A null word value is
+// decoded to a null value of the same quality.
+func (f *BitField) Decode(word *sdkModels.CommandValue) (*sdkModels.CommandValue, errors.EdgeX) {
+	if word.Value == nil {
+		return &sdkModels.CommandValue{DeviceResourceName: f.Name, Type: f.ValueType, Origin: word.Origin, Tags: make(map[string]string), Quality: word.Quality}, nil
+	}
+	raw, err := f.wordBits(word)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	bits := raw >> f.Offset & bitMask(f.Width)
+	var value any
+	if f.ValueType == common.ValueTypeBool {
+		value = bits == 1
+	} else {
+		if _, signed, _ := integerSize(f.ValueType); signed && f.Width < 64 && bits&(1<<(f.Width-1)) != 0 {
+			// sign extension of the two's complement bit field
+			bits |= ^bitMask(f.Width)
+		}
+		value = integerOfType(f.ValueType, bits)
+	}
+
+	cv, cvErr := sdkModels.NewCommandValue(f.Name, f.ValueType, value)
+	if cvErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(cvErr)
+	}
+	cv.Origin = word.Origin
+	cv.Quality = word.Quality
+	return cv, nil
+}
+
+// Merge sets the bits of the bit field in the value of its word to the value of the bit field
+func (f *BitField) Merge(word *sdkModels.CommandValue, field *sdkModels.CommandValue) errors.EdgeX {
+	raw, err := f.wordBits(word)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	mask := bitMask(f.Width)
+	var bits uint64
+	if f.ValueType == common.ValueTypeBool {
+		b, err := cast.ToBoolE(field.Value)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid value of bit field %s", f.Name), err)
+		}
+		if b {
+			bits = 1
+		}
+	} else if _, signed, _ := integerSize(f.ValueType); signed {
+		n, err := cast.ToInt64E(field.Value)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid value of bit field %s", f.Name), err)
+		}
+		if f.Width < 64 && (n < -int64(1)<<(f.Width-1) || n >= int64(1)<<(f.Width-1)) {
+			errMsg := fmt.Sprintf("value %d is out of the range of the %d-bit bit field %s", n, f.Width, f.Name)
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+		}
+		bits = uint64(n) & mask
+	} else {
+		n, err := cast.ToUint64E(field.Value)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid value of bit field %s", f.Name), err)
+		}
+		if n > mask {
+			errMsg := fmt.Sprintf("value %d is out of the range of the %d-bit bit field %s", n, f.Width, f.Name)
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+		}
+		bits = n
+	}
+
+	raw = raw&^(mask<<f.Offset) | bits<<f.Offset
+	word.Value = integerOfType(word.Type, raw)
+	return nil
+}
+
+// wordBits returns the raw bits of the word value, and checks that the bit field fits in the word
+func (f *BitField) wordBits(word *sdkModels.CommandValue) (uint64, errors.EdgeX) {
+	size, _, ok := integerSize(word.Type)
+	if !ok {
+		errMsg := fmt.Sprintf("word %s of bit field %s must be of integer value type instead of %s", f.Word, f.Name, word.Type)
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+	}
+	if f.Offset+f.Width > size {
+		errMsg := fmt.Sprintf("bit field %s at offset %d of %d bits exceeds the %d-bit word %s", f.Name, f.Offset, f.Width, size, f.Word)
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+	}
+
+	var raw uint64
+	switch v := word.Value.(type) {
+	case uint8:
+		raw = uint64(v)
+	case uint16:
+		raw = uint64(v)
+	case uint32:
+		raw = uint64(v)
+	case uint64:
+		raw = v
+	case int8:
+		raw = uint64(v)
+	case int16:
+		raw = uint64(v)
+	case int32:
+		raw = uint64(v)
+	case int64:
+		raw = uint64(v)
+	default:
+		errMsg := fmt.Sprintf("value %v of word %s doesn't match value type %s", word.Value, f.Word, word.Type)
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+	}
+	return raw & bitMask(size), nil
+}
+
+// bitFieldResources returns the bit field DeviceResources of the source, i.e. of a DeviceCommand or the
+// DeviceResource itself, and of the sources of its virtual DeviceResources. The words which are read only to
+// decode them are added to the hidden DeviceResources.
+func bitFieldResources(profileName string, sourceName string, virtuals []models.DeviceResource, hidden map[string]bool) []models.DeviceResource {
+	resourceNames := sourceResourceNames(profileName, sourceName)
+	candidates := slices.Clone(resourceNames)
+	for _, dr := range virtuals {
+		sources, err := VirtualSources(profileName, dr)
+		if err == nil {
+			candidates = append(candidates, sources...)
+		}
+	}
+
+	var fields []models.DeviceResource
+	seen := make(map[string]bool, len(candidates))
+	for _, name := range candidates {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		dr, ok := cache.Profiles().DeviceResource(profileName, name)
+		if !ok {
+			continue
+		}
+		if field, err := ResourceBitField(dr); err != nil || field == nil {
+			continue
+		} else if !slices.Contains(resourceNames, field.Word) {
+			hidden[field.Word] = true
+		}
+		fields = append(fields, dr)
+	}
+	return fields
+}
+
+// decodeBitFields decodes the bit field DeviceResources from the values of their words. The bit fields whose
+// word is missing are skipped.
+func decodeBitFields(fields []models.DeviceResource, cvs []*sdkModels.CommandValue) ([]*sdkModels.CommandValue, errors.EdgeX) {
+	decoded := make([]*sdkModels.CommandValue, 0, len(fields))
+	for _, dr := range fields {
+		field, err := ResourceBitField(dr)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		i := slices.IndexFunc(cvs, func(cv *sdkModels.CommandValue) bool { return cv != nil && cv.DeviceResourceName == field.Word })
+		if i < 0 {
+			continue
+		}
+		cv, err := field.Decode(cvs[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		decoded = append(decoded, cv)
+	}
+	return decoded, nil
+}
+
+// integerSize returns the size in bits and the signedness of the integer value type
+func integerSize(valueType string) (uint, bool, bool) {
+	switch valueType {
+	case common.ValueTypeUint8:
+		return 8, false, true
+	case common.ValueTypeUint16:
+		return 16, false, true
+	case common.ValueTypeUint32:
+		return 32, false, true
+	case common.ValueTypeUint64:
+		return 64, false, true
+	case common.ValueTypeInt8:
+		return 8, true, true
+	case common.ValueTypeInt16:
+		return 16, true, true
+	case common.ValueTypeInt32:
+		return 32, true, true
+	case common.ValueTypeInt64:
+		return 64, true, true
+	}
+	return 0, false, false
+}
+
+// integerOfType converts the raw bits to the integer value type, truncating the bits which don't fit
+func integerOfType(valueType string, bits uint64) any {
+	switch valueType {
+	case common.ValueTypeUint8:
+		return uint8(bits)
+	case common.ValueTypeUint16:
+		return uint16(bits)
+	case common.ValueTypeUint32:
+		return uint32(bits)
+	case common.ValueTypeUint64:
+		return bits
+	case common.ValueTypeInt8:
+		return int8(bits)
+	case common.ValueTypeInt16:
+		return int16(bits)
+	case common.ValueTypeInt32:
+		return int32(bits)
+	case common.ValueTypeInt64:
+		return int64(bits)
+	}
+	return nil
+}
+
+func bitMask(width uint) uint64 {
+	if width >= 64 {
+		return ^uint64(0)
+	}
+	return 1<<width - 1
+}
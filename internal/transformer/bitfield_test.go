@@ -0,0 +1,218 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transformer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/cache"
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+func bitFieldResource(name string, valueType string, word string, offset uint, width uint) models.DeviceResource {
+	return models.DeviceResource{
+		Name: name,
+		Attributes: map[string]any{
+			sdkCommon.BitFieldWordAttribute:   word,
+			sdkCommon.BitFieldOffsetAttribute: offset,
+			sdkCommon.BitFieldWidthAttribute:  width,
+		},
+		Properties: models.ResourceProperties{ValueType: valueType, ReadWrite: common.ReadWrite_RW},
+	}
+}
+
+func TestResourceBitField(t *testing.T) {
+	tests := []struct {
+		name        string
+		valueType   string
+		attributes  map[string]any
+		expected    *BitField
+		expectedErr bool
+	}{
+		{"not a bit field", common.ValueTypeBool, nil, nil, false},
+		{"valid - default offset and width", common.ValueTypeBool, map[string]any{sdkCommon.BitFieldWordAttribute: "status"},
+			&BitField{ValueType: common.ValueTypeBool, Word: "status", Offset: 0, Width: 1}, false},
+		{"valid - string attributes", common.ValueTypeUint8, map[string]any{sdkCommon.BitFieldWordAttribute: "status",
+			sdkCommon.BitFieldOffsetAttribute: "4", sdkCommon.BitFieldWidthAttribute: "3"},
+			&BitField{ValueType: common.ValueTypeUint8, Word: "status", Offset: 4, Width: 3}, false},
+		{"invalid - empty word", common.ValueTypeBool, map[string]any{sdkCommon.BitFieldWordAttribute: ""}, nil, true},
+		{"invalid - zero width", common.ValueTypeUint8, map[string]any{sdkCommon.BitFieldWordAttribute: "status", sdkCommon.BitFieldWidthAttribute: 0}, nil, true},
+		{"invalid - negative offset", common.ValueTypeUint8, map[string]any{sdkCommon.BitFieldWordAttribute: "status", sdkCommon.BitFieldOffsetAttribute: -1}, nil, true},
+		{"invalid - wide Bool", common.ValueTypeBool, map[string]any{sdkCommon.BitFieldWordAttribute: "status", sdkCommon.BitFieldWidthAttribute: 2}, nil, true},
+		{"invalid - wider than the value type", common.ValueTypeInt8, map[string]any{sdkCommon.BitFieldWordAttribute: "status", sdkCommon.BitFieldWidthAttribute: 9}, nil, true},
+		{"invalid - value type", common.ValueTypeFloat32, map[string]any{sdkCommon.BitFieldWordAttribute: "status"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dr := models.DeviceResource{Name: "field", Attributes: tt.attributes, Properties: models.ResourceProperties{ValueType: tt.valueType}}
+			field, err := ResourceBitField(dr)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.expected != nil {
+				tt.expected.Name = dr.Name
+			}
+			assert.Equal(t, tt.expected, field)
+		})
+	}
+}
+
+func TestBitField_Decode(t *testing.T) {
+	tests := []struct {
+		name      string
+		valueType string
+		offset    uint
+		width     uint
+		word      *sdkModels.CommandValue
+		expected  any
+		expectErr bool
+	}{
+		{"Bool set", common.ValueTypeBool, 3, 1, &sdkModels.CommandValue{Type: common.ValueTypeUint16, Value: uint16(0x0008)}, true, false},
+		{"Bool clear", common.ValueTypeBool, 2, 1, &sdkModels.CommandValue{Type: common.ValueTypeUint16, Value: uint16(0x0008)}, false, false},
+		{"unsigned range", common.ValueTypeUint8, 4, 4, &sdkModels.CommandValue{Type: common.ValueTypeUint16, Value: uint16(0xABCD)}, uint8(0xC), false},
+		{"signed range sign-extended", common.ValueTypeInt8, 12, 4, &sdkModels.CommandValue{Type: common.ValueTypeUint16, Value: uint16(0xABCD)}, int8(-6), false},
+		{"signed word", common.ValueTypeUint16, 8, 8, &sdkModels.CommandValue{Type: common.ValueTypeInt16, Value: int16(-2)}, uint16(0xFF), false},
+		{"whole 64-bit word", common.ValueTypeInt64, 0, 64, &sdkModels.CommandValue{Type: common.ValueTypeUint64, Value: uint64(1<<64 - 1)}, int64(-1), false},
+		{"exceeds the word", common.ValueTypeUint8, 6, 4, &sdkModels.CommandValue{Type: common.ValueTypeUint8, Value: uint8(0)}, nil, true},
+		{"word not integer", common.ValueTypeBool, 0, 1, &sdkModels.CommandValue{Type: common.ValueTypeFloat32, Value: float32(1)}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field := BitField{Name: "field", ValueType: tt.valueType, Word: "word", Offset: tt.offset, Width: tt.width}
+			cv, err := field.Decode(tt.word)
+			if tt.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "field", cv.DeviceResourceName)
+			assert.Equal(t, tt.valueType, cv.Type)
+			assert.Equal(t, tt.expected, cv.Value)
+		})
+	}
+}
+
+func TestBitField_Decode_BadWord(t *testing.T) {
+	word := &sdkModels.CommandValue{DeviceResourceName: "word", Type: common.ValueTypeUint16, Value: uint16(1)}
+	word.SetQuality(sdkModels.QualityBad, sdkModels.QualityReasonCommunicationFailure, "timeout")
+
+	field := BitField{Name: "field", ValueType: common.ValueTypeBool, Word: "word", Width: 1}
+	cv, err := field.Decode(word)
+	require.NoError(t, err)
+	assert.Nil(t, cv.Value)
+	assert.Equal(t, common.ValueTypeBool, cv.Type)
+	assert.Equal(t, sdkModels.QualityBad, cv.QualityStatus())
+}
+
+func TestBitField_Merge(t *testing.T) {
+	tests := []struct {
+		name      string
+		valueType string
+		offset    uint
+		width     uint
+		word      any
+		value     any
+		expected  any
+		expectErr bool
+	}{
+		{"set Bool", common.ValueTypeBool, 3, 1, uint16(0xFF00), true, uint16(0xFF08), false},
+		{"clear Bool", common.ValueTypeBool, 8, 1, uint16(0xFF00), false, uint16(0xFE00), false},
+		{"unsigned range", common.ValueTypeUint8, 4, 4, uint16(0xABCD), uint8(0x3), uint16(0xAB3D), false},
+		{"negative signed range", common.ValueTypeInt8, 12, 4, uint16(0x0BCD), int8(-1), uint16(0xFBCD), false},
+		{"signed word", common.ValueTypeUint8, 0, 8, int16(-1), uint8(0), int16(-256), false},
+		{"unsigned out of range", common.ValueTypeUint8, 4, 4, uint16(0), uint8(16), nil, true},
+		{"signed out of range", common.ValueTypeInt8, 4, 4, uint16(0), int8(-9), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			word, err := sdkModels.NewCommandValue("word", wordValueType(tt.word), tt.word)
+			require.NoError(t, err)
+			value, err := sdkModels.NewCommandValue("field", tt.valueType, tt.value)
+			require.NoError(t, err)
+
+			field := BitField{Name: "field", ValueType: tt.valueType, Word: "word", Offset: tt.offset, Width: tt.width}
+			edgexErr := field.Merge(word, value)
+			if tt.expectErr {
+				require.Error(t, edgexErr)
+				return
+			}
+			require.NoError(t, edgexErr)
+			assert.Equal(t, tt.expected, word.Value)
+		})
+	}
+}
+
+func wordValueType(v any) string {
+	switch v.(type) {
+	case int16:
+		return common.ValueTypeInt16
+	default:
+		return common.ValueTypeUint16
+	}
+}
+
+func TestCommandValuesToEventDTO_BitFields(t *testing.T) {
+	dic := NewMockDIC()
+	err := cache.InitCache(TestDeviceService, TestDeviceService, dic)
+	require.NoError(t, err)
+
+	scale := 2.0
+	level := bitFieldResource("level", common.ValueTypeUint8, "status", 4, 4)
+	level.Properties.Scale = &scale
+	profile := models.DeviceProfile{
+		Name: "bitFieldProfile",
+		DeviceResources: []models.DeviceResource{
+			{Name: "status", Properties: models.ResourceProperties{ValueType: common.ValueTypeUint16, ReadWrite: common.ReadWrite_RW}},
+			bitFieldResource("running", common.ValueTypeBool, "status", 0, 1),
+			bitFieldResource("fault", common.ValueTypeBool, "status", 1, 1),
+			level,
+		},
+		DeviceCommands: []models.DeviceCommand{
+			{Name: "state", ReadWrite: common.ReadWrite_R, ResourceOperations: []models.ResourceOperation{{DeviceResource: "running"}, {DeviceResource: "fault"}, {DeviceResource: "level"}}},
+			{Name: "raw", ReadWrite: common.ReadWrite_R, ResourceOperations: []models.ResourceOperation{{DeviceResource: "status"}, {DeviceResource: "fault"}}},
+		},
+	}
+	require.NoError(t, cache.Profiles().Add(profile))
+	require.NoError(t, cache.Devices().Add(models.Device{Name: "bitFieldDevice", ProfileName: profile.Name, ServiceName: TestDeviceService}))
+
+	tests := []struct {
+		name       string
+		sourceName string
+		expected   map[string]string
+	}{
+		{"fan out of the word, which is not reported", "state", map[string]string{"running": "true", "fault": "false", "level": "12"}},
+		{"word reported along", "raw", map[string]string{"status": "97", "fault": "false"}},
+		{"bit field DeviceResource", "level", map[string]string{"level": "12"}},
+		{"word DeviceResource", "status", map[string]string{"status": "97"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			word, err := sdkModels.NewCommandValue("status", common.ValueTypeUint16, uint16(0x61))
+			require.NoError(t, err)
+			cvs := []*sdkModels.CommandValue{word}
+
+			event, edgexErr := CommandValuesToEventDTO(context.Background(), cvs, "bitFieldDevice", tt.sourceName, true, dic)
+			require.NoError(t, edgexErr)
+			require.NotNil(t, event)
+			actual := make(map[string]string, len(event.Readings))
+			for _, r := range event.Readings {
+				actual[r.ResourceName] = r.Value
+			}
+			assert.Equal(t, tt.expected, actual)
+			assert.Len(t, cvs, 1, "expect the CommandValues of the driver not to be extended")
+		})
+	}
+}
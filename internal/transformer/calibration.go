@@ -0,0 +1,240 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transformer
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/spf13/cast"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+)
+
+const (
+	calibrationPoints = "points"
+	calibrationMode   = "mode"
+)
+
+// CalibrationPoint maps the device value X to the calibrated value Y
+type CalibrationPoint struct {
+	X float64
+	Y float64
+}
+
+// Calibration is a piecewise-linear calibration curve. The values out of the table are clamped to the first or
+// last point, or extrapolated from the first or last segment.
+type Calibration struct {
+	// Points are sorted by X
+	Points      []CalibrationPoint
+	Extrapolate bool
+}
+
+// ResourceCalibration returns the calibration of the DeviceResource for the device, from the ds-calibration
+// property of the device which takes precedence over the ds-calibration attribute of the DeviceResource.
+// It returns nil if the DeviceResource isn't calibrated.
+func ResourceCalibration(device models.Device, dr models.DeviceResource) (*Calibration, errors.EdgeX) {
+	if v, ok := device.Properties[sdkCommon.CalibrationProperty]; ok {
+		overrides, err := cast.ToStringMapE(v)
+		if err != nil {
+			errMsg := fmt.Sprintf("invalid %s property of device %s", sdkCommon.CalibrationProperty, device.Name)
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, err)
+		}
+		if override, ok := overrides[dr.Name]; ok {
+			table, mode := override, any(nil)
+			if m, err := cast.ToStringMapE(override); err == nil {
+				table, mode = m[calibrationPoints], m[calibrationMode]
+			}
+			c, err := NewCalibration(table, mode)
+			if err != nil {
+				errMsg := fmt.Sprintf("invalid calibration of DeviceResource %s for device %s", dr.Name, device.Name)
+				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, err)
+			}
+			return c, nil
+		}
+	}
+
+	table, ok := dr.Attributes[sdkCommon.CalibrationAttribute]
+	if !ok {
+		return nil, nil
+	}
+	c, err := NewCalibration(table, dr.Attributes[sdkCommon.CalibrationModeAttribute])
+	if err != nil {
+		errMsg := fmt.Sprintf("invalid %s of DeviceResource %s", sdkCommon.CalibrationAttribute, dr.Name)
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, err)
+	}
+	return c, nil
+}
+
+// NewCalibration parses the calibration table, as "x:y" points separated by commas, or a list of [x, y] lists or
+// {x, y} objects, and the mode, which is either "clamp" (default) or "extrapolate".
+func NewCalibration(table any, mode any) (*Calibration, error) {
+	var c Calibration
+	switch m := strings.ToLower(strings.TrimSpace(cast.ToString(mode))); m {
+	case "", sdkCommon.CalibrationClamp:
+	case sdkCommon.CalibrationExtrapolate:
+		c.Extrapolate = true
+	default:
+		return nil, fmt.Errorf("unknown calibration mode '%s', expected %s or %s", m, sdkCommon.CalibrationClamp, sdkCommon.CalibrationExtrapolate)
+	}
+
+	var err error
+	if s, ok := table.(string); ok {
+		c.Points, err = parseCalibrationString(s)
+	} else {
+		c.Points, err = parseCalibrationList(table)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(c.Points) < 2 {
+		return nil, fmt.Errorf("calibration table must have at least 2 points")
+	}
+	slices.SortFunc(c.Points, func(a, b CalibrationPoint) int {
+		switch {
+		case a.X < b.X:
+			return -1
+		case a.X > b.X:
+			return 1
+		}
+		return 0
+	})
+	for i := 1; i < len(c.Points); i++ {
+		if c.Points[i].X == c.Points[i-1].X {
+			return nil, fmt.Errorf("calibration table has several points at %v", c.Points[i].X)
+		}
+	}
+	return &c, nil
+}
+
+func parseCalibrationString(table string) ([]CalibrationPoint, error) {
+	var points []CalibrationPoint
+	for _, pair := range strings.Split(table, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		x, y, ok := strings.Cut(pair, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid calibration point '%s', expected x:y", strings.TrimSpace(pair))
+		}
+		point, err := newCalibrationPoint(strings.TrimSpace(x), strings.TrimSpace(y))
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, point)
+	}
+	return points, nil
+}
+
+func parseCalibrationList(table any) ([]CalibrationPoint, error) {
+	list, err := cast.ToSliceE(table)
+	if err != nil {
+		return nil, fmt.Errorf("invalid calibration table: %w", err)
+	}
+	points := make([]CalibrationPoint, 0, len(list))
+	for _, item := range list {
+		var x, y any
+		if m, err := cast.ToStringMapE(item); err == nil {
+			x, y = m["x"], m["y"]
+		} else if pair, err := cast.ToSliceE(item); err == nil && len(pair) == 2 {
+			x, y = pair[0], pair[1]
+		} else {
+			return nil, fmt.Errorf("invalid calibration point %v, expected [x, y] or {x, y}", item)
+		}
+		point, err := newCalibrationPoint(x, y)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, point)
+	}
+	return points, nil
+}
+
+func newCalibrationPoint(x any, y any) (CalibrationPoint, error) {
+	xValue, err := cast.ToFloat64E(x)
+	if err != nil {
+		return CalibrationPoint{}, fmt.Errorf("invalid x %v of calibration point: %w", x, err)
+	}
+	yValue, err := cast.ToFloat64E(y)
+	if err != nil {
+		return CalibrationPoint{}, fmt.Errorf("invalid y %v of calibration point: %w", y, err)
+	}
+	return CalibrationPoint{X: xValue, Y: yValue}, nil
+}
+
+// Apply returns the calibrated value of the device value
+func (c *Calibration) Apply(x float64) float64 {
+	return interpolate(c.Points, x, c.Extrapolate)
+}
+
+// Invert returns the device value of the calibrated value. It fails if the curve is not strictly monotonic, since
+// several device values would be calibrated to the same value.
+func (c *Calibration) Invert(y float64) (float64, error) {
+	inverse := make([]CalibrationPoint, len(c.Points))
+	for i, p := range c.Points {
+		inverse[i] = CalibrationPoint{X: p.Y, Y: p.X}
+	}
+	if inverse[0].X > inverse[len(inverse)-1].X {
+		slices.Reverse(inverse)
+	}
+	for i := 1; i < len(inverse); i++ {
+		if inverse[i].X <= inverse[i-1].X {
+			return 0, fmt.Errorf("calibration table is not strictly monotonic and can't be inverted")
+		}
+	}
+	return interpolate(inverse, y, c.Extrapolate), nil
+}
+
+// interpolate linearly interpolates the points sorted by X
+func interpolate(points []CalibrationPoint, x float64, extrapolate bool) float64 {
+	last := len(points) - 1
+	if !extrapolate {
+		if x <= points[0].X {
+			return points[0].Y
+		} else if x >= points[last].X {
+			return points[last].Y
+		}
+	}
+
+	// the segment containing x, or the first or last segment to extrapolate
+	i, _ := slices.BinarySearchFunc(points, x, func(p CalibrationPoint, x float64) int {
+		switch {
+		case p.X < x:
+			return -1
+		case p.X > x:
+			return 1
+		}
+		return 0
+	})
+	i = min(max(i, 1), last)
+	p0, p1 := points[i-1], points[i]
+	return p0.Y + (x-p0.X)*(p1.Y-p0.Y)/(p1.X-p0.X)
+}
+
+func transformCalibration(value any, calibration *Calibration, read bool) (any, errors.EdgeX) {
+	v, err := cast.ToFloat64E(value)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to calibrate value", err)
+	}
+
+	var result float64
+	if read {
+		result = calibration.Apply(v)
+	} else if result, err = calibration.Invert(v); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to calibrate value", err)
+	}
+
+	transformed, edgexErr := float64ToTypeOf(value, result)
+	if edgexErr != nil {
+		errMsg := fmt.Sprintf("calibrated value %v of %v is out of the range of %T", result, value, value)
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgexErr), errMsg, edgexErr)
+	}
+	return transformed, nil
+}
@@ -0,0 +1,162 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transformer
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+func TestNewCalibration(t *testing.T) {
+	expected := []CalibrationPoint{{X: 0, Y: -40}, {X: 100, Y: 25}, {X: 200, Y: 80}}
+	tests := []struct {
+		name        string
+		table       any
+		mode        any
+		extrapolate bool
+		expectedErr bool
+	}{
+		{"valid - string sorted by x", "100:25, 0:-40, 200:80", nil, false, false},
+		{"valid - list of pairs", []any{[]any{0, -40}, []any{100, 25}, []any{200, "80"}}, "Extrapolate", true, false},
+		{"valid - list of objects", []any{map[string]any{"x": 0, "y": -40}, map[string]any{"x": 100, "y": 25}, map[string]any{"x": 200, "y": 80}}, sdkCommon.CalibrationClamp, false, false},
+		{"invalid - single point", "0:1", nil, false, true},
+		{"invalid - duplicated x", "0:1, 0:2", nil, false, true},
+		{"invalid - point", "0:1, 2", nil, false, true},
+		{"invalid - number", "0:1, 2:a", nil, false, true},
+		{"invalid - pair", []any{[]any{0, 1, 2}, []any{3, 4}}, nil, false, true},
+		{"invalid - mode", "0:1, 2:3", "nearest", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewCalibration(tt.table, tt.mode)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, expected, c.Points)
+			assert.Equal(t, tt.extrapolate, c.Extrapolate)
+		})
+	}
+}
+
+func TestCalibration_ApplyAndInvert(t *testing.T) {
+	points := []CalibrationPoint{{X: 0, Y: 100}, {X: 10, Y: 50}, {X: 20, Y: 0}}
+	clamp := &Calibration{Points: points}
+	extrapolate := &Calibration{Points: points, Extrapolate: true}
+
+	tests := []struct {
+		name        string
+		calibration *Calibration
+		x           float64
+		y           float64
+	}{
+		{"point", clamp, 10, 50},
+		{"between points", clamp, 15, 25},
+		{"clamped below", clamp, -5, 100},
+		{"clamped above", clamp, 30, 0},
+		{"extrapolated below", extrapolate, -5, 125},
+		{"extrapolated above", extrapolate, 30, -50},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.y, tt.calibration.Apply(tt.x), 1e-9)
+			x, err := tt.calibration.Invert(tt.y)
+			require.NoError(t, err)
+			if tt.calibration.Extrapolate || (tt.x >= 0 && tt.x <= 20) {
+				assert.InDelta(t, tt.x, x, 1e-9)
+			}
+		})
+	}
+
+	notMonotonic := &Calibration{Points: []CalibrationPoint{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 0}}}
+	_, err := notMonotonic.Invert(0.5)
+	assert.Error(t, err)
+}
+
+func TestResourceCalibration(t *testing.T) {
+	dr := models.DeviceResource{Name: "temperature", Attributes: map[string]any{sdkCommon.CalibrationAttribute: "0:0, 10:100"}}
+	tests := []struct {
+		name        string
+		properties  map[string]any
+		dr          models.DeviceResource
+		expected    *Calibration
+		expectedErr bool
+	}{
+		{"not calibrated", nil, models.DeviceResource{Name: "temperature"}, nil, false},
+		{"DeviceResource calibration", nil, dr, &Calibration{Points: []CalibrationPoint{{0, 0}, {10, 100}}}, false},
+		{"device calibration of another DeviceResource", map[string]any{sdkCommon.CalibrationProperty: map[string]any{"humidity": "0:0, 1:1"}}, dr,
+			&Calibration{Points: []CalibrationPoint{{0, 0}, {10, 100}}}, false},
+		{"device calibration table", map[string]any{sdkCommon.CalibrationProperty: map[string]any{"temperature": "0:1, 10:101"}}, dr,
+			&Calibration{Points: []CalibrationPoint{{0, 1}, {10, 101}}}, false},
+		{"device calibration with mode", map[string]any{sdkCommon.CalibrationProperty: map[string]any{
+			"temperature": map[string]any{"points": []any{[]any{0, 2}, []any{10, 102}}, "mode": sdkCommon.CalibrationExtrapolate}}}, dr,
+			&Calibration{Points: []CalibrationPoint{{0, 2}, {10, 102}}, Extrapolate: true}, false},
+		{"invalid device property", map[string]any{sdkCommon.CalibrationProperty: "0:0"}, dr, nil, true},
+		{"invalid device calibration", map[string]any{sdkCommon.CalibrationProperty: map[string]any{"temperature": "0:0"}}, dr, nil, true},
+		{"invalid DeviceResource calibration", nil, models.DeviceResource{Name: "temperature", Attributes: map[string]any{
+			sdkCommon.CalibrationAttribute: "0:0, 1:1", sdkCommon.CalibrationModeAttribute: "invalid"}}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ResourceCalibration(models.Device{Name: "device", Properties: tt.properties}, tt.dr)
+			if tt.expectedErr {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, c)
+		})
+	}
+}
+
+func TestTransformCalibration(t *testing.T) {
+	calibration := &Calibration{Points: []CalibrationPoint{{X: 0, Y: -40}, {X: 1023, Y: 125}}, Extrapolate: true}
+	offset := 1.0
+	tests := []struct {
+		name         string
+		valueType    string
+		value        any
+		properties   models.ResourceProperties
+		expectedRead any
+		expectedKind errors.ErrKind
+	}{
+		{"uint16 rounded", common.ValueTypeUint16, uint16(512), models.ResourceProperties{}, uint16(43), ""},
+		{"float32 with offset", common.ValueTypeFloat32, float32(1023), models.ResourceProperties{Offset: &offset}, float32(126), ""},
+		{"uint8 negative overflow", common.ValueTypeUint8, uint8(0), models.ResourceProperties{}, nil, errors.KindOverflowError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cv, err := sdkModels.NewCommandValue("resource", tt.valueType, tt.value)
+			require.NoError(t, err)
+			edgexErr := TransformReadResult(cv, tt.properties, calibration)
+			if tt.expectedKind != "" {
+				require.Error(t, edgexErr)
+				assert.Equal(t, tt.expectedKind, errors.Kind(edgexErr))
+				return
+			}
+			require.NoError(t, edgexErr)
+			assert.Equal(t, tt.expectedRead, cv.Value)
+
+			// the write inverts the read
+			if tt.valueType == common.ValueTypeFloat32 {
+				edgexErr = TransformWriteParameter(cv, tt.properties, calibration)
+				require.NoError(t, edgexErr)
+				assert.InDelta(t, tt.value, cv.Value, 1e-3)
+			}
+		})
+	}
+}
@@ -0,0 +1,550 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transformer
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/spf13/cast"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+const (
+	// ExpressionValue is the variable of an expression holding the value being transformed
+	ExpressionValue = "value"
+
+	// maxExpressionLength and maxExpressionDepth bound the cost of compiling and evaluating an expression
+	maxExpressionLength = 1024
+	maxExpressionDepth  = 64
+)
+
+// expressionFunctions are the only functions callable from an expression, keyed by name with their arity
+var expressionFunctions = map[string]struct {
+	arity int
+	fn    func(args []float64) float64
+}{
+	"abs":   {1, func(a []float64) float64 { return math.Abs(a[0]) }},
+	"sqrt":  {1, func(a []float64) float64 { return math.Sqrt(a[0]) }},
+	"exp":   {1, func(a []float64) float64 { return math.Exp(a[0]) }},
+	"log":   {1, func(a []float64) float64 { return math.Log(a[0]) }},
+	"log10": {1, func(a []float64) float64 { return math.Log10(a[0]) }},
+	"floor": {1, func(a []float64) float64 { return math.Floor(a[0]) }},
+	"ceil":  {1, func(a []float64) float64 { return math.Ceil(a[0]) }},
+	"round": {1, func(a []float64) float64 { return math.Round(a[0]) }},
+	"trunc": {1, func(a []float64) float64 { return math.Trunc(a[0]) }},
+	"sin":   {1, func(a []float64) float64 { return math.Sin(a[0]) }},
+	"cos":   {1, func(a []float64) float64 { return math.Cos(a[0]) }},
+	"tan":   {1, func(a []float64) float64 { return math.Tan(a[0]) }},
+	"pow":   {2, func(a []float64) float64 { return math.Pow(a[0], a[1]) }},
+	"min":   {2, func(a []float64) float64 { return math.Min(a[0], a[1]) }},
+	"max":   {2, func(a []float64) float64 { return math.Max(a[0], a[1]) }},
+}
+
+// expressionNode evaluates a node of a compiled expression against the variables
+type expressionNode func(vars map[string]float64) (float64, error)
+
+// expression is a compiled arithmetic expression. The grammar only allows numbers, variables, the operators
+// + - * / % ^ and the expressionFunctions, so an expression can't have side effects and always terminates.
+type expression struct {
+	source    string
+	root      expressionNode
+	variables []string
+}
+
+func (e *expression) evaluate(vars map[string]float64) (float64, error) {
+	return e.root(vars)
+}
+
+type expressionCacheKey struct {
+	profileName  string
+	resourceName string
+	attribute    string
+}
+
+var (
+	expressionCache      = make(map[expressionCacheKey]*expression)
+	expressionCacheMutex sync.RWMutex
+)
+
+// compiledExpression returns the compiled expression of the attribute of a DeviceResource, compiling it only the
+// first time or when the expression of the profile has been updated since.
+func compiledExpression(profileName string, resourceName string, attribute string, source string) (*expression, errors.EdgeX) {
+	key := expressionCacheKey{profileName: profileName, resourceName: resourceName, attribute: attribute}
+	expressionCacheMutex.RLock()
+	e, ok := expressionCache[key]
+	expressionCacheMutex.RUnlock()
+	if ok && e.source == source {
+		return e, nil
+	}
+
+	e, err := compileExpression(source)
+	if err != nil {
+		errMsg := fmt.Sprintf("invalid %s of DeviceResource %s in profile %s", attribute, resourceName, profileName)
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, err)
+	}
+	expressionCacheMutex.Lock()
+	expressionCache[key] = e
+	expressionCacheMutex.Unlock()
+	return e, nil
+}
+
+// RemoveProfileExpressions drops the compiled expressions of the profile, which must be called once the profile is
+// updated or removed.
+func RemoveProfileExpressions(profileName string) {
+	expressionCacheMutex.Lock()
+	defer expressionCacheMutex.Unlock()
+	for key := range expressionCache {
+		if key.profileName == profileName {
+			delete(expressionCache, key)
+		}
+	}
+}
+
+// checkExpressionValueType rejects the expressions of the Int64 and Uint64 DeviceResources, since an expression is
+// evaluated in float64 which can't represent their values above 2^53.
+func checkExpressionValueType(resourceName string, valueType string, attribute string) errors.EdgeX {
+	if valueType != common.ValueTypeInt64 && valueType != common.ValueTypeUint64 {
+		return nil
+	}
+	errMsg := fmt.Sprintf("%s of DeviceResource %s is not supported for the value type %s", attribute, resourceName, valueType)
+	return errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+}
+
+// TransformReadExpression applies the ds-readexpression attribute of the DeviceResource to the CommandValue after
+// the fixed read transforms. Besides the value, the expression can refer to the other DeviceResources of the same
+// reading by name, with their values as read from the device. The Int64 and Uint64 DeviceResources can't have an
+// expression.
+func TransformReadExpression(cv *sdkModels.CommandValue, profileName string, dr models.DeviceResource, rawValues map[string]float64) errors.EdgeX {
+	source, ok := expressionAttribute(dr, sdkCommon.ReadExpressionAttribute)
+	if !ok || !isNumericValueType(cv) {
+		return nil
+	}
+	if err := checkExpressionValueType(dr.Name, cv.Type, sdkCommon.ReadExpressionAttribute); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	e, err := compiledExpression(profileName, dr.Name, sdkCommon.ReadExpressionAttribute, source)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return applyExpression(cv, e, rawValues)
+}
+
+// TransformWriteExpression applies the ds-writeexpression attribute of the DeviceResource to the CommandValue
+// before the fixed write transforms. It is the inverse of the ds-readexpression, so a DeviceResource with a read
+// expression can't be written without a write expression.
+func TransformWriteExpression(cv *sdkModels.CommandValue, profileName string, dr models.DeviceResource) errors.EdgeX {
+	source, ok := expressionAttribute(dr, sdkCommon.WriteExpressionAttribute)
+	if !ok {
+		if _, hasRead := expressionAttribute(dr, sdkCommon.ReadExpressionAttribute); hasRead && isNumericValueType(cv) {
+			errMsg := fmt.Sprintf("DeviceResource %s has a %s but no %s to write it", dr.Name, sdkCommon.ReadExpressionAttribute,
+				sdkCommon.WriteExpressionAttribute)
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+		}
+		return nil
+	}
+	if !isNumericValueType(cv) {
+		return nil
+	}
+	if err := checkExpressionValueType(dr.Name, cv.Type, sdkCommon.WriteExpressionAttribute); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	e, err := compiledExpression(profileName, dr.Name, sdkCommon.WriteExpressionAttribute, source)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return applyExpression(cv, e, nil)
+}
+
+// ExpressionVariables returns the values of the numeric CommandValues by DeviceResource name, to be referred by
+// the read expressions of the other DeviceResources. It must be called before any CommandValue is transformed.
+func ExpressionVariables(cvs []*sdkModels.CommandValue) map[string]float64 {
+	vars := make(map[string]float64, len(cvs))
+	for _, cv := range cvs {
+		if cv == nil || cv.Value == nil || !isNumericValueType(cv) {
+			continue
+		}
+		if v, err := cast.ToFloat64E(cv.Value); err == nil {
+			vars[cv.DeviceResourceName] = v
+		}
+	}
+	return vars
+}
+
+func expressionAttribute(dr models.DeviceResource, attribute string) (string, bool) {
+	v, ok := dr.Attributes[attribute]
+	if !ok {
+		return "", false
+	}
+	source := strings.TrimSpace(cast.ToString(v))
+	return source, source != ""
+}
+
+func applyExpression(cv *sdkModels.CommandValue, e *expression, rawValues map[string]float64) errors.EdgeX {
+	if cv.Value == nil {
+		return nil
+	}
+	res, edgexErr := isNaN(cv)
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	} else if res {
+		errMSg := fmt.Sprintf("NaN error for DeviceResource %s", cv.DeviceResourceName)
+		return errors.NewCommonEdgeX(errors.KindNaNError, errMSg, nil)
+	}
+
+	value, err := cast.ToFloat64E(cv.Value)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to evaluate expression of DeviceResource %s", cv.DeviceResourceName), err)
+	}
+	vars := make(map[string]float64, len(e.variables))
+	for _, name := range e.variables {
+		if name == ExpressionValue {
+			vars[name] = value
+		} else if v, ok := rawValues[name]; ok {
+			vars[name] = v
+		}
+	}
+	result, err := e.evaluate(vars)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to evaluate expression '%s' of DeviceResource %s", e.source, cv.DeviceResourceName)
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, err)
+	}
+
+	transformed, edgexErr := float64ToValueType(cv.Type, result)
+	if edgexErr != nil {
+		errMsg := fmt.Sprintf("failed to transform DeviceResource %s with expression '%s'", cv.DeviceResourceName, e.source)
+		return errors.NewCommonEdgeX(errors.Kind(edgexErr), errMsg, edgexErr)
+	}
+	cv.Value = transformed
+	return nil
+}
+
+// compileExpression parses the source into a tree of expressionNode closures
+func compileExpression(source string) (*expression, error) {
+	if len(source) > maxExpressionLength {
+		return nil, fmt.Errorf("expression is longer than %d characters", maxExpressionLength)
+	}
+	tokens, err := tokenizeExpression(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &expressionParser{tokens: tokens, variables: make(map[string]bool)}
+	root, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected '%s' at position %d", p.tokens[p.pos].text, p.tokens[p.pos].pos)
+	}
+
+	e := &expression{source: source, root: root}
+	for name := range p.variables {
+		e.variables = append(e.variables, name)
+	}
+	return e, nil
+}
+
+type tokenKind int
+
+const (
+	tokenNumber tokenKind = iota
+	tokenIdent
+	tokenOperator
+)
+
+type expressionToken struct {
+	kind   tokenKind
+	text   string
+	number float64
+	pos    int
+}
+
+func tokenizeExpression(source string) ([]expressionToken, error) {
+	var tokens []expressionToken
+	runes := []rune(source)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || r == '.':
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			// exponent of the scientific notation, e.g. 1.5e-3
+			if i < len(runes) && (runes[i] == 'e' || runes[i] == 'E') {
+				j := i + 1
+				if j < len(runes) && (runes[j] == '+' || runes[j] == '-') {
+					j++
+				}
+				if j < len(runes) && unicode.IsDigit(runes[j]) {
+					for i = j; i < len(runes) && unicode.IsDigit(runes[i]); i++ {
+					}
+				}
+			}
+			text := string(runes[start:i])
+			v, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number '%s' at position %d", text, start)
+			}
+			tokens = append(tokens, expressionToken{kind: tokenNumber, text: text, number: v, pos: start})
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, expressionToken{kind: tokenIdent, text: string(runes[start:i]), pos: start})
+		case strings.ContainsRune("+-*/%^(),", r):
+			tokens = append(tokens, expressionToken{kind: tokenOperator, text: string(r), pos: i})
+			i++
+		default:
+			return nil, fmt.Errorf("unexpected character '%c' at position %d", r, i)
+		}
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty expression")
+	}
+	return tokens, nil
+}
+
+// expressionParser is a recursive descent parser of the grammar
+//
+//	sum     = product { ("+" | "-") product }
+//	product = unary { ("*" | "/" | "%") unary }
+//	unary   = ("+" | "-") unary | power
+//	power   = primary [ "^" unary ]
+//	primary = number | variable | function "(" sum { "," sum } ")" | "(" sum ")"
+type expressionParser struct {
+	tokens    []expressionToken
+	pos       int
+	depth     int
+	variables map[string]bool
+}
+
+func (p *expressionParser) peekOperator(operators string) (string, bool) {
+	if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != tokenOperator || !strings.Contains(operators, p.tokens[p.pos].text) {
+		return "", false
+	}
+	return p.tokens[p.pos].text, true
+}
+
+func (p *expressionParser) expectOperator(operator string) error {
+	if op, ok := p.peekOperator(operator); ok && op == operator {
+		p.pos++
+		return nil
+	}
+	if p.pos >= len(p.tokens) {
+		return fmt.Errorf("expected '%s' at the end of the expression", operator)
+	}
+	return fmt.Errorf("expected '%s' at position %d", operator, p.tokens[p.pos].pos)
+}
+
+func (p *expressionParser) enter() error {
+	p.depth++
+	if p.depth > maxExpressionDepth {
+		return fmt.Errorf("expression is nested deeper than %d levels", maxExpressionDepth)
+	}
+	return nil
+}
+
+func (p *expressionParser) parseSum() (expressionNode, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer func() { p.depth-- }()
+
+	left, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.peekOperator("+-")
+		if !ok {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode(op, left, right)
+	}
+}
+
+func (p *expressionParser) parseProduct() (expressionNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.peekOperator("*/%")
+		if !ok {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode(op, left, right)
+	}
+}
+
+func (p *expressionParser) parseUnary() (expressionNode, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer func() { p.depth-- }()
+
+	if op, ok := p.peekOperator("+-"); ok {
+		p.pos++
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if op == "+" {
+			return operand, nil
+		}
+		return func(vars map[string]float64) (float64, error) {
+			v, err := operand(vars)
+			return -v, err
+		}, nil
+	}
+	return p.parsePower()
+}
+
+func (p *expressionParser) parsePower() (expressionNode, error) {
+	base, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := p.peekOperator("^"); !ok {
+		return base, nil
+	}
+	p.pos++
+	// the exponent binds to the right, i.e. 2^3^2 is 2^(3^2)
+	exponent, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	return binaryNode("^", base, exponent), nil
+}
+
+func (p *expressionParser) parsePrimary() (expressionNode, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, fmt.Errorf("unexpected end of the expression")
+	}
+	token := p.tokens[p.pos]
+	p.pos++
+	switch token.kind {
+	case tokenNumber:
+		v := token.number
+		return func(map[string]float64) (float64, error) { return v, nil }, nil
+	case tokenIdent:
+		if _, ok := p.peekOperator("("); ok {
+			return p.parseCall(token)
+		}
+		name := token.text
+		p.variables[name] = true
+		return func(vars map[string]float64) (float64, error) {
+			v, ok := vars[name]
+			if !ok {
+				return 0, fmt.Errorf("unknown variable '%s'", name)
+			}
+			return v, nil
+		}, nil
+	default:
+		if token.text != "(" {
+			return nil, fmt.Errorf("unexpected '%s' at position %d", token.text, token.pos)
+		}
+		node, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectOperator(")"); err != nil {
+			return nil, err
+		}
+		return node, nil
+	}
+}
+
+func (p *expressionParser) parseCall(name expressionToken) (expressionNode, error) {
+	function, ok := expressionFunctions[name.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown function '%s' at position %d", name.text, name.pos)
+	}
+	p.pos++ // "("
+	var args []expressionNode
+	for {
+		arg, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if _, ok := p.peekOperator(","); !ok {
+			break
+		}
+		p.pos++
+	}
+	if err := p.expectOperator(")"); err != nil {
+		return nil, err
+	}
+	if len(args) != function.arity {
+		return nil, fmt.Errorf("function '%s' expects %d argument(s) but got %d", name.text, function.arity, len(args))
+	}
+
+	return func(vars map[string]float64) (float64, error) {
+		values := make([]float64, len(args))
+		for i, arg := range args {
+			v, err := arg(vars)
+			if err != nil {
+				return 0, err
+			}
+			values[i] = v
+		}
+		return function.fn(values), nil
+	}, nil
+}
+
+func binaryNode(op string, left expressionNode, right expressionNode) expressionNode {
+	var apply func(l, r float64) float64
+	switch op {
+	case "+":
+		apply = func(l, r float64) float64 { return l + r }
+	case "-":
+		apply = func(l, r float64) float64 { return l - r }
+	case "*":
+		apply = func(l, r float64) float64 { return l * r }
+	case "/":
+		apply = func(l, r float64) float64 { return l / r }
+	case "%":
+		apply = math.Mod
+	case "^":
+		apply = math.Pow
+	}
+	return func(vars map[string]float64) (float64, error) {
+		l, err := left(vars)
+		if err != nil {
+			return 0, err
+		}
+		r, err := right(vars)
+		if err != nil {
+			return 0, err
+		}
+		return apply(l, r), nil
+	}
+}
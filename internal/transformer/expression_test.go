@@ -0,0 +1,189 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transformer
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	contracts "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+func Test_compileExpression(t *testing.T) {
+	vars := map[string]float64{"value": 3, "hi": 1, "lo": 2}
+	tests := []struct {
+		name        string
+		source      string
+		expected    float64
+		expectedErr bool
+	}{
+		{"valid - number", "1.5e2", 150, false},
+		{"valid - precedence", "1 + value * 2 - 4 / 2", 5, false},
+		{"valid - parentheses", "(1 + value) * 2", 8, false},
+		{"valid - unary minus", "-value + -(-2)", -1, false},
+		{"valid - power is right associative", "2 ^ 3 ^ 2", 512, false},
+		{"valid - unary minus binds looser than power", "-value ^ 2", -9, false},
+		{"valid - modulo", "value % 2", 1, false},
+		{"valid - functions", "max(abs(-value), sqrt(16)) + round(0.6)", 5, false},
+		{"valid - other DeviceResources", "hi * 65536 + lo", 65538, false},
+		{"invalid - empty", " ", 0, true},
+		{"invalid - unknown function", "exec(value)", 0, true},
+		{"invalid - wrong arity", "pow(value)", 0, true},
+		{"invalid - unbalanced parentheses", "(value + 1", 0, true},
+		{"invalid - trailing token", "value 1", 0, true},
+		{"invalid - unexpected character", "value; 1", 0, true},
+		{"invalid - invalid number", "1.2.3", 0, true},
+		{"invalid - too long", strings.Repeat("1+", maxExpressionLength) + "1", 0, true},
+		{"invalid - too deep", strings.Repeat("(", maxExpressionDepth+1) + "1" + strings.Repeat(")", maxExpressionDepth+1), 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := compileExpression(tt.source)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			res, err := e.evaluate(vars)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expected, res, 1e-9)
+		})
+	}
+
+	e, err := compileExpression("value + unknown")
+	require.NoError(t, err)
+	_, err = e.evaluate(vars)
+	assert.Error(t, err, "expect an error of an unknown variable")
+}
+
+func TestTransformReadExpression(t *testing.T) {
+	tests := []struct {
+		name         string
+		valueType    string
+		value        any
+		expression   string
+		expected     any
+		expectedKind errors.ErrKind
+	}{
+		{"valid - float64 polynomial", common.ValueTypeFloat64, float64(2), "value * value * 0.5 + 1", float64(3), ""},
+		{"valid - float32", common.ValueTypeFloat32, float32(1.5), "value * 2", float32(3), ""},
+		{"valid - int16 rounded", common.ValueTypeInt16, int16(25), "value / 10", int16(3), ""},
+		{"valid - combine registers", common.ValueTypeUint32, uint32(1), "hi * 65536 + value", uint32(65537), ""},
+		{"valid - non-numeric value is not transformed", common.ValueTypeString, "on", "value * 2", "on", ""},
+		{"invalid - uint8 overflow", common.ValueTypeUint8, uint8(200), "value * 2", nil, errors.KindOverflowError},
+		{"invalid - uint16 negative", common.ValueTypeUint16, uint16(1), "value - 2", nil, errors.KindOverflowError},
+		{"invalid - int64 not supported", common.ValueTypeInt64, int64(math.MaxInt64), "value - 1", nil, errors.KindContractInvalid},
+		{"invalid - uint64 not supported", common.ValueTypeUint64, uint64(1), "value + 1", nil, errors.KindContractInvalid},
+		{"invalid - int32 overflow", common.ValueTypeInt32, int32(math.MaxInt32), "value * 4", nil, errors.KindOverflowError},
+		{"invalid - float32 overflow", common.ValueTypeFloat32, float32(math.MaxFloat32), "value * 2", nil, errors.KindOverflowError},
+		{"invalid - division by zero", common.ValueTypeFloat64, float64(1), "value / 0", nil, errors.KindOverflowError},
+		{"invalid - NaN result", common.ValueTypeFloat64, float64(-1), "sqrt(value)", nil, errors.KindNaNError},
+		{"invalid - zero divided by zero", common.ValueTypeInt32, int32(0), "value / value", nil, errors.KindNaNError},
+		{"invalid - NaN value", common.ValueTypeFloat64, math.NaN(), "value + 1", nil, errors.KindNaNError},
+		{"invalid - unknown variable", common.ValueTypeFloat64, float64(1), "value + missing", nil, errors.KindContractInvalid},
+		{"invalid - syntax error", common.ValueTypeFloat64, float64(1), "value +", nil, errors.KindContractInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cv, err := models.NewCommandValue("resource", tt.valueType, tt.value)
+			require.NoError(t, err)
+			dr := contracts.DeviceResource{Name: tt.name, Attributes: map[string]any{sdkCommon.ReadExpressionAttribute: tt.expression}}
+
+			edgexErr := TransformReadExpression(cv, "profile", dr, map[string]float64{"hi": 1})
+			if tt.expectedKind != "" {
+				require.Error(t, edgexErr)
+				assert.Equal(t, tt.expectedKind, errors.Kind(edgexErr))
+				return
+			}
+			require.NoError(t, edgexErr)
+			assert.Equal(t, tt.expected, cv.Value)
+		})
+	}
+}
+
+func TestTransformWriteExpression(t *testing.T) {
+	tests := []struct {
+		name         string
+		value        any
+		attributes   map[string]any
+		expected     any
+		expectedKind errors.ErrKind
+	}{
+		{"valid - no expression", int16(5), nil, int16(5), ""},
+		{"valid - inverse expression", int16(23), map[string]any{
+			sdkCommon.ReadExpressionAttribute:  "value * 0.1",
+			sdkCommon.WriteExpressionAttribute: "value / 0.1",
+		}, int16(230), ""},
+		{"invalid - read expression without write expression", int16(23), map[string]any{
+			sdkCommon.ReadExpressionAttribute: "value * 0.1",
+		}, nil, errors.KindContractInvalid},
+		{"invalid - overflow", int16(23), map[string]any{
+			sdkCommon.WriteExpressionAttribute: "value * 10000",
+		}, nil, errors.KindOverflowError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cv, err := models.NewCommandValue("resource", common.ValueTypeInt16, tt.value)
+			require.NoError(t, err)
+			dr := contracts.DeviceResource{Name: tt.name, Attributes: tt.attributes}
+
+			edgexErr := TransformWriteExpression(cv, "profile", dr)
+			if tt.expectedKind != "" {
+				require.Error(t, edgexErr)
+				assert.Equal(t, tt.expectedKind, errors.Kind(edgexErr))
+				return
+			}
+			require.NoError(t, edgexErr)
+			assert.Equal(t, tt.expected, cv.Value)
+		})
+	}
+}
+
+func Test_compiledExpression(t *testing.T) {
+	first, err := compiledExpression("cacheProfile", "resource", sdkCommon.ReadExpressionAttribute, "value * 2")
+	require.NoError(t, err)
+	second, err := compiledExpression("cacheProfile", "resource", sdkCommon.ReadExpressionAttribute, "value * 2")
+	require.NoError(t, err)
+	assert.Same(t, first, second, "expect the expression to be compiled once")
+
+	other, err := compiledExpression("otherProfile", "resource", sdkCommon.ReadExpressionAttribute, "value * 2")
+	require.NoError(t, err)
+	assert.NotSame(t, first, other, "expect the expressions to be cached per profile")
+
+	updated, err := compiledExpression("cacheProfile", "resource", sdkCommon.ReadExpressionAttribute, "value * 3")
+	require.NoError(t, err)
+	assert.NotSame(t, first, updated, "expect the updated expression to be compiled again")
+	res, evalErr := updated.evaluate(map[string]float64{ExpressionValue: 1})
+	require.NoError(t, evalErr)
+	assert.Equal(t, float64(3), res)
+
+	RemoveProfileExpressions("cacheProfile")
+	recompiled, err := compiledExpression("cacheProfile", "resource", sdkCommon.ReadExpressionAttribute, "value * 3")
+	require.NoError(t, err)
+	assert.NotSame(t, updated, recompiled, "expect the expressions of the removed profile to be compiled again")
+	cached, err := compiledExpression("otherProfile", "resource", sdkCommon.ReadExpressionAttribute, "value * 2")
+	require.NoError(t, err)
+	assert.Same(t, other, cached, "expect the expressions of the other profiles to be kept")
+}
+
+func TestExpressionVariables(t *testing.T) {
+	hi, err := models.NewCommandValue("hi", common.ValueTypeUint16, uint16(1))
+	require.NoError(t, err)
+	name, err := models.NewCommandValue("name", common.ValueTypeString, "sensor")
+	require.NoError(t, err)
+
+	vars := ExpressionVariables([]*models.CommandValue{hi, nil, name})
+	assert.Equal(t, map[string]float64{"hi": 1}, vars)
+}
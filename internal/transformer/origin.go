@@ -0,0 +1,146 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transformer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	contracts "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/spf13/cast"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+const (
+	// clockOffsetWeight is the weight of a new sample in the moving average of the offset of a device clock
+	clockOffsetWeight = 0.1
+	// clockOffsetStateKey is the key of the transform state holding the estimated offset in nanoseconds of the
+	// device clock to the SDK clock
+	clockOffsetStateKey = "clockoffset"
+)
+
+var (
+	previousOrigin int64
+	originMutex    sync.Mutex
+)
+
+type autoEventTickKey struct{}
+
+// WithAutoEventTick returns a copy of the context carrying the scheduled time of the AutoEvent reading the device
+func WithAutoEventTick(ctx context.Context, tick time.Time) context.Context {
+	return context.WithValue(ctx, autoEventTickKey{}, tick)
+}
+
+// AutoEventTick returns the scheduled time of the AutoEvent carried by the context, if any
+func AutoEventTick(ctx context.Context) (time.Time, bool) {
+	tick, ok := ctx.Value(autoEventTickKey{}).(time.Time)
+	return tick, ok && !tick.IsZero()
+}
+
+// resolveOriginPolicy returns the origin policy of the device, from the ds-originpolicy property of the device which
+// takes precedence over the configured policy
+func resolveOriginPolicy(device contracts.Device, configured string) (string, errors.EdgeX) {
+	policy := configured
+	if v, ok := device.Properties[sdkCommon.OriginPolicyProperty]; ok {
+		policy = cast.ToString(v)
+	}
+	switch policy = strings.ToLower(strings.TrimSpace(policy)); policy {
+	case "":
+		return sdkCommon.OriginPolicyDriver, nil
+	case sdkCommon.OriginPolicyDriver, sdkCommon.OriginPolicySDK, sdkCommon.OriginPolicyAligned, sdkCommon.OriginPolicyDevice:
+		return policy, nil
+	default:
+		errMsg := fmt.Sprintf("invalid origin policy '%s' of device %s, expected %s, %s, %s or %s", policy, device.Name,
+			sdkCommon.OriginPolicyDriver, sdkCommon.OriginPolicySDK, sdkCommon.OriginPolicyAligned, sdkCommon.OriginPolicyDevice)
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+	}
+}
+
+// applyOriginPolicy returns the Origin of the Event and sets the Origin of the CommandValues per the policy. The
+// CommandValues without Origin take the one of the Event. It also returns the Event tags reporting the policy.
+// The estimated offset of the device clock is kept in the transform states of the device.
+func applyOriginPolicy(ctx context.Context, policy string, deviceName string, cvs []*models.CommandValue,
+	states *container.TransformStates) (int64, map[string]any) {
+	switch policy {
+	case sdkCommon.OriginPolicySDK:
+		origin := getUniqueOrigin()
+		setOrigins(cvs, origin)
+		return origin, map[string]any{sdkCommon.OriginPolicyTag: policy}
+	case sdkCommon.OriginPolicyAligned:
+		tick, ok := AutoEventTick(ctx)
+		if !ok {
+			return applyOriginPolicy(ctx, sdkCommon.OriginPolicySDK, deviceName, cvs, states)
+		}
+		setOrigins(cvs, tick.UnixNano())
+		return tick.UnixNano(), map[string]any{sdkCommon.OriginPolicyTag: policy}
+	case sdkCommon.OriginPolicyDevice:
+		origin := getUniqueOrigin()
+		tags := map[string]any{sdkCommon.OriginPolicyTag: policy}
+		var latest int64
+		for _, cv := range cvs {
+			if cv != nil && cv.Origin > latest {
+				latest = cv.Origin
+			}
+		}
+		if latest == 0 {
+			return origin, tags
+		}
+		offset := estimateClockOffset(deviceName, origin-latest, states)
+		for _, cv := range cvs {
+			if cv != nil && cv.Origin != 0 {
+				cv.Origin += offset
+			}
+		}
+		tags[sdkCommon.ClockOffsetTag] = offset
+		return origin, tags
+	default:
+		return getUniqueOrigin(), nil
+	}
+}
+
+// estimateClockOffset updates the moving average of the offset of the device clock with the sample, i.e. the
+// difference between the SDK clock and the device clock at the time of a read, and returns it
+func estimateClockOffset(deviceName string, sample int64, states *container.TransformStates) int64 {
+	var offset float64
+	states.Update(deviceName, clockOffsetStateKey, func(state any) any {
+		previous, ok := state.(float64)
+		if !ok {
+			offset = float64(sample)
+		} else {
+			offset = previous + (float64(sample)-previous)*clockOffsetWeight
+		}
+		return offset
+	})
+	return int64(offset)
+}
+
+func setOrigins(cvs []*models.CommandValue, origin int64) {
+	for _, cv := range cvs {
+		if cv != nil {
+			cv.Origin = origin
+		}
+	}
+}
+
+// getUniqueOrigin returns the current time of the SDK clock in nanoseconds, which is unique across the Events
+func getUniqueOrigin() int64 {
+	originMutex.Lock()
+	defer originMutex.Unlock()
+	now := time.Now().UnixNano()
+	if now <= previousOrigin {
+		now = previousOrigin + 1
+	}
+	previousOrigin = now
+	return now
+}
@@ -0,0 +1,158 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transformer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/cache"
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+func TestResolveOriginPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		properties  map[string]any
+		configured  string
+		expected    string
+		expectedErr bool
+	}{
+		{"default", nil, "", sdkCommon.OriginPolicyDriver, false},
+		{"configured", nil, "SDK", sdkCommon.OriginPolicySDK, false},
+		{"device override", map[string]any{sdkCommon.OriginPolicyProperty: "aligned"}, sdkCommon.OriginPolicySDK, sdkCommon.OriginPolicyAligned, false},
+		{"invalid configured", nil, "gps", "", true},
+		{"invalid device override", map[string]any{sdkCommon.OriginPolicyProperty: "gps"}, sdkCommon.OriginPolicySDK, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := resolveOriginPolicy(models.Device{Name: "device", Properties: tt.properties}, tt.configured)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, policy)
+		})
+	}
+}
+
+func originValues(origins ...int64) []*sdkModels.CommandValue {
+	cvs := make([]*sdkModels.CommandValue, 0, len(origins))
+	for _, origin := range origins {
+		cvs = append(cvs, &sdkModels.CommandValue{DeviceResourceName: "resource", Type: common.ValueTypeInt32, Value: int32(1), Origin: origin})
+	}
+	return cvs
+}
+
+func TestApplyOriginPolicy(t *testing.T) {
+	tick := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tickCtx := WithAutoEventTick(context.Background(), tick)
+	states := container.NewTransformStates()
+
+	t.Run("driver", func(t *testing.T) {
+		cvs := originValues(0, 42)
+		origin, tags := applyOriginPolicy(tickCtx, sdkCommon.OriginPolicyDriver, "device", cvs, states)
+		assert.NotZero(t, origin)
+		assert.Nil(t, tags)
+		assert.Equal(t, int64(0), cvs[0].Origin)
+		assert.Equal(t, int64(42), cvs[1].Origin)
+	})
+	t.Run("sdk", func(t *testing.T) {
+		cvs := originValues(0, 42)
+		origin, tags := applyOriginPolicy(tickCtx, sdkCommon.OriginPolicySDK, "device", cvs, states)
+		assert.Equal(t, map[string]any{sdkCommon.OriginPolicyTag: sdkCommon.OriginPolicySDK}, tags)
+		assert.Equal(t, origin, cvs[0].Origin)
+		assert.Equal(t, origin, cvs[1].Origin)
+	})
+	t.Run("aligned", func(t *testing.T) {
+		cvs := originValues(0, 42)
+		origin, tags := applyOriginPolicy(tickCtx, sdkCommon.OriginPolicyAligned, "device", cvs, states)
+		assert.Equal(t, map[string]any{sdkCommon.OriginPolicyTag: sdkCommon.OriginPolicyAligned}, tags)
+		assert.Equal(t, tick.UnixNano(), origin)
+		assert.Equal(t, tick.UnixNano(), cvs[0].Origin)
+		assert.Equal(t, tick.UnixNano(), cvs[1].Origin)
+	})
+	t.Run("aligned without AutoEvent", func(t *testing.T) {
+		cvs := originValues(42)
+		origin, tags := applyOriginPolicy(context.Background(), sdkCommon.OriginPolicyAligned, "device", cvs, states)
+		assert.Equal(t, map[string]any{sdkCommon.OriginPolicyTag: sdkCommon.OriginPolicySDK}, tags)
+		assert.Equal(t, origin, cvs[0].Origin)
+	})
+	t.Run("device", func(t *testing.T) {
+		deviceName := "clockDevice"
+
+		// the device clock is an hour late
+		late := time.Hour.Nanoseconds()
+		deviceTime := time.Now().UnixNano() - late
+		cvs := originValues(0, deviceTime)
+		origin, tags := applyOriginPolicy(context.Background(), sdkCommon.OriginPolicyDevice, deviceName, cvs, states)
+		assert.Equal(t, sdkCommon.OriginPolicyDevice, tags[sdkCommon.OriginPolicyTag])
+		offset, ok := tags[sdkCommon.ClockOffsetTag].(int64)
+		require.True(t, ok)
+		assert.InDelta(t, late, offset, float64(time.Second))
+		assert.Equal(t, int64(0), cvs[0].Origin, "expect the Origin not set by the driver to fall back to the Event")
+		assert.Equal(t, deviceTime+offset, cvs[1].Origin)
+		assert.LessOrEqual(t, cvs[1].Origin, origin)
+
+		// a single outlier only moves the estimated offset by its weight
+		cvs = originValues(time.Now().UnixNano() - late - 10*time.Second.Nanoseconds())
+		_, tags = applyOriginPolicy(context.Background(), sdkCommon.OriginPolicyDevice, deviceName, cvs, states)
+		assert.InDelta(t, late+time.Second.Nanoseconds(), tags[sdkCommon.ClockOffsetTag], float64(time.Second)/2)
+
+		// the offset is estimated again once the states of the device are removed
+		states.RemoveDevice(deviceName)
+		cvs = originValues(time.Now().UnixNano() - late - 10*time.Second.Nanoseconds())
+		_, tags = applyOriginPolicy(context.Background(), sdkCommon.OriginPolicyDevice, deviceName, cvs, states)
+		assert.InDelta(t, late+10*time.Second.Nanoseconds(), tags[sdkCommon.ClockOffsetTag], float64(time.Second))
+	})
+	t.Run("device without device clock", func(t *testing.T) {
+		cvs := originValues(0)
+		_, tags := applyOriginPolicy(context.Background(), sdkCommon.OriginPolicyDevice, "noClockDevice", cvs, states)
+		assert.Equal(t, map[string]any{sdkCommon.OriginPolicyTag: sdkCommon.OriginPolicyDevice}, tags)
+	})
+}
+
+func TestCommandValuesToEventDTO_OriginPolicy(t *testing.T) {
+	dic := NewMockDIC()
+	err := cache.InitCache(TestDeviceService, TestDeviceService, dic)
+	require.NoError(t, err)
+
+	profile := models.DeviceProfile{
+		Name: "originProfile",
+		DeviceResources: []models.DeviceResource{
+			{Name: "temperature", Properties: models.ResourceProperties{ValueType: common.ValueTypeInt32, ReadWrite: common.ReadWrite_R}},
+		},
+	}
+	require.NoError(t, cache.Profiles().Add(profile))
+	require.NoError(t, cache.Devices().Add(models.Device{Name: "alignedDevice", ProfileName: profile.Name, ServiceName: TestDeviceService,
+		Properties: map[string]any{sdkCommon.OriginPolicyProperty: sdkCommon.OriginPolicyAligned}}))
+	require.NoError(t, cache.Devices().Add(models.Device{Name: "invalidPolicyDevice", ProfileName: profile.Name, ServiceName: TestDeviceService,
+		Properties: map[string]any{sdkCommon.OriginPolicyProperty: "gps"}}))
+
+	tick := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cvs := originValues(42)
+	cvs[0].DeviceResourceName = "temperature"
+	event, edgexErr := CommandValuesToEventDTO(WithAutoEventTick(context.Background(), tick), cvs, "alignedDevice", "temperature", true, dic)
+	require.NoError(t, edgexErr)
+	require.NotNil(t, event)
+	assert.Equal(t, tick.UnixNano(), event.Origin)
+	require.Len(t, event.Readings, 1)
+	assert.Equal(t, tick.UnixNano(), event.Readings[0].Origin)
+	assert.Equal(t, sdkCommon.OriginPolicyAligned, event.Tags[sdkCommon.OriginPolicyTag])
+
+	_, edgexErr = CommandValuesToEventDTO(context.Background(), originValues(42), "invalidPolicyDevice", "temperature", true, dic)
+	assert.Error(t, edgexErr)
+}
@@ -1,14 +1,14 @@
 //
-// Copyright (C) 2021-2023 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
 package transformer
 
 import (
+	"context"
 	"fmt"
-	"sync"
-	"time"
+	"slices"
 
 	"github.com/edgexfoundry/device-sdk-go/v4/internal/cache"
 	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
@@ -20,14 +20,39 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	contracts "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
 
-var (
-	previousOrigin int64
-	originMutex    sync.Mutex
-)
+type transformStatesKey struct{}
+
+// WithTransformStates returns a copy of the context carrying the transform states used by CommandValuesToEventDTO
+// instead of the ones of the DIC, e.g. the Batch view of them for the Events of a single read
+func WithTransformStates(ctx context.Context, states *container.TransformStates) context.Context {
+	return context.WithValue(ctx, transformStatesKey{}, states)
+}
+
+type writtenValuesKey struct{}
+
+// WithWrittenValues returns a copy of the context marking the CommandValues converted by CommandValuesToEventDTO as
+// the values written by a SET command. They don't read nor update the transform states of the device, e.g. the
+// previous read values of the rate-of-change and stuck-value rules, since they aren't read from the device.
+func WithWrittenValues(ctx context.Context) context.Context {
+	return context.WithValue(ctx, writtenValuesKey{}, true)
+}
 
-func CommandValuesToEventDTO(cvs []*models.CommandValue, deviceName string, sourceName string, dataTransform bool, dic *di.Container) (*dtos.Event, errors.EdgeX) {
+// transformStatesFrom returns the transform states carried by the context, or else the ones of the DIC, and nil for
+// the written values
+func transformStatesFrom(ctx context.Context, dic *di.Container) *container.TransformStates {
+	if written, ok := ctx.Value(writtenValuesKey{}).(bool); ok && written {
+		return nil
+	}
+	if states, ok := ctx.Value(transformStatesKey{}).(*container.TransformStates); ok {
+		return states
+	}
+	return container.TransformStatesFrom(dic.Get)
+}
+
+func CommandValuesToEventDTO(ctx context.Context, cvs []*models.CommandValue, deviceName string, sourceName string, dataTransform bool, dic *di.Container) (*dtos.Event, errors.EdgeX) {
 	// in some case device service driver implementation would generate no readings
 	// in this case no event would be created. Based on the implementation there would be 2 scenarios:
 	// 1. uninitialized *CommandValue slices, i.e. nil
@@ -43,51 +68,30 @@ func CommandValuesToEventDTO(cvs []*models.CommandValue, deviceName string, sour
 	}
 
 	var transformsOK = true
-	origin := getUniqueOrigin()
-	tags := make(map[string]interface{})
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get)
+	policy, err := resolveOriginPolicy(device, config.Writable.Reading.OriginPolicy)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	transformStates := transformStatesFrom(ctx, dic)
+	origin, originTags := applyOriginPolicy(ctx, policy, device.Name, cvs, transformStates)
+	tags := make(map[string]interface{})
 	readings := make([]dtos.BaseReading, 0, len(cvs))
-	for _, cv := range cvs {
-		if cv == nil {
-			continue
+	appendReading := func(cv *models.CommandValue, dr contracts.DeviceResource) errors.EdgeX {
+		// validation rules
+		failures, err := checkValidationRules(device.Name, dr, cv, transformStates)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
 		}
-		// double-check the CommandValue return from ProtocolDriver match device command
-		dr, ok := cache.Profiles().DeviceResource(device.ProfileName, cv.DeviceResourceName)
-		if !ok {
-			msg := fmt.Sprintf("failed to find DeviceResource %s in Device %s for CommandValue (%s)", cv.DeviceResourceName, deviceName, cv.String())
-			lc.Error(msg)
-			return nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, msg, nil)
-		}
-
-		// perform data transformation
-		if dataTransform && cv.Value != nil {
-			edgexErr := TransformReadResult(cv, dr.Properties)
-			if edgexErr != nil {
-				lc.Errorf("failed to transform CommandValue (%s): %v", cv.String(), edgexErr)
-
-				var err error
-				if errors.Kind(edgexErr) == errors.KindOverflowError {
-					cv, err = models.NewCommandValue(cv.DeviceResourceName, common.ValueTypeString, Overflow)
-					if err != nil {
-						return nil, errors.NewCommonEdgeXWrapper(err)
-					}
-				} else if errors.Kind(edgexErr) == errors.KindNaNError {
-					cv, err = models.NewCommandValue(cv.DeviceResourceName, common.ValueTypeString, NaN)
-					if err != nil {
-						return nil, errors.NewCommonEdgeXWrapper(err)
-					}
-				} else {
-					transformsOK = false
-				}
-			}
+		if applyValidationActions(cv, device.Name, failures, lc, dc, dic) {
+			lc.Debugf("device: %s DeviceResource: %v reading dropped by the validation rules", device.Name, cv.DeviceResourceName)
+			return nil
 		}
 
 		// assertion
-		dc := bootstrapContainer.DeviceClientFrom(dic.Get)
-		err := checkAssertion(cv, dr.Properties.Assertion, device.Name, lc, dc)
-		if err != nil {
-			return nil, errors.NewCommonEdgeXWrapper(err)
-		}
+		checkAssertion(cv, dr.Properties.Assertion, device.Name, lc, dc)
 
 		for key, value := range cv.Tags {
 			tags[key] = value
@@ -101,20 +105,41 @@ func CommandValuesToEventDTO(cvs []*models.CommandValue, deviceName string, sour
 		} else if len(ro.Mappings) > 0 {
 			newCV, ok := mapCommandValue(cv, ro.Mappings)
 			if ok {
+				newCV.Quality = cv.Quality
 				cv = newCV
 			}
 		}
 
+		// unit conversion of a copy, since the virtual DeviceResources are computed from the values in their Units
+		units := dr.Properties.Units
+		if dataTransform && cv.Value != nil {
+			converted := *cv
+			if units, err = TransformReadUnit(ctx, &converted, device, dr); err != nil {
+				lc.Errorf("failed to convert the unit of CommandValue (%s): %v", cv.String(), err)
+				if !setTransformFailureQuality(&converted, err) {
+					return errors.NewCommonEdgeXWrapper(err)
+				}
+			}
+			cv = &converted
+		}
+
 		reading, err := commandValueToReading(cv, device.Name, device.ProfileName, dr.Properties.MediaType, origin)
 		if err != nil {
-			return nil, errors.NewCommonEdgeXWrapper(err)
+			return errors.NewCommonEdgeXWrapper(err)
 		}
 		// ReadingUnits=true to include units in the reading
-		config := container.ConfigurationFrom(dic.Get)
 		if config.Writable.Reading.ReadingUnits {
-			reading.Units = dr.Properties.Units
+			reading.Units = units
 		}
 		sdkCommon.AddReadingTags(&reading)
+		if qualityTags := cv.QualityTags(); len(qualityTags) > 0 {
+			if reading.Tags == nil {
+				reading.Tags = make(map[string]any, len(qualityTags))
+			}
+			for k, v := range qualityTags {
+				reading.Tags[k] = v
+			}
+		}
 		readings = append(readings, reading)
 
 		if cv.Type == common.ValueTypeBinary {
@@ -122,6 +147,85 @@ func CommandValuesToEventDTO(cvs []*models.CommandValue, deviceName string, sour
 		} else {
 			lc.Debugf("device: %s DeviceResource: %v reading: %+v", device.Name, cv.DeviceResourceName, reading)
 		}
+		return nil
+	}
+
+	// the virtual DeviceResources are computed from the transformed values of their sources, which are
+	// only reported if they are part of the source as well
+	virtuals, hidden := virtualResources(device.ProfileName, sourceName)
+	// the bit fields are decoded from the raw values of their words, which are hidden the same way
+	if fields := bitFieldResources(device.ProfileName, sourceName, virtuals, hidden); len(fields) > 0 {
+		decoded, err := decodeBitFields(fields, cvs)
+		if err != nil {
+			lc.Errorf("failed to decode the bit fields of device %s: %v", device.Name, err)
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		cvs = append(slices.Clip(cvs), decoded...)
+	}
+	// the read expressions refer to the values of the other DeviceResources before any transform
+	var expressionVariables map[string]float64
+	if dataTransform {
+		expressionVariables = ExpressionVariables(cvs)
+	}
+	transformed := make([]*models.CommandValue, 0, len(cvs))
+	for _, cv := range cvs {
+		if cv == nil {
+			continue
+		}
+		// double-check the CommandValue return from ProtocolDriver match device command
+		dr, ok := cache.Profiles().DeviceResource(device.ProfileName, cv.DeviceResourceName)
+		if !ok {
+			msg := fmt.Sprintf("failed to find DeviceResource %s in Device %s for CommandValue (%s)", cv.DeviceResourceName, deviceName, cv.String())
+			lc.Error(msg)
+			return nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, msg, nil)
+		}
+
+		// perform data transformation
+		if dataTransform && cv.Value != nil {
+			calibration, edgexErr := ResourceCalibration(device, dr)
+			if edgexErr == nil {
+				edgexErr = TransformReadResult(cv, dr.Properties, calibration)
+			}
+			if edgexErr == nil {
+				edgexErr = TransformReadExpression(cv, device.ProfileName, dr, expressionVariables)
+			}
+			if edgexErr != nil {
+				lc.Errorf("failed to transform CommandValue (%s): %v", cv.String(), edgexErr)
+				if !setTransformFailureQuality(cv, edgexErr) {
+					transformsOK = false
+					continue
+				}
+			}
+		}
+		transformed = append(transformed, cv)
+
+		if hidden[cv.DeviceResourceName] {
+			continue
+		}
+		if err := appendReading(cv, dr); err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
+	if len(virtuals) > 0 {
+		values := ExpressionVariables(transformed)
+		for _, dr := range virtuals {
+			cv, edgexErr := computeVirtualValue(device.Name, device.ProfileName, dr, values, transformStates)
+			if edgexErr != nil {
+				lc.Errorf("failed to compute virtual DeviceResource %s of device %s: %v", dr.Name, device.Name, edgexErr)
+				cv = &models.CommandValue{DeviceResourceName: dr.Name, Type: dr.Properties.ValueType, Tags: make(map[string]string)}
+				if !setTransformFailureQuality(cv, edgexErr) {
+					transformsOK = false
+					continue
+				}
+			} else if cv == nil {
+				lc.Debugf("virtual DeviceResource %s of device %s is not computed without the values of its sources", dr.Name, device.Name)
+				continue
+			}
+			if err := appendReading(cv, dr); err != nil {
+				return nil, errors.NewCommonEdgeXWrapper(err)
+			}
+		}
 	}
 
 	if !transformsOK {
@@ -134,6 +238,9 @@ func CommandValuesToEventDTO(cvs []*models.CommandValue, deviceName string, sour
 		eventDTO.Origin = origin
 		eventDTO.Tags = tags
 		sdkCommon.AddEventTags(&eventDTO)
+		for k, v := range originTags {
+			eventDTO.Tags[k] = v
+		}
 
 		return &eventDTO, nil
 	} else {
@@ -141,6 +248,20 @@ func CommandValuesToEventDTO(cvs []*models.CommandValue, deviceName string, sour
 	}
 }
 
+// setTransformFailureQuality marks the CommandValue bad for the overflow and NaN errors, and returns false for
+// the other errors
+func setTransformFailureQuality(cv *models.CommandValue, edgexErr errors.EdgeX) bool {
+	switch errors.Kind(edgexErr) {
+	case errors.KindOverflowError:
+		cv.SetQuality(models.QualityBad, models.QualityReasonOverflow, edgexErr.Error())
+	case errors.KindNaNError:
+		cv.SetQuality(models.QualityBad, models.QualityReasonNaN, edgexErr.Error())
+	default:
+		return false
+	}
+	return true
+}
+
 func commandValueToReading(cv *models.CommandValue, deviceName, profileName, mediaType string, eventOrigin int64) (dtos.BaseReading, errors.EdgeX) {
 	var err error
 	var reading dtos.BaseReading
@@ -174,14 +295,3 @@ func commandValueToReading(cv *models.CommandValue, deviceName, profileName, med
 
 	return reading, nil
 }
-
-func getUniqueOrigin() int64 {
-	originMutex.Lock()
-	defer originMutex.Unlock()
-	now := time.Now().UnixNano()
-	if now <= previousOrigin {
-		now = previousOrigin + 1
-	}
-	previousOrigin = now
-	return now
-}
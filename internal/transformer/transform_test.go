@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021-2023 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -8,6 +8,7 @@ package transformer
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/rand"
 	"testing"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/edgexfoundry/device-sdk-go/v4/internal/cache"
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
 	"github.com/edgexfoundry/device-sdk-go/v4/internal/config"
 	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
 	"github.com/edgexfoundry/device-sdk-go/v4/pkg/interfaces/mocks"
@@ -137,6 +139,9 @@ func NewMockDIC() *di.Container {
 		bootstrapContainer.MetricsManagerInterfaceName: func(get di.Get) interface{} {
 			return mockMetricsManager
 		},
+		container.TransformStatesName: func(get di.Get) interface{} {
+			return container.NewTransformStates()
+		},
 	})
 }
 func Test_getUniqueOrigin(t *testing.T) {
@@ -177,7 +182,7 @@ func TestCommandValuesToEventDTO_ReadingUnits(t *testing.T) {
 					return configuration
 				},
 			})
-			event, err := CommandValuesToEventDTO(testCase.CommandValues, TestDevice, TestDeviceCommand, configuration.Device.DataTransform, dic)
+			event, err := CommandValuesToEventDTO(context.Background(), testCase.CommandValues, TestDevice, TestDeviceCommand, configuration.Device.DataTransform, dic)
 			require.NoError(t, err)
 
 			assert.Equal(t, TestDevice, event.DeviceName)
@@ -227,7 +232,7 @@ func TestCommandValuesToEventDTO_ReadingNilValue(t *testing.T) {
 		testCommandNilValue(t, common.ValueTypeObject),
 		testCommandNilValue(t, common.ValueTypeObjectArray),
 	}
-	event, err := CommandValuesToEventDTO(cvs, TestDevice, TestDeviceCommand, true, dic)
+	event, err := CommandValuesToEventDTO(context.Background(), cvs, TestDevice, TestDeviceCommand, true, dic)
 	require.NoError(t, err)
 
 	for _, r := range event.Readings {
@@ -245,3 +250,90 @@ func testCommandNilValue(t *testing.T, valueType string) *sdkModels.CommandValue
 	require.NoError(t, e)
 	return cv
 }
+
+func TestCommandValuesToEventDTO_Quality(t *testing.T) {
+	dic := NewMockDIC()
+	edgexErr := cache.InitCache(TestDeviceService, TestDeviceService, dic)
+	require.NoError(t, edgexErr)
+
+	scale := 100.0
+	profile := models.DeviceProfile{
+		Name: "qualityProfile",
+		DeviceResources: []models.DeviceResource{
+			{Name: "scaled", Properties: models.ResourceProperties{ValueType: common.ValueTypeInt8, ReadWrite: common.ReadWrite_R, Scale: &scale}},
+			{Name: "float", Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat64, ReadWrite: common.ReadWrite_R}},
+			{Name: "flagged", Properties: models.ResourceProperties{ValueType: common.ValueTypeUint16, ReadWrite: common.ReadWrite_R}},
+			{Name: "array", Properties: models.ResourceProperties{ValueType: common.ValueTypeInt8Array, ReadWrite: common.ReadWrite_R, Scale: &scale}},
+		},
+	}
+	require.NoError(t, cache.Profiles().Add(profile))
+	require.NoError(t, cache.Devices().Add(models.Device{Name: "qualityDevice", ProfileName: profile.Name, ServiceName: TestDeviceService}))
+
+	scaled, err := sdkModels.NewCommandValue("scaled", common.ValueTypeInt8, int8(2))
+	require.NoError(t, err)
+	nan, err := sdkModels.NewCommandValue("float", common.ValueTypeFloat64, math.NaN())
+	require.NoError(t, err)
+	// the quality set by the driver is reported as is
+	flagged, err := sdkModels.NewCommandValue("flagged", common.ValueTypeUint16, uint16(7))
+	require.NoError(t, err)
+	flagged.SetQuality(sdkModels.QualityUncertain, sdkModels.QualityReasonLastKnownValue, "")
+	// an overflowing element fails the whole array
+	array, err := sdkModels.NewCommandValue("array", common.ValueTypeInt8Array, []int8{0, 1, 2})
+	require.NoError(t, err)
+
+	event, edgexErr := CommandValuesToEventDTO(context.Background(), []*sdkModels.CommandValue{scaled, nan, flagged, array}, "qualityDevice", "qualitySource", true, dic)
+	require.NoError(t, edgexErr)
+	require.Len(t, event.Readings, 4)
+
+	expected := []struct {
+		valueType string
+		value     string
+		status    sdkModels.QualityStatus
+		reason    string
+	}{
+		{common.ValueTypeInt8, "", sdkModels.QualityBad, sdkModels.QualityReasonOverflow},
+		{common.ValueTypeFloat64, "", sdkModels.QualityBad, sdkModels.QualityReasonNaN},
+		{common.ValueTypeUint16, "7", sdkModels.QualityUncertain, sdkModels.QualityReasonLastKnownValue},
+		{common.ValueTypeInt8Array, "", sdkModels.QualityBad, sdkModels.QualityReasonOverflow},
+	}
+	for i, r := range event.Readings {
+		assert.Equal(t, expected[i].valueType, r.ValueType, "the declared value type is preserved")
+		assert.Equal(t, expected[i].value, r.Value)
+		assert.Equal(t, string(expected[i].status), r.Tags[sdkModels.QualityTag])
+		assert.Equal(t, expected[i].reason, r.Tags[sdkModels.QualityReasonTag])
+	}
+	assert.Contains(t, event.Readings[3].Tags[sdkModels.QualityMessageTag], "element 2", "expect the failed element to be reported")
+}
+
+func TestCommandValuesToEventDTO_WrittenValues(t *testing.T) {
+	dic := NewMockDIC()
+	edgexErr := cache.InitCache(TestDeviceService, TestDeviceService, dic)
+	require.NoError(t, edgexErr)
+
+	profile := models.DeviceProfile{
+		Name: "writtenProfile",
+		DeviceResources: []models.DeviceResource{
+			{Name: "setpoint", Attributes: map[string]any{sdkCommon.StuckCountAttribute: 3},
+				Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat64, ReadWrite: common.ReadWrite_RW}},
+		},
+	}
+	require.NoError(t, cache.Profiles().Add(profile))
+	require.NoError(t, cache.Devices().Add(models.Device{Name: "writtenDevice", ProfileName: profile.Name, ServiceName: TestDeviceService}))
+
+	stuck := func(ctx context.Context) bool {
+		cv, err := sdkModels.NewCommandValue("setpoint", common.ValueTypeFloat64, float64(5))
+		require.NoError(t, err)
+		event, edgexErr := CommandValuesToEventDTO(ctx, []*sdkModels.CommandValue{cv}, "writtenDevice", "setpoint", true, dic)
+		require.NoError(t, edgexErr)
+		require.Len(t, event.Readings, 1)
+		return event.Readings[0].Tags[sdkModels.QualityReasonTag] == sdkModels.QualityReasonStuckValue
+	}
+
+	// the written values are neither checked against nor counted in the previous read values of the stuck-value rule
+	assert.False(t, stuck(context.Background()))
+	assert.False(t, stuck(WithWrittenValues(context.Background())))
+	assert.False(t, stuck(WithWrittenValues(context.Background())))
+	assert.False(t, stuck(WithWrittenValues(context.Background())))
+	assert.False(t, stuck(context.Background()))
+	assert.True(t, stuck(context.Background()))
+}
@@ -1,6 +1,6 @@
 // -*- Mode: Go; indent-tabs-mode: t -*-
 //
-// Copyright (C) 2018-2023 IOTech Ltd
+// Copyright (C) 2018-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -14,10 +14,23 @@ import (
 	dsModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
 )
 
-func TransformWriteParameter(cv *dsModels.CommandValue, pv models.ResourceProperties) errors.EdgeX {
+// TransformWriteParameter transforms the written value in the inverse order of TransformReadResult, i.e. offset,
+// scale, base and calibration. The calibration is optional. The numeric arrays are validated and transformed
+// element-wise.
+func TransformWriteParameter(cv *dsModels.CommandValue, pv models.ResourceProperties, calibration *Calibration) errors.EdgeX {
 	if cv.Value == nil {
 		return nil
 	}
+	if isNumericArrayValueType(cv) {
+		transformed, err := transformArray(cv.Value, func(element any) (any, errors.EdgeX) {
+			return transformWriteValue(element, pv, calibration)
+		})
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to transform DeviceResource %s", cv.DeviceResourceName), err)
+		}
+		cv.Value = transformed
+		return nil
+	}
 	if !isNumericValueType(cv) {
 		return nil
 	}
@@ -26,43 +39,59 @@ func TransformWriteParameter(cv *dsModels.CommandValue, pv models.ResourceProper
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	newValue, err := transformWriteValue(value, pv, calibration)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	if value != newValue {
+		cv.Value = newValue
+	}
+	return nil
+}
+
+// transformWriteValue validates the numeric scalar value against the minimum and maximum, and transforms it
+func transformWriteValue(value any, pv models.ResourceProperties, calibration *Calibration) (any, errors.EdgeX) {
+	var err errors.EdgeX
 	newValue := value
 
 	if pv.Maximum != nil {
 		err = validateWriteMaximum(value, *pv.Maximum)
 		if err != nil {
-			return errors.NewCommonEdgeXWrapper(err)
+			return nil, errors.NewCommonEdgeXWrapper(err)
 		}
 	}
 	if pv.Minimum != nil {
 		err = validateWriteMinimum(value, *pv.Minimum)
 		if err != nil {
-			return errors.NewCommonEdgeXWrapper(err)
+			return nil, errors.NewCommonEdgeXWrapper(err)
 		}
 	}
 	if pv.Offset != nil && *pv.Offset != defaultOffset {
 		newValue, err = transformOffset(newValue, *pv.Offset, false)
 		if err != nil {
-			return errors.NewCommonEdgeXWrapper(err)
+			return nil, errors.NewCommonEdgeXWrapper(err)
 		}
 	}
 	if pv.Scale != nil && *pv.Scale != defaultScale {
 		newValue, err = transformScale(newValue, *pv.Scale, false)
 		if err != nil {
-			return errors.NewCommonEdgeXWrapper(err)
+			return nil, errors.NewCommonEdgeXWrapper(err)
 		}
 	}
 	if pv.Base != nil && *pv.Base != defaultBase {
 		newValue, err = transformBase(newValue, *pv.Base, false)
 		if err != nil {
-			return errors.NewCommonEdgeXWrapper(err)
+			return nil, errors.NewCommonEdgeXWrapper(err)
 		}
 	}
-
-	if value != newValue {
-		cv.Value = newValue
+	if calibration != nil {
+		newValue, err = transformCalibration(newValue, calibration, false)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
 	}
-	return nil
+	return newValue, nil
 }
 
 func validateWriteMaximum(value any, maximum float64) errors.EdgeX {
@@ -1,6 +1,6 @@
 // -*- Mode: Go; indent-tabs-mode: t -*-
 //
-// Copyright (C) 2019-2023 IOTech Ltd
+// Copyright (C) 2019-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -26,12 +26,28 @@ const (
 	defaultOffset float64 = 0.0
 	defaultMask   uint64  = 0
 	defaultShift  int64   = 0
-
-	Overflow = "overflow"
-	NaN      = "NaN"
 )
 
-func TransformReadResult(cv *sdkModels.CommandValue, pv models.ResourceProperties) errors.EdgeX {
+// TransformReadResult transforms the read value in the order mask, shift, calibration, base, scale and offset.
+// The calibration is optional. The numeric arrays are transformed element-wise, and an element which is NaN or
+// overflows fails the whole array.
+func TransformReadResult(cv *sdkModels.CommandValue, pv models.ResourceProperties, calibration *Calibration) errors.EdgeX {
+	if isNumericArrayValueType(cv) {
+		if cv.Value == nil {
+			return nil
+		}
+		transformed, err := transformArray(cv.Value, func(element any) (any, errors.EdgeX) {
+			if err := checkNaNElement(element); err != nil {
+				return nil, err
+			}
+			return transformReadValue(element, pv, calibration)
+		})
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to transform DeviceResource %s", cv.DeviceResourceName), err)
+		}
+		cv.Value = transformed
+		return nil
+	}
 	if !isNumericValueType(cv) {
 		return nil
 	}
@@ -47,45 +63,59 @@ func TransformReadResult(cv *sdkModels.CommandValue, pv models.ResourcePropertie
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	newValue, err := transformReadValue(value, pv, calibration)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	if value != newValue {
+		cv.Value = newValue
+	}
+	return nil
+}
+
+// transformReadValue transforms a numeric scalar value, the mask and shift only apply to the unsigned integers
+func transformReadValue(value any, pv models.ResourceProperties, calibration *Calibration) (any, errors.EdgeX) {
+	var err errors.EdgeX
 	newValue := value
 
-	if pv.Mask != nil && *pv.Mask != defaultMask &&
-		(cv.Type == common.ValueTypeUint8 || cv.Type == common.ValueTypeUint16 || cv.Type == common.ValueTypeUint32 || cv.Type == common.ValueTypeUint64) {
+	if pv.Mask != nil && *pv.Mask != defaultMask {
 		newValue, err = transformReadMask(newValue, *pv.Mask)
 		if err != nil {
-			return errors.NewCommonEdgeXWrapper(err)
+			return nil, errors.NewCommonEdgeXWrapper(err)
 		}
 	}
-	if pv.Shift != nil && *pv.Shift != defaultShift &&
-		(cv.Type == common.ValueTypeUint8 || cv.Type == common.ValueTypeUint16 || cv.Type == common.ValueTypeUint32 || cv.Type == common.ValueTypeUint64) {
+	if pv.Shift != nil && *pv.Shift != defaultShift {
 		newValue, err = transformReadShift(newValue, *pv.Shift)
 		if err != nil {
-			return errors.NewCommonEdgeXWrapper(err)
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if calibration != nil {
+		newValue, err = transformCalibration(newValue, calibration, true)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
 		}
 	}
 	if pv.Base != nil && *pv.Base != defaultBase {
 		newValue, err = transformBase(newValue, *pv.Base, true)
 		if err != nil {
-			return errors.NewCommonEdgeXWrapper(err)
+			return nil, errors.NewCommonEdgeXWrapper(err)
 		}
 	}
 	if pv.Scale != nil && *pv.Scale != defaultScale {
 		newValue, err = transformScale(newValue, *pv.Scale, true)
 		if err != nil {
-			return errors.NewCommonEdgeXWrapper(err)
+			return nil, errors.NewCommonEdgeXWrapper(err)
 		}
 	}
 	if pv.Offset != nil && *pv.Offset != defaultOffset {
 		newValue, err = transformOffset(newValue, *pv.Offset, true)
 		if err != nil {
-			return errors.NewCommonEdgeXWrapper(err)
+			return nil, errors.NewCommonEdgeXWrapper(err)
 		}
 	}
-
-	if value != newValue {
-		cv.Value = newValue
-	}
-	return nil
+	return newValue, nil
 }
 
 func transformBase(value any, base float64, read bool) (any, errors.EdgeX) {
@@ -465,13 +495,13 @@ func checkAssertion(
 	assertion string,
 	deviceName string,
 	lc logger.LoggingClient,
-	dc interfaces.DeviceClient) errors.EdgeX {
-	if assertion != "" && cv.ValueToString() != assertion {
+	dc interfaces.DeviceClient) {
+	if assertion != "" && cv.Value != nil && cv.ValueToString() != assertion {
 		go sdkCommon.UpdateOperatingState(deviceName, models.Down, lc, dc)
 		errMsg := fmt.Sprintf("Assertion failed for DeviceResource %s, with value %s", cv.DeviceResourceName, cv.ValueToString())
-		return errors.NewCommonEdgeX(errors.KindServerError, errMsg, nil)
+		lc.Error(errMsg)
+		cv.SetQuality(sdkModels.QualityBad, sdkModels.QualityReasonAssertionFailed, errMsg)
 	}
-	return nil
 }
 
 func mapCommandValue(value *sdkModels.CommandValue, mappings map[string]string) (*sdkModels.CommandValue, bool) {
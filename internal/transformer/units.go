@@ -0,0 +1,356 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transformer
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/spf13/cast"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+// Unit is a unit of measure of the built-in registry. A value in the unit is converted to the base unit of its
+// quantity as value*Factor + Offset.
+type Unit struct {
+	Quantity string
+	Factor   float64
+	Offset   float64
+}
+
+const (
+	quantityTemperature = "temperature"
+	quantityPressure    = "pressure"
+	quantityLength      = "length"
+	quantityMass        = "mass"
+	quantitySpeed       = "speed"
+	quantityVolume      = "volume"
+	quantityFlow        = "flow"
+	quantityEnergy      = "energy"
+	quantityPower       = "power"
+	quantityTime        = "time"
+	quantityFrequency   = "frequency"
+	quantityVoltage     = "voltage"
+	quantityCurrent     = "current"
+	quantityAngle       = "angle"
+	quantityRatio       = "ratio"
+)
+
+// unitRegistry maps the names and symbols of the units to the units. The base units are the SI ones.
+var unitRegistry = func() map[string]Unit {
+	registry := make(map[string]Unit)
+	add := func(u Unit, names ...string) {
+		for _, name := range names {
+			registry[name] = u
+		}
+	}
+
+	add(Unit{quantityTemperature, 1, 0}, "K", "kelvin")
+	add(Unit{quantityTemperature, 1, 273.15}, "degC", "°C", "Cel", "celsius")
+	add(Unit{quantityTemperature, 5.0 / 9, 459.67 * 5 / 9}, "degF", "°F", "[degF]", "fahrenheit")
+
+	add(Unit{quantityPressure, 1, 0}, "Pa")
+	add(Unit{quantityPressure, 1e2, 0}, "hPa")
+	add(Unit{quantityPressure, 1e3, 0}, "kPa")
+	add(Unit{quantityPressure, 1e6, 0}, "MPa")
+	add(Unit{quantityPressure, 1e5, 0}, "bar")
+	add(Unit{quantityPressure, 1e2, 0}, "mbar")
+	add(Unit{quantityPressure, 6894.757293168361, 0}, "psi", "[psi]")
+	add(Unit{quantityPressure, 101325, 0}, "atm")
+	add(Unit{quantityPressure, 133.322387415, 0}, "mmHg", "mm[Hg]")
+	add(Unit{quantityPressure, 3386.389, 0}, "inHg", "[in_i'Hg]")
+
+	add(Unit{quantityLength, 1, 0}, "m")
+	add(Unit{quantityLength, 1e-3, 0}, "mm")
+	add(Unit{quantityLength, 1e-2, 0}, "cm")
+	add(Unit{quantityLength, 1e3, 0}, "km")
+	add(Unit{quantityLength, 0.0254, 0}, "in", "[in_i]")
+	add(Unit{quantityLength, 0.3048, 0}, "ft", "[ft_i]")
+	add(Unit{quantityLength, 0.9144, 0}, "yd", "[yd_i]")
+	add(Unit{quantityLength, 1609.344, 0}, "mi", "[mi_i]")
+
+	add(Unit{quantityMass, 1, 0}, "kg")
+	add(Unit{quantityMass, 1e-3, 0}, "g")
+	add(Unit{quantityMass, 1e3, 0}, "t")
+	add(Unit{quantityMass, 0.45359237, 0}, "lb", "[lb_av]")
+	add(Unit{quantityMass, 0.028349523125, 0}, "oz", "[oz_av]")
+
+	add(Unit{quantitySpeed, 1, 0}, "m/s")
+	add(Unit{quantitySpeed, 1 / 3.6, 0}, "km/h")
+	add(Unit{quantitySpeed, 0.44704, 0}, "mph", "[mi_i]/h")
+	add(Unit{quantitySpeed, 1852.0 / 3600, 0}, "kn", "[kn_i]")
+
+	add(Unit{quantityVolume, 1, 0}, "m3")
+	add(Unit{quantityVolume, 1e-3, 0}, "L", "l")
+	add(Unit{quantityVolume, 1e-6, 0}, "mL", "ml")
+	add(Unit{quantityVolume, 0.003785411784, 0}, "gal", "[gal_us]")
+
+	add(Unit{quantityFlow, 1, 0}, "m3/s")
+	add(Unit{quantityFlow, 1.0 / 3600, 0}, "m3/h")
+	add(Unit{quantityFlow, 1e-3 / 60, 0}, "L/min", "l/min")
+	add(Unit{quantityFlow, 1e-3, 0}, "L/s", "l/s")
+	add(Unit{quantityFlow, 0.003785411784 / 60, 0}, "gpm", "[gal_us]/min")
+
+	add(Unit{quantityEnergy, 1, 0}, "J")
+	add(Unit{quantityEnergy, 1e3, 0}, "kJ")
+	add(Unit{quantityEnergy, 3600, 0}, "Wh")
+	add(Unit{quantityEnergy, 3.6e6, 0}, "kWh")
+	add(Unit{quantityEnergy, 3.6e9, 0}, "MWh")
+
+	add(Unit{quantityPower, 1, 0}, "W")
+	add(Unit{quantityPower, 1e-3, 0}, "mW")
+	add(Unit{quantityPower, 1e3, 0}, "kW")
+	add(Unit{quantityPower, 1e6, 0}, "MW")
+	add(Unit{quantityPower, 745.69987158227022, 0}, "hp", "[HP]")
+
+	add(Unit{quantityTime, 1, 0}, "s")
+	add(Unit{quantityTime, 1e-3, 0}, "ms")
+	add(Unit{quantityTime, 60, 0}, "min")
+	add(Unit{quantityTime, 3600, 0}, "h")
+
+	add(Unit{quantityFrequency, 1, 0}, "Hz")
+	add(Unit{quantityFrequency, 1e3, 0}, "kHz")
+	add(Unit{quantityFrequency, 1.0 / 60, 0}, "rpm")
+
+	add(Unit{quantityVoltage, 1, 0}, "V")
+	add(Unit{quantityVoltage, 1e-3, 0}, "mV")
+	add(Unit{quantityVoltage, 1e3, 0}, "kV")
+
+	add(Unit{quantityCurrent, 1, 0}, "A")
+	add(Unit{quantityCurrent, 1e-3, 0}, "mA")
+
+	add(Unit{quantityAngle, 1, 0}, "rad")
+	add(Unit{quantityAngle, math.Pi / 180, 0}, "deg", "°")
+
+	add(Unit{quantityRatio, 1, 0}, "1")
+	add(Unit{quantityRatio, 1e-2, 0}, "%")
+	add(Unit{quantityRatio, 1e-6, 0}, "ppm", "[ppm]")
+	return registry
+}()
+
+// LookupUnit returns the unit of the built-in registry by name or symbol, e.g. "degC" or "°C"
+func LookupUnit(name string) (Unit, bool) {
+	u, ok := unitRegistry[strings.TrimSpace(name)]
+	return u, ok
+}
+
+// ConvertUnit converts the value between two units of the same quantity
+func ConvertUnit(value float64, from string, to string) (float64, errors.EdgeX) {
+	fromUnit, ok := LookupUnit(from)
+	if !ok {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown unit '%s'", from), nil)
+	}
+	toUnit, ok := LookupUnit(to)
+	if !ok {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown unit '%s'", to), nil)
+	}
+	if fromUnit.Quantity != toUnit.Quantity {
+		errMsg := fmt.Sprintf("unit '%s' of %s can't be converted to unit '%s' of %s", from, fromUnit.Quantity, to, toUnit.Quantity)
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+	}
+	return (value*fromUnit.Factor + fromUnit.Offset - toUnit.Offset) / toUnit.Factor, nil
+}
+
+// targetUnits are the target units of a command or device, by DeviceResource or by quantity
+type targetUnits struct {
+	byResource map[string]string
+	byQuantity map[string]string
+}
+
+type requestedUnitsKey struct{}
+
+// WithRequestedUnits returns a copy of the context carrying the target units of the ds-units query parameter of
+// a command. The context is returned unchanged if the parameter is empty.
+func WithRequestedUnits(ctx context.Context, parameter string) (context.Context, errors.EdgeX) {
+	if strings.TrimSpace(parameter) == "" {
+		return ctx, nil
+	}
+	units, err := parseTargetUnits(parameter)
+	if err != nil {
+		return ctx, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid %s parameter", sdkCommon.UnitsParameter), err)
+	}
+	return context.WithValue(ctx, requestedUnitsKey{}, units), nil
+}
+
+// parseTargetUnits parses a comma-separated list of units, applied to the DeviceResources of the same quantity,
+// or "resource:unit" pairs
+func parseTargetUnits(value string) (targetUnits, error) {
+	units := targetUnits{byResource: make(map[string]string), byQuantity: make(map[string]string)}
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		resource, unit, ok := strings.Cut(entry, ":")
+		if !ok {
+			unit = resource
+		}
+		unit = strings.TrimSpace(unit)
+		u, known := LookupUnit(unit)
+		if !known {
+			return targetUnits{}, fmt.Errorf("unknown unit '%s'", unit)
+		}
+		if ok {
+			units.byResource[strings.TrimSpace(resource)] = unit
+		} else {
+			units.byQuantity[u.Quantity] = unit
+		}
+	}
+	return units, nil
+}
+
+// deviceTargetUnits returns the target units of the ds-units property of the device
+func deviceTargetUnits(device models.Device) (targetUnits, errors.EdgeX) {
+	v, ok := device.Properties[sdkCommon.UnitsProperty]
+	if !ok {
+		return targetUnits{}, nil
+	}
+	var units targetUnits
+	var err error
+	if s, isString := v.(string); isString {
+		units, err = parseTargetUnits(s)
+	} else {
+		var m map[string]string
+		if m, err = cast.ToStringMapStringE(v); err == nil {
+			units = targetUnits{byResource: m}
+			for _, unit := range m {
+				if _, known := LookupUnit(unit); !known {
+					err = fmt.Errorf("unknown unit '%s'", unit)
+					break
+				}
+			}
+		}
+	}
+	if err != nil {
+		errMsg := fmt.Sprintf("invalid %s property of device %s", sdkCommon.UnitsProperty, device.Name)
+		return targetUnits{}, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, err)
+	}
+	return units, nil
+}
+
+// ResourceTargetUnit returns the unit the values of the DeviceResource are converted to for the device, from the
+// ds-units query parameter carried by the context, the ds-units property of the device and the ds-targetunit
+// attribute of the DeviceResource in that order of precedence. It returns "" if the values aren't converted. The
+// values are converted in float64, which can't represent the Int64 and Uint64 values above 2^53, so the 64-bit
+// integer DeviceResources aren't converted to the target unit of their quantity and can't have a target unit of
+// their own.
+func ResourceTargetUnit(ctx context.Context, device models.Device, dr models.DeviceResource) (string, errors.EdgeX) {
+	native, nativeKnown := LookupUnit(dr.Properties.Units)
+	is64Bit := slices.Contains([]string{common.ValueTypeInt64, common.ValueTypeUint64, common.ValueTypeInt64Array, common.ValueTypeUint64Array},
+		dr.Properties.ValueType)
+	targetOf := func(units targetUnits) string {
+		if unit, ok := units.byResource[dr.Name]; ok {
+			return unit
+		}
+		if nativeKnown && !is64Bit {
+			return units.byQuantity[native.Quantity]
+		}
+		return ""
+	}
+
+	var target string
+	if requested, ok := ctx.Value(requestedUnitsKey{}).(targetUnits); ok {
+		target = targetOf(requested)
+	}
+	if target == "" {
+		units, err := deviceTargetUnits(device)
+		if err != nil {
+			return "", errors.NewCommonEdgeXWrapper(err)
+		}
+		target = targetOf(units)
+	}
+	if target == "" {
+		target = strings.TrimSpace(cast.ToString(dr.Attributes[sdkCommon.TargetUnitAttribute]))
+	}
+	if target == "" || target == dr.Properties.Units {
+		return "", nil
+	}
+
+	if is64Bit {
+		errMsg := fmt.Sprintf("DeviceResource %s of value type %s can't be converted to unit '%s'", dr.Name, dr.Properties.ValueType, target)
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+	}
+	if !nativeKnown {
+		errMsg := fmt.Sprintf("DeviceResource %s can't be converted to unit '%s' from unknown units '%s'", dr.Name, target, dr.Properties.Units)
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+	}
+	if _, err := ConvertUnit(0, dr.Properties.Units, target); err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid target unit of DeviceResource %s", dr.Name), err)
+	}
+	return target, nil
+}
+
+// TransformReadUnit converts the read value of the DeviceResource from its Units to its target unit, and returns
+// the unit of the value
+func TransformReadUnit(ctx context.Context, cv *sdkModels.CommandValue, device models.Device, dr models.DeviceResource) (string, errors.EdgeX) {
+	if cv.Value == nil || (!isNumericValueType(cv) && !isNumericArrayValueType(cv)) {
+		return dr.Properties.Units, nil
+	}
+	target, err := ResourceTargetUnit(ctx, device, dr)
+	if err != nil {
+		return dr.Properties.Units, errors.NewCommonEdgeXWrapper(err)
+	} else if target == "" {
+		return dr.Properties.Units, nil
+	}
+	if err = convertValueUnit(cv, dr.Properties.Units, target); err != nil {
+		return dr.Properties.Units, errors.NewCommonEdgeXWrapper(err)
+	}
+	return target, nil
+}
+
+// TransformWriteUnit converts the written value of the DeviceResource from its target unit to its Units
+func TransformWriteUnit(ctx context.Context, cv *sdkModels.CommandValue, device models.Device, dr models.DeviceResource) errors.EdgeX {
+	if cv.Value == nil || (!isNumericValueType(cv) && !isNumericArrayValueType(cv)) {
+		return nil
+	}
+	target, err := ResourceTargetUnit(ctx, device, dr)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	} else if target == "" {
+		return nil
+	}
+	return convertValueUnit(cv, target, dr.Properties.Units)
+}
+
+func convertValueUnit(cv *sdkModels.CommandValue, from string, to string) errors.EdgeX {
+	convert := func(value any) (any, errors.EdgeX) {
+		v, err := cast.ToFloat64E(value)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to convert value of %s to unit '%s'", cv.DeviceResourceName, to), err)
+		}
+		result, edgexErr := ConvertUnit(v, from, to)
+		if edgexErr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+		converted, edgexErr := float64ToTypeOf(value, result)
+		if edgexErr != nil {
+			errMsg := fmt.Sprintf("value %v of %s converted to unit '%s' is out of the range of %T", value, cv.DeviceResourceName, to, value)
+			return nil, errors.NewCommonEdgeX(errors.Kind(edgexErr), errMsg, edgexErr)
+		}
+		return converted, nil
+	}
+
+	var converted any
+	var err errors.EdgeX
+	if isNumericArrayValueType(cv) {
+		converted, err = transformArray(cv.Value, convert)
+	} else {
+		converted, err = convert(cv.Value)
+	}
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	cv.Value = converted
+	return nil
+}
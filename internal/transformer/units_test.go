@@ -0,0 +1,221 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transformer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/cache"
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+func TestConvertUnit(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       float64
+		from        string
+		to          string
+		expected    float64
+		expectedErr bool
+	}{
+		{"degF to degC", 212, "degF", "degC", 100, false},
+		{"degC to degF", -40, "°C", "°F", -40, false},
+		{"degC to K", 25, "degC", "K", 298.15, false},
+		{"psi to bar", 14.5038, "psi", "bar", 1, false},
+		{"bar to kPa", 1, "bar", "kPa", 100, false},
+		{"km/h to m/s", 36, "km/h", "m/s", 10, false},
+		{"kWh to J", 1, "kWh", "J", 3.6e6, false},
+		{"same unit", 42, "mm", "mm", 42, false},
+		{"unknown unit", 1, "furlong", "m", 0, true},
+		{"incompatible units", 1, "bar", "degC", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ConvertUnit(tt.value, tt.from, tt.to)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expected, result, 1e-4)
+		})
+	}
+}
+
+func TestWithRequestedUnits(t *testing.T) {
+	ctx, err := WithRequestedUnits(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, context.Background(), ctx)
+
+	_, err = WithRequestedUnits(context.Background(), "degC,pressure:furlong")
+	assert.Error(t, err)
+
+	ctx, err = WithRequestedUnits(context.Background(), "degF, pressure:psi")
+	require.NoError(t, err)
+	units, ok := ctx.Value(requestedUnitsKey{}).(targetUnits)
+	require.True(t, ok)
+	assert.Equal(t, map[string]string{quantityTemperature: "degF"}, units.byQuantity)
+	assert.Equal(t, map[string]string{"pressure": "psi"}, units.byResource)
+}
+
+func TestResourceTargetUnit(t *testing.T) {
+	temperature := models.DeviceResource{Name: "temperature", Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, Units: "degC"}}
+	profileTarget := temperature
+	profileTarget.Attributes = map[string]any{sdkCommon.TargetUnitAttribute: "K"}
+	unknownUnits := models.DeviceResource{Name: "temperature", Properties: models.ResourceProperties{Units: "celsius degrees"}}
+	energy := models.DeviceResource{Name: "energy", Properties: models.ResourceProperties{ValueType: common.ValueTypeUint64, Units: "Wh"}}
+	energyTarget := energy
+	energyTarget.Attributes = map[string]any{sdkCommon.TargetUnitAttribute: "kWh"}
+	requested, err := WithRequestedUnits(context.Background(), "degF")
+	require.NoError(t, err)
+	requestedByResource, err := WithRequestedUnits(context.Background(), "temperature:degC")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		properties  map[string]any
+		dr          models.DeviceResource
+		expected    string
+		expectedErr bool
+	}{
+		{"not converted", context.Background(), nil, temperature, "", false},
+		{"profile", context.Background(), nil, profileTarget, "K", false},
+		{"device by quantity", context.Background(), map[string]any{sdkCommon.UnitsProperty: "degF"}, profileTarget, "degF", false},
+		{"device by DeviceResource", context.Background(), map[string]any{sdkCommon.UnitsProperty: map[string]any{"temperature": "degF"}}, profileTarget, "degF", false},
+		{"device of another quantity", context.Background(), map[string]any{sdkCommon.UnitsProperty: "psi"}, profileTarget, "K", false},
+		{"request", requested, map[string]any{sdkCommon.UnitsProperty: "K"}, profileTarget, "degF", false},
+		{"request of the native unit", requestedByResource, nil, profileTarget, "", false},
+		{"request ignoring unknown units", requested, nil, unknownUnits, "", false},
+		{"invalid - incompatible target unit", context.Background(), map[string]any{sdkCommon.UnitsProperty: "temperature:bar"}, temperature, "", true},
+		{"invalid - unknown units", requestedByResource, nil, unknownUnits, "", true},
+		{"invalid - device property", context.Background(), map[string]any{sdkCommon.UnitsProperty: "furlong"}, temperature, "", true},
+		{"64-bit integer not converted by quantity", context.Background(), map[string]any{sdkCommon.UnitsProperty: "kWh"}, energy, "", false},
+		{"invalid - 64-bit integer by DeviceResource", context.Background(), map[string]any{sdkCommon.UnitsProperty: "energy:kWh"}, energy, "", true},
+		{"invalid - 64-bit integer profile", context.Background(), nil, energyTarget, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			device := models.Device{Name: "device", Properties: tt.properties}
+			target, err := ResourceTargetUnit(tt.ctx, device, tt.dr)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, target)
+		})
+	}
+}
+
+func TestTransformUnit(t *testing.T) {
+	dr := models.DeviceResource{
+		Name:       "temperature",
+		Attributes: map[string]any{sdkCommon.TargetUnitAttribute: "degF"},
+		Properties: models.ResourceProperties{ValueType: common.ValueTypeInt16, Units: "degC"},
+	}
+	device := models.Device{Name: "device"}
+
+	cv, err := sdkModels.NewCommandValue(dr.Name, common.ValueTypeInt16, int16(37))
+	require.NoError(t, err)
+	units, edgexErr := TransformReadUnit(context.Background(), cv, device, dr)
+	require.NoError(t, edgexErr)
+	assert.Equal(t, "degF", units)
+	assert.Equal(t, int16(99), cv.Value, "expect the integers to be rounded")
+
+	edgexErr = TransformWriteUnit(context.Background(), cv, device, dr)
+	require.NoError(t, edgexErr)
+	assert.Equal(t, int16(37), cv.Value)
+
+	cv, err = sdkModels.NewCommandValue(dr.Name, common.ValueTypeInt16, int16(32000))
+	require.NoError(t, err)
+	_, edgexErr = TransformReadUnit(context.Background(), cv, device, dr)
+	assert.Error(t, edgexErr, "expect the converted value to overflow")
+
+	cv, err = sdkModels.NewCommandValue(dr.Name, common.ValueTypeFloat32Array, []float32{0, 100})
+	require.NoError(t, err)
+	units, edgexErr = TransformReadUnit(context.Background(), cv, device, dr)
+	require.NoError(t, edgexErr)
+	assert.Equal(t, "degF", units)
+	assert.Equal(t, []float32{32, 212}, cv.Value, "expect the arrays to be converted element-wise")
+
+	cv, err = sdkModels.NewCommandValue(dr.Name, common.ValueTypeString, "37")
+	require.NoError(t, err)
+	units, edgexErr = TransformReadUnit(context.Background(), cv, device, dr)
+	require.NoError(t, edgexErr)
+	assert.Equal(t, "degC", units, "expect the non-numeric values not to be converted")
+	assert.Equal(t, "37", cv.Value)
+}
+
+func TestCommandValuesToEventDTO_Units(t *testing.T) {
+	dic := NewMockDIC()
+	err := cache.InitCache(TestDeviceService, TestDeviceService, dic)
+	require.NoError(t, err)
+	container.ConfigurationFrom(dic.Get).Writable.Reading.ReadingUnits = true
+	defer func() { container.ConfigurationFrom(dic.Get).Writable.Reading.ReadingUnits = false }()
+
+	profile := models.DeviceProfile{
+		Name: "unitsProfile",
+		DeviceResources: []models.DeviceResource{
+			{Name: "temperature", Attributes: map[string]any{sdkCommon.TargetUnitAttribute: "degF"},
+				Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat64, ReadWrite: common.ReadWrite_R, Units: "degC"}},
+			{Name: "pressure", Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat64, ReadWrite: common.ReadWrite_R, Units: "bar"}},
+			{Name: "doubled", Attributes: map[string]any{sdkCommon.VirtualResourceAttribute: "expression", sdkCommon.ReadExpressionAttribute: "temperature * 2"},
+				Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat64, ReadWrite: common.ReadWrite_R, Units: "degC"}},
+		},
+		DeviceCommands: []models.DeviceCommand{
+			{Name: "all", ReadWrite: common.ReadWrite_R, ResourceOperations: []models.ResourceOperation{
+				{DeviceResource: "temperature"}, {DeviceResource: "pressure"}, {DeviceResource: "doubled"}}},
+		},
+	}
+	require.NoError(t, cache.Profiles().Add(profile))
+	require.NoError(t, cache.Devices().Add(models.Device{Name: "unitsDevice", ProfileName: profile.Name, ServiceName: TestDeviceService}))
+
+	tests := []struct {
+		name          string
+		units         string
+		expected      map[string]string
+		expectedUnits map[string]string
+	}{
+		{"profile target unit", "",
+			map[string]string{"temperature": "2.120000e+02", "pressure": "1.000000e+00", "doubled": "2.000000e+02"},
+			map[string]string{"temperature": "degF", "pressure": "bar", "doubled": "degC"}},
+		{"requested units", "K,pressure:kPa",
+			map[string]string{"temperature": "3.731500e+02", "pressure": "1.000000e+02", "doubled": "4.731500e+02"},
+			map[string]string{"temperature": "K", "pressure": "kPa", "doubled": "K"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, edgexErr := WithRequestedUnits(context.Background(), tt.units)
+			require.NoError(t, edgexErr)
+			temperature, err := sdkModels.NewCommandValue("temperature", common.ValueTypeFloat64, float64(100))
+			require.NoError(t, err)
+			pressure, err := sdkModels.NewCommandValue("pressure", common.ValueTypeFloat64, float64(1))
+			require.NoError(t, err)
+
+			event, edgexErr := CommandValuesToEventDTO(ctx, []*sdkModels.CommandValue{temperature, pressure}, "unitsDevice", "all", true, dic)
+			require.NoError(t, edgexErr)
+			require.NotNil(t, event)
+			values := make(map[string]string, len(event.Readings))
+			units := make(map[string]string, len(event.Readings))
+			for _, r := range event.Readings {
+				values[r.ResourceName] = r.Value
+				units[r.ResourceName] = r.Units
+			}
+			assert.Equal(t, tt.expected, values)
+			assert.Equal(t, tt.expectedUnits, units)
+		})
+	}
+}
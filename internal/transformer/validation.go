@@ -0,0 +1,214 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transformer
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/spf13/cast"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/utils"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+// validationState holds the previous read values of a DeviceResource of a device for the rate-of-change and
+// stuck-value rules
+type validationState struct {
+	// lastValue and lastOrigin are the last value which passed the rate-of-change rule
+	lastValue  float64
+	lastOrigin int64
+	hasLast    bool
+	stuckValue float64
+	stuckCount int
+}
+
+// validationStatePrefix prefixes the name of a DeviceResource in the key of the transform state holding its
+// validationState
+const validationStatePrefix = "validation:"
+
+type validationFailure struct {
+	reason  string
+	action  string
+	message string
+}
+
+// checkValidationRules checks the numeric read value against the plausibility range, the maximum rate of change
+// and the stuck-value rules of the DeviceResource, and returns the failed ones. The previous read values of the
+// rate-of-change and stuck-value rules are kept in the transform states of the device.
+func checkValidationRules(deviceName string, dr models.DeviceResource, cv *sdkModels.CommandValue,
+	states *container.TransformStates) ([]validationFailure, errors.EdgeX) {
+	if cv.Value == nil || !isNumericValueType(cv) {
+		return nil, nil
+	}
+	minimum, err := validationNumber(dr, sdkCommon.ValidMinimumAttribute)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	maximum, err := validationNumber(dr, sdkCommon.ValidMaximumAttribute)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	maxRate, err := validationNumber(dr, sdkCommon.MaxRateOfChangeAttribute)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	stuckCount, err := validationNumber(dr, sdkCommon.StuckCountAttribute)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	if minimum == nil && maximum == nil && maxRate == nil && stuckCount == nil {
+		return nil, nil
+	}
+
+	value, castErr := cast.ToFloat64E(cv.Value)
+	if castErr != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to validate DeviceResource %s", dr.Name), castErr)
+	}
+
+	var failures []validationFailure
+	addFailure := func(reason string, actionAttribute string, message string) errors.EdgeX {
+		action, err := validationAction(dr, actionAttribute)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		failures = append(failures, validationFailure{reason: reason, action: action, message: message})
+		return nil
+	}
+
+	if (minimum != nil && value < *minimum) || (maximum != nil && value > *maximum) {
+		msg := fmt.Sprintf("value %v of DeviceResource %s is out of the valid range [%s, %s]", value, dr.Name,
+			formatBound(minimum, math.Inf(-1)), formatBound(maximum, math.Inf(1)))
+		if err := addFailure(sdkModels.QualityReasonOutOfRange, sdkCommon.ValidRangeActionAttribute, msg); err != nil {
+			return nil, err
+		}
+	}
+	if maxRate == nil && stuckCount == nil {
+		return failures, nil
+	}
+
+	origin := cv.Origin
+	if origin == 0 {
+		origin = time.Now().UnixNano()
+	}
+	rateExceeded := false
+	var rate float64
+	var count int
+	states.Update(deviceName, validationStatePrefix+dr.Name, func(current any) any {
+		var state validationState
+		if previous, ok := current.(validationState); ok {
+			state = previous
+		}
+		if maxRate != nil && state.hasLast && origin > state.lastOrigin {
+			rate = math.Abs(value-state.lastValue) / (float64(origin-state.lastOrigin) / float64(time.Second))
+			rateExceeded = rate > *maxRate
+		}
+		// a rejected spike doesn't become the reference of the next rate of change
+		if !rateExceeded {
+			state.lastValue, state.lastOrigin, state.hasLast = value, origin, true
+		}
+		if state.stuckCount > 0 && value == state.stuckValue {
+			state.stuckCount++
+		} else {
+			state.stuckValue, state.stuckCount = value, 1
+		}
+		count = state.stuckCount
+		return state
+	})
+
+	if rateExceeded {
+		msg := fmt.Sprintf("rate of change %v/s of DeviceResource %s exceeds the maximum %v/s", rate, dr.Name, *maxRate)
+		if err := addFailure(sdkModels.QualityReasonRateOfChange, sdkCommon.MaxRateOfChangeActionAttribute, msg); err != nil {
+			return nil, err
+		}
+	}
+	if stuckCount != nil && *stuckCount >= 2 && count >= int(*stuckCount) {
+		msg := fmt.Sprintf("value %v of DeviceResource %s is stuck for %d readings", value, dr.Name, count)
+		if err := addFailure(sdkModels.QualityReasonStuckValue, sdkCommon.StuckActionAttribute, msg); err != nil {
+			return nil, err
+		}
+	}
+	return failures, nil
+}
+
+// applyValidationActions applies the actions of the failed validation rules to the CommandValue, and returns
+// true if the reading is dropped
+func applyValidationActions(cv *sdkModels.CommandValue, deviceName string, failures []validationFailure, lc logger.LoggingClient,
+	dc interfaces.DeviceClient, dic *di.Container) bool {
+	if len(failures) == 0 {
+		return false
+	}
+	drop := false
+	value := cv.ValueToString()
+	for _, failure := range failures {
+		lc.Warnf("device %s: %s, action: %s", deviceName, failure.message, failure.action)
+		switch failure.action {
+		case sdkCommon.ValidationActionDrop:
+			drop = true
+		case sdkCommon.ValidationActionBad:
+			cv.SetQuality(sdkModels.QualityBad, failure.reason, failure.message)
+		case sdkCommon.ValidationActionDown:
+			go sdkCommon.UpdateOperatingState(deviceName, models.Down, lc, dc)
+			cv.SetQuality(sdkModels.QualityBad, failure.reason, failure.message)
+		case sdkCommon.ValidationActionEvent:
+			details := sdkModels.ReadingValidationFailure{
+				DeviceName:   deviceName,
+				ResourceName: cv.DeviceResourceName,
+				Rule:         failure.reason,
+				Value:        value,
+				Message:      failure.message,
+			}
+			go utils.PublishGenericSystemEvent(common.DeviceSystemEventType, sdkCommon.ReadingValidationSystemEventAction, details, context.Background(), dic)
+		}
+	}
+	return drop
+}
+
+func validationNumber(dr models.DeviceResource, attribute string) (*float64, errors.EdgeX) {
+	v, ok := dr.Attributes[attribute]
+	if !ok {
+		return nil, nil
+	}
+	f, err := cast.ToFloat64E(v)
+	if err != nil {
+		errMsg := fmt.Sprintf("invalid %s of DeviceResource %s", attribute, dr.Name)
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, err)
+	}
+	return &f, nil
+}
+
+func validationAction(dr models.DeviceResource, attribute string) (string, errors.EdgeX) {
+	action := strings.ToLower(strings.TrimSpace(cast.ToString(dr.Attributes[attribute])))
+	switch action {
+	case "":
+		return sdkCommon.ValidationActionBad, nil
+	case sdkCommon.ValidationActionBad, sdkCommon.ValidationActionDrop, sdkCommon.ValidationActionEvent, sdkCommon.ValidationActionDown:
+		return action, nil
+	default:
+		errMsg := fmt.Sprintf("invalid %s '%s' of DeviceResource %s, expected %s, %s, %s or %s", attribute, action, dr.Name,
+			sdkCommon.ValidationActionBad, sdkCommon.ValidationActionDrop, sdkCommon.ValidationActionEvent, sdkCommon.ValidationActionDown)
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+	}
+}
+
+func formatBound(bound *float64, unbounded float64) string {
+	if bound == nil {
+		return fmt.Sprint(unbounded)
+	}
+	return fmt.Sprint(*bound)
+}
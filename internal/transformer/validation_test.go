@@ -0,0 +1,186 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transformer
+
+import (
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	messagingMocks "github.com/edgexfoundry/go-mod-messaging/v4/messaging/mocks"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/config"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+func validatedResource(name string, attributes map[string]any) models.DeviceResource {
+	return models.DeviceResource{Name: name, Attributes: attributes, Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat64}}
+}
+
+func TestCheckValidationRules_Range(t *testing.T) {
+	tests := []struct {
+		name           string
+		attributes     map[string]any
+		value          float64
+		expectedAction string
+		expectedErr    bool
+	}{
+		{"no rule", nil, 1000, "", false},
+		{"in range", map[string]any{sdkCommon.ValidMinimumAttribute: -40, sdkCommon.ValidMaximumAttribute: "125"}, 25, "", false},
+		{"below minimum with the default action", map[string]any{sdkCommon.ValidMinimumAttribute: -40}, -41, sdkCommon.ValidationActionBad, false},
+		{"above maximum", map[string]any{sdkCommon.ValidMaximumAttribute: 125, sdkCommon.ValidRangeActionAttribute: "Drop"}, 126, sdkCommon.ValidationActionDrop, false},
+		{"invalid maximum", map[string]any{sdkCommon.ValidMaximumAttribute: "high"}, 1, "", true},
+		{"invalid action", map[string]any{sdkCommon.ValidMaximumAttribute: 1, sdkCommon.ValidRangeActionAttribute: "ignore"}, 2, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cv, err := sdkModels.NewCommandValue("temperature", common.ValueTypeFloat64, tt.value)
+			require.NoError(t, err)
+			failures, edgexErr := checkValidationRules("rangeDevice", validatedResource("temperature", tt.attributes), cv, nil)
+			if tt.expectedErr {
+				require.Error(t, edgexErr)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(edgexErr))
+				return
+			}
+			require.NoError(t, edgexErr)
+			if tt.expectedAction == "" {
+				assert.Empty(t, failures)
+				return
+			}
+			require.Len(t, failures, 1)
+			assert.Equal(t, sdkModels.QualityReasonOutOfRange, failures[0].reason)
+			assert.Equal(t, tt.expectedAction, failures[0].action)
+		})
+	}
+}
+
+func TestCheckValidationRules_RateOfChange(t *testing.T) {
+	dr := validatedResource("pressure", map[string]any{sdkCommon.MaxRateOfChangeAttribute: 10})
+	states := container.NewTransformStates()
+	start := time.Now().UnixNano()
+	readings := []struct {
+		value    float64
+		seconds  int64
+		expected bool
+	}{
+		{100, 0, false},
+		{105, 1, false},
+		// the spike is rejected and the next value is compared with the last valid one
+		{200, 2, true},
+		{120, 3, false},
+	}
+	for _, r := range readings {
+		cv, err := sdkModels.NewCommandValueWithOrigin(dr.Name, common.ValueTypeFloat64, r.value, start+r.seconds*int64(time.Second))
+		require.NoError(t, err)
+		failures, edgexErr := checkValidationRules("rateDevice", dr, cv, states)
+		require.NoError(t, edgexErr)
+		if !r.expected {
+			assert.Empty(t, failures, "value %v", r.value)
+			continue
+		}
+		require.Len(t, failures, 1, "value %v", r.value)
+		assert.Equal(t, sdkModels.QualityReasonRateOfChange, failures[0].reason)
+	}
+}
+
+func TestCheckValidationRules_StuckValue(t *testing.T) {
+	dr := validatedResource("flow", map[string]any{sdkCommon.StuckCountAttribute: 3, sdkCommon.StuckActionAttribute: sdkCommon.ValidationActionEvent})
+	values := []float64{1, 2, 2, 2, 2, 3}
+	expected := []bool{false, false, false, true, true, false}
+	states := container.NewTransformStates()
+	for i, v := range values {
+		cv, err := sdkModels.NewCommandValue(dr.Name, common.ValueTypeFloat64, v)
+		require.NoError(t, err)
+		failures, edgexErr := checkValidationRules("stuckDevice", dr, cv, states)
+		require.NoError(t, edgexErr)
+		if !expected[i] {
+			assert.Empty(t, failures, "reading %d", i)
+			continue
+		}
+		require.Len(t, failures, 1, "reading %d", i)
+		assert.Equal(t, sdkModels.QualityReasonStuckValue, failures[0].reason)
+		assert.Equal(t, sdkCommon.ValidationActionEvent, failures[0].action)
+	}
+
+	// the stuck value is counted again once the previous readings of the device are removed
+	states.RemoveDevice("stuckDevice")
+	cv, err := sdkModels.NewCommandValue(dr.Name, common.ValueTypeFloat64, float64(3))
+	require.NoError(t, err)
+	failures, edgexErr := checkValidationRules("stuckDevice", dr, cv, states)
+	require.NoError(t, edgexErr)
+	assert.Empty(t, failures)
+}
+
+func TestApplyValidationActions(t *testing.T) {
+	published := make(chan types.MessageEnvelope, 1)
+	messagingClient := &messagingMocks.MessageClient{}
+	messagingClient.On("Publish", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		published <- args.Get(0).(types.MessageEnvelope)
+	}).Return(nil)
+	lc := logger.NewMockClient()
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) any {
+			return lc
+		},
+		container.ConfigurationName: func(get di.Get) any {
+			return &config.ConfigurationStruct{}
+		},
+		container.DeviceServiceName: func(get di.Get) any {
+			return &models.DeviceService{Name: TestDeviceService}
+		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) any {
+			return messagingClient
+		},
+	})
+
+	tests := []struct {
+		name            string
+		action          string
+		expectedDrop    bool
+		expectedQuality sdkModels.QualityStatus
+	}{
+		{"drop", sdkCommon.ValidationActionDrop, true, sdkModels.QualityGood},
+		{"bad", sdkCommon.ValidationActionBad, false, sdkModels.QualityBad},
+		{"event", sdkCommon.ValidationActionEvent, false, sdkModels.QualityGood},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cv, err := sdkModels.NewCommandValue("temperature", common.ValueTypeFloat64, float64(200))
+			require.NoError(t, err)
+			failures := []validationFailure{{reason: sdkModels.QualityReasonOutOfRange, action: tt.action, message: "out of range"}}
+
+			dropped := applyValidationActions(cv, TestDevice, failures, lc, nil, dic)
+			assert.Equal(t, tt.expectedDrop, dropped)
+			assert.Equal(t, tt.expectedQuality, cv.QualityStatus())
+			if tt.action != sdkCommon.ValidationActionEvent {
+				return
+			}
+
+			envelope := <-published
+			systemEvent, err := types.GetMsgPayload[dtos.SystemEvent](envelope)
+			require.NoError(t, err)
+			assert.Equal(t, sdkCommon.ReadingValidationSystemEventAction, systemEvent.Action)
+			var details sdkModels.ReadingValidationFailure
+			require.NoError(t, systemEvent.DecodeDetails(&details))
+			assert.Equal(t, TestDevice, details.DeviceName)
+			assert.Equal(t, sdkModels.QualityReasonOutOfRange, details.Rule)
+			assert.Equal(t, "200", details.Value)
+		})
+	}
+}
@@ -0,0 +1,273 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transformer
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/spf13/cast"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/cache"
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+// functions of the virtual DeviceResources
+const (
+	VirtualSum        = "sum"
+	VirtualAverage    = "average"
+	VirtualMin        = "min"
+	VirtualMax        = "max"
+	VirtualDelta      = "delta"
+	VirtualExpression = "expression"
+)
+
+// deltaStatePrefix prefixes the name of a delta virtual DeviceResource in the key of the transform state holding the
+// last value of its source
+const deltaStatePrefix = "delta:"
+
+// IsVirtualResource tells whether the DeviceResource is computed by the SDK rather than read by the driver
+func IsVirtualResource(dr models.DeviceResource) bool {
+	_, ok := dr.Attributes[sdkCommon.VirtualResourceAttribute]
+	return ok
+}
+
+// VirtualSources returns the names of the DeviceResources the virtual DeviceResource is computed from
+func VirtualSources(profileName string, dr models.DeviceResource) ([]string, errors.EdgeX) {
+	var sources []string
+	switch v := dr.Attributes[sdkCommon.VirtualSourcesAttribute].(type) {
+	case nil:
+	case string:
+		for _, name := range strings.Split(v, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				sources = append(sources, name)
+			}
+		}
+	default:
+		sources = cast.ToStringSlice(v)
+	}
+
+	function := virtualFunction(dr)
+	if function == VirtualExpression {
+		if err := checkExpressionValueType(dr.Name, dr.Properties.ValueType, sdkCommon.ReadExpressionAttribute); err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if len(sources) == 0 && function == VirtualExpression {
+		source, ok := expressionAttribute(dr, sdkCommon.ReadExpressionAttribute)
+		if !ok {
+			errMsg := fmt.Sprintf("virtual DeviceResource %s has no %s", dr.Name, sdkCommon.ReadExpressionAttribute)
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+		}
+		e, err := compiledExpression(profileName, dr.Name, sdkCommon.ReadExpressionAttribute, source)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		sources = slices.Sorted(slices.Values(e.variables))
+	}
+
+	switch {
+	case len(sources) == 0:
+		errMsg := fmt.Sprintf("virtual DeviceResource %s has no %s", dr.Name, sdkCommon.VirtualSourcesAttribute)
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+	case function == VirtualDelta && len(sources) != 1:
+		errMsg := fmt.Sprintf("delta virtual DeviceResource %s must have exactly one source", dr.Name)
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+	}
+	return sources, nil
+}
+
+func virtualFunction(dr models.DeviceResource) string {
+	return strings.ToLower(strings.TrimSpace(cast.ToString(dr.Attributes[sdkCommon.VirtualResourceAttribute])))
+}
+
+// virtualResources returns the virtual DeviceResources of the source, i.e. of a DeviceCommand or the DeviceResource
+// itself, along with the source DeviceResources which are read only to compute them and not part of the source.
+func virtualResources(profileName string, sourceName string) ([]models.DeviceResource, map[string]bool) {
+	resourceNames := sourceResourceNames(profileName, sourceName)
+	var virtuals []models.DeviceResource
+	hidden := make(map[string]bool)
+	for _, name := range resourceNames {
+		dr, ok := cache.Profiles().DeviceResource(profileName, name)
+		if !ok || !IsVirtualResource(dr) {
+			continue
+		}
+		virtuals = append(virtuals, dr)
+		sources, err := VirtualSources(profileName, dr)
+		if err != nil {
+			continue
+		}
+		for _, source := range sources {
+			if !slices.Contains(resourceNames, source) {
+				hidden[source] = true
+			}
+		}
+	}
+	return virtuals, hidden
+}
+
+// sourceResourceNames returns the names of the DeviceResources of the source, i.e. of a DeviceCommand or the
+// DeviceResource itself
+func sourceResourceNames(profileName string, sourceName string) []string {
+	dc, ok := cache.Profiles().DeviceCommand(profileName, sourceName)
+	if !ok {
+		return []string{sourceName}
+	}
+	resourceNames := make([]string, 0, len(dc.ResourceOperations))
+	for _, ro := range dc.ResourceOperations {
+		resourceNames = append(resourceNames, ro.DeviceResource)
+	}
+	return resourceNames
+}
+
+// computeVirtualValue computes the virtual DeviceResource from the values of its sources. It returns nil without
+// error if a source value is missing, or for the first reading of a delta. The last value of the source of a delta
+// is kept in the transform states of the device.
+func computeVirtualValue(deviceName string, profileName string, dr models.DeviceResource, values map[string]float64,
+	states *container.TransformStates) (*sdkModels.CommandValue, errors.EdgeX) {
+	sources, err := VirtualSources(profileName, dr)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	inputs := make([]float64, 0, len(sources))
+	for _, source := range sources {
+		v, ok := values[source]
+		if !ok {
+			return nil, nil
+		}
+		inputs = append(inputs, v)
+	}
+
+	var result float64
+	switch function := virtualFunction(dr); function {
+	case VirtualSum, VirtualAverage:
+		for _, v := range inputs {
+			result += v
+		}
+		if function == VirtualAverage {
+			result /= float64(len(inputs))
+		}
+	case VirtualMin:
+		result = slices.Min(inputs)
+	case VirtualMax:
+		result = slices.Max(inputs)
+	case VirtualDelta:
+		var previous float64
+		var ok bool
+		states.Update(deviceName, deltaStatePrefix+dr.Name, func(state any) any {
+			previous, ok = state.(float64)
+			return inputs[0]
+		})
+		if !ok {
+			return nil, nil
+		}
+		result = inputs[0] - previous
+	case VirtualExpression:
+		source, _ := expressionAttribute(dr, sdkCommon.ReadExpressionAttribute)
+		e, err := compiledExpression(profileName, dr.Name, sdkCommon.ReadExpressionAttribute, source)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		var evalErr error
+		result, evalErr = e.evaluate(values)
+		if evalErr != nil {
+			errMsg := fmt.Sprintf("failed to evaluate expression '%s' of virtual DeviceResource %s", e.source, dr.Name)
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, evalErr)
+		}
+	default:
+		errMsg := fmt.Sprintf("unknown function '%s' of virtual DeviceResource %s", function, dr.Name)
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+	}
+
+	value, edgexErr := float64ToValueType(dr.Properties.ValueType, result)
+	if edgexErr != nil {
+		errMsg := fmt.Sprintf("failed to compute virtual DeviceResource %s", dr.Name)
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgexErr), errMsg, edgexErr)
+	}
+	cv, cvErr := sdkModels.NewCommandValue(dr.Name, dr.Properties.ValueType, value)
+	if cvErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(cvErr)
+	}
+	return cv, nil
+}
+
+// float64ToValueType converts the computed value to the numeric value type, rounding the integers
+func float64ToValueType(valueType string, value float64) (any, errors.EdgeX) {
+	var origin any
+	switch valueType {
+	case common.ValueTypeUint8:
+		origin = uint8(0)
+	case common.ValueTypeUint16:
+		origin = uint16(0)
+	case common.ValueTypeUint32:
+		origin = uint32(0)
+	case common.ValueTypeUint64:
+		origin = uint64(0)
+	case common.ValueTypeInt8:
+		origin = int8(0)
+	case common.ValueTypeInt16:
+		origin = int16(0)
+	case common.ValueTypeInt32:
+		origin = int32(0)
+	case common.ValueTypeInt64:
+		origin = int64(0)
+	case common.ValueTypeFloat32:
+		origin = float32(0)
+	case common.ValueTypeFloat64:
+		origin = float64(0)
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("value type %s is not numeric", valueType), nil)
+	}
+	return float64ToTypeOf(origin, value)
+}
+
+// float64ToTypeOf converts the computed value to the numeric type of the origin value, rounding the integers
+func float64ToTypeOf(origin any, value float64) (any, errors.EdgeX) {
+	if math.IsNaN(value) {
+		return nil, errors.NewCommonEdgeX(errors.KindNaNError, "computed value is NaN", nil)
+	}
+	switch origin.(type) {
+	case float32, float64:
+	default:
+		value = math.Round(value)
+	}
+	if math.IsInf(value, 0) || !checkTransformedValueInRange(origin, value) {
+		return nil, errors.NewCommonEdgeX(errors.KindOverflowError, fmt.Sprintf("computed value %v is out of the range of %T", value, origin), nil)
+	}
+
+	switch origin.(type) {
+	case uint8:
+		return uint8(value), nil
+	case uint16:
+		return uint16(value), nil
+	case uint32:
+		return uint32(value), nil
+	case uint64:
+		return uint64(value), nil
+	case int8:
+		return int8(value), nil
+	case int16:
+		return int16(value), nil
+	case int32:
+		return int32(value), nil
+	case int64:
+		return int64(value), nil
+	case float32:
+		return float32(value), nil
+	case float64:
+		return value, nil
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("value type %T is not numeric", origin), nil)
+	}
+}
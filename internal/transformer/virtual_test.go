@@ -0,0 +1,222 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transformer
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/cache"
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+func virtualResource(name string, valueType string, attributes map[string]any) models.DeviceResource {
+	return models.DeviceResource{
+		Name:       name,
+		Attributes: attributes,
+		Properties: models.ResourceProperties{ValueType: valueType, ReadWrite: common.ReadWrite_R},
+	}
+}
+
+func TestVirtualSources(t *testing.T) {
+	tests := []struct {
+		name        string
+		attributes  map[string]any
+		expected    []string
+		expectedErr bool
+	}{
+		{"valid - comma-separated", map[string]any{sdkCommon.VirtualResourceAttribute: VirtualSum, sdkCommon.VirtualSourcesAttribute: "a, b"}, []string{"a", "b"}, false},
+		{"valid - list", map[string]any{sdkCommon.VirtualResourceAttribute: VirtualMax, sdkCommon.VirtualSourcesAttribute: []any{"a", "b"}}, []string{"a", "b"}, false},
+		{"valid - variables of the expression", map[string]any{sdkCommon.VirtualResourceAttribute: VirtualExpression,
+			sdkCommon.ReadExpressionAttribute: "lo + hi * 65536"}, []string{"hi", "lo"}, false},
+		{"invalid - no source", map[string]any{sdkCommon.VirtualResourceAttribute: VirtualSum}, nil, true},
+		{"invalid - expression without expression", map[string]any{sdkCommon.VirtualResourceAttribute: VirtualExpression}, nil, true},
+		{"invalid - delta of several sources", map[string]any{sdkCommon.VirtualResourceAttribute: VirtualDelta, sdkCommon.VirtualSourcesAttribute: "a,b"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sources, err := VirtualSources("virtualProfile", virtualResource(tt.name, common.ValueTypeFloat64, tt.attributes))
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, sources)
+		})
+	}
+
+	_, err := VirtualSources("virtualProfile", virtualResource("int64", common.ValueTypeInt64, map[string]any{
+		sdkCommon.VirtualResourceAttribute: VirtualExpression, sdkCommon.ReadExpressionAttribute: "lo + hi * 65536"}))
+	assert.Error(t, err, "expect an error for an expression of an Int64 virtual DeviceResource")
+}
+
+func Test_computeVirtualValue(t *testing.T) {
+	values := map[string]float64{"a": 1, "b": 4, "hi": 1, "lo": 2, "big": 300}
+	tests := []struct {
+		name         string
+		valueType    string
+		attributes   map[string]any
+		expected     any
+		expectedKind errors.ErrKind
+	}{
+		{"sum", common.ValueTypeFloat64, map[string]any{sdkCommon.VirtualResourceAttribute: VirtualSum, sdkCommon.VirtualSourcesAttribute: "a,b"}, float64(5), ""},
+		{"average rounded to integer", common.ValueTypeInt32, map[string]any{sdkCommon.VirtualResourceAttribute: "Average", sdkCommon.VirtualSourcesAttribute: "a,b"}, int32(3), ""},
+		{"min", common.ValueTypeFloat32, map[string]any{sdkCommon.VirtualResourceAttribute: VirtualMin, sdkCommon.VirtualSourcesAttribute: "a,b"}, float32(1), ""},
+		{"max", common.ValueTypeUint8, map[string]any{sdkCommon.VirtualResourceAttribute: VirtualMax, sdkCommon.VirtualSourcesAttribute: "a,b"}, uint8(4), ""},
+		{"expression", common.ValueTypeUint32, map[string]any{sdkCommon.VirtualResourceAttribute: VirtualExpression,
+			sdkCommon.ReadExpressionAttribute: "hi * 65536 + lo"}, uint32(65538), ""},
+		{"missing source", common.ValueTypeFloat64, map[string]any{sdkCommon.VirtualResourceAttribute: VirtualSum, sdkCommon.VirtualSourcesAttribute: "a,missing"}, nil, ""},
+		{"overflow", common.ValueTypeUint8, map[string]any{sdkCommon.VirtualResourceAttribute: VirtualSum, sdkCommon.VirtualSourcesAttribute: "big"}, nil, errors.KindOverflowError},
+		{"NaN", common.ValueTypeFloat64, map[string]any{sdkCommon.VirtualResourceAttribute: VirtualExpression,
+			sdkCommon.ReadExpressionAttribute: "sqrt(a - b)"}, nil, errors.KindNaNError},
+		{"unknown function", common.ValueTypeFloat64, map[string]any{sdkCommon.VirtualResourceAttribute: "median", sdkCommon.VirtualSourcesAttribute: "a"}, nil, errors.KindContractInvalid},
+		{"not numeric", common.ValueTypeString, map[string]any{sdkCommon.VirtualResourceAttribute: VirtualSum, sdkCommon.VirtualSourcesAttribute: "a"}, nil, errors.KindContractInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cv, err := computeVirtualValue("device", "virtualProfile", virtualResource(tt.name, tt.valueType, tt.attributes), values, nil)
+			if tt.expectedKind != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.expectedKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			if tt.expected == nil {
+				assert.Nil(t, cv)
+				return
+			}
+			require.NotNil(t, cv)
+			assert.Equal(t, tt.name, cv.DeviceResourceName)
+			assert.Equal(t, tt.valueType, cv.Type)
+			assert.Equal(t, tt.expected, cv.Value)
+		})
+	}
+}
+
+func Test_computeVirtualValue_Delta(t *testing.T) {
+	dr := virtualResource("delta", common.ValueTypeFloat64, map[string]any{sdkCommon.VirtualResourceAttribute: VirtualDelta, sdkCommon.VirtualSourcesAttribute: "counter"})
+
+	states := container.NewTransformStates()
+
+	cv, err := computeVirtualValue("deltaDevice", "virtualProfile", dr, map[string]float64{"counter": 10}, states)
+	require.NoError(t, err)
+	assert.Nil(t, cv, "expect no value without previous reading")
+
+	cv, err = computeVirtualValue("deltaDevice", "virtualProfile", dr, map[string]float64{"counter": 15.5}, states)
+	require.NoError(t, err)
+	require.NotNil(t, cv)
+	assert.Equal(t, 5.5, cv.Value)
+
+	cv, err = computeVirtualValue("anotherDevice", "virtualProfile", dr, map[string]float64{"counter": 20}, states)
+	require.NoError(t, err)
+	assert.Nil(t, cv, "expect the previous readings to be kept per device")
+
+	states.RemoveDevice("deltaDevice")
+	cv, err = computeVirtualValue("deltaDevice", "virtualProfile", dr, map[string]float64{"counter": 20}, states)
+	require.NoError(t, err)
+	assert.Nil(t, cv, "expect no value once the previous readings of the device are removed")
+}
+
+func Test_float64ToValueType(t *testing.T) {
+	tests := []struct {
+		name         string
+		valueType    string
+		value        float64
+		expected     any
+		expectedKind errors.ErrKind
+	}{
+		{"int8 rounded", common.ValueTypeInt8, -2.5, int8(-3), ""},
+		{"uint64", common.ValueTypeUint64, 42, uint64(42), ""},
+		{"float32", common.ValueTypeFloat32, 1.5, float32(1.5), ""},
+		{"int16 overflow", common.ValueTypeInt16, math.MaxInt16 + 1, nil, errors.KindOverflowError},
+		{"uint32 negative", common.ValueTypeUint32, -1, nil, errors.KindOverflowError},
+		{"float64 infinity", common.ValueTypeFloat64, math.Inf(-1), nil, errors.KindOverflowError},
+		{"float32 NaN", common.ValueTypeFloat32, math.NaN(), nil, errors.KindNaNError},
+		{"bool", common.ValueTypeBool, 1, nil, errors.KindContractInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := float64ToValueType(tt.valueType, tt.value)
+			if tt.expectedKind != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.expectedKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, v)
+		})
+	}
+}
+
+func TestCommandValuesToEventDTO_VirtualResources(t *testing.T) {
+	dic := NewMockDIC()
+	err := cache.InitCache(TestDeviceService, TestDeviceService, dic)
+	require.NoError(t, err)
+
+	scale := 10.0
+	profile := models.DeviceProfile{
+		Name: "virtualProfile",
+		DeviceResources: []models.DeviceResource{
+			{Name: "a", Properties: models.ResourceProperties{ValueType: common.ValueTypeInt16, ReadWrite: common.ReadWrite_RW, Scale: &scale}},
+			{Name: "b", Properties: models.ResourceProperties{ValueType: common.ValueTypeInt16, ReadWrite: common.ReadWrite_RW}},
+			virtualResource("total", common.ValueTypeInt32, map[string]any{sdkCommon.VirtualResourceAttribute: VirtualSum, sdkCommon.VirtualSourcesAttribute: "a,b"}),
+			virtualResource("overflow", common.ValueTypeInt8, map[string]any{sdkCommon.VirtualResourceAttribute: VirtualMax, sdkCommon.VirtualSourcesAttribute: "a,b"}),
+		},
+		DeviceCommands: []models.DeviceCommand{
+			{Name: "summary", ReadWrite: common.ReadWrite_R, ResourceOperations: []models.ResourceOperation{{DeviceResource: "b"}, {DeviceResource: "total"}, {DeviceResource: "overflow"}}},
+		},
+	}
+	require.NoError(t, cache.Profiles().Add(profile))
+	require.NoError(t, cache.Devices().Add(models.Device{Name: "virtualDevice", ProfileName: profile.Name, ServiceName: TestDeviceService}))
+
+	newCommandValues := func() []*sdkModels.CommandValue {
+		a, err := sdkModels.NewCommandValue("a", common.ValueTypeInt16, int16(20))
+		require.NoError(t, err)
+		b, err := sdkModels.NewCommandValue("b", common.ValueTypeInt16, int16(5))
+		require.NoError(t, err)
+		return []*sdkModels.CommandValue{a, b}
+	}
+
+	tests := []struct {
+		name       string
+		sourceName string
+		expected   map[string]string
+	}{
+		{"DeviceCommand with the transformed sources, the hidden source is not reported", "summary",
+			map[string]string{"b": "5", "total": "205", "overflow": ""}},
+		{"virtual DeviceResource", "total", map[string]string{"total": "205"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := CommandValuesToEventDTO(context.Background(), newCommandValues(), "virtualDevice", tt.sourceName, true, dic)
+			require.NoError(t, err)
+			require.NotNil(t, event)
+			actual := make(map[string]string, len(event.Readings))
+			for _, r := range event.Readings {
+				actual[r.ResourceName] = r.Value
+				// the value which fails to compute is reported as a bad null value of the declared type
+				if r.ResourceName == "overflow" {
+					assert.Equal(t, common.ValueTypeInt8, r.ValueType)
+					assert.Equal(t, string(sdkModels.QualityBad), r.Tags[sdkModels.QualityTag])
+					assert.Equal(t, sdkModels.QualityReasonOverflow, r.Tags[sdkModels.QualityReasonTag])
+				} else {
+					assert.NotContains(t, r.Tags, sdkModels.QualityTag)
+				}
+			}
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,335 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package conformance provides a conformance suite of the ProtocolDriver contract, so that every driver is checked
+// the same way. The suite runs the ProtocolDriver in the test harness of the pkg/testing package with a device
+// profile and a device, and checks that the ProtocolDriver:
+//   - initializes, starts and stops without error, and without leaking goroutines,
+//   - validates, adds, updates and removes the device without error,
+//   - reads each readable DeviceResource as a CommandValue of its name and value type, alone and all together,
+//   - writes each writable DeviceResource,
+//   - sends valid AsyncValues to the AsyncValuesChannel,
+//   - sends valid devices to the DiscoveredDeviceChannel when it discovers devices, if the discovery is checked.
+//
+// The panics of the ProtocolDriver are recovered and reported as violations. The suite is run by a test of the
+// driver, e.g.
+//
+//	func TestConformance(t *testing.T) {
+//		conformance.Run(t, conformance.Config{
+//			NewDriver: func() interfaces.ProtocolDriver { return driver.New() },
+//			Profile:   profile,
+//			Device:    device,
+//		})
+//	}
+//
+// The goroutines started during the suite which are still running after Stop are reported as leaked if they run code
+// of the package which declares the type of the ProtocolDriver, so that the suite may run in parallel with the tests
+// of other packages. The goroutines of the other tests of the same package may be reported, so the suite must not run
+// in parallel with them.
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/interfaces"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+	sdkTesting "github.com/edgexfoundry/device-sdk-go/v4/pkg/testing"
+)
+
+const (
+	asyncValuesCheck = "AsyncValues"
+	goroutinesCheck  = "Goroutines"
+)
+
+// Config is the configuration of the conformance suite
+type Config struct {
+	// NewDriver returns the ProtocolDriver to check
+	NewDriver func() interfaces.ProtocolDriver
+	// Profile is the device profile of the Device
+	Profile models.DeviceProfile
+	// Device is the device whose DeviceResources are read and written. Its ProfileName is the name of the Profile
+	// if it's empty, and its AdminState is UNLOCKED if it's empty.
+	Device models.Device
+	// DriverConfigs is the driver specific configuration returned by DriverConfigs of the DeviceServiceSDK
+	DriverConfigs map[string]string
+	// WriteValues are the values written to the writable DeviceResources by name, as in the body of a SET command.
+	// The other writable DeviceResources are written with their DefaultValue, or else with the value read, and
+	// aren't written if they have neither.
+	WriteValues map[string]any
+	// Discovery checks the device discovery, in which the ProtocolDriver must discover at least a device
+	Discovery bool
+	// Timeout is how long the suite waits for the asynchronous results, i.e. the commands, the discovered devices
+	// and the end of the goroutines, the DefaultTimeout of the test harness if it's zero
+	Timeout time.Duration
+	// LogLevel is the level of the logs of the device service, which are discarded if it's empty
+	LogLevel string
+}
+
+// Violation is a violation of the ProtocolDriver contract
+type Violation struct {
+	// Check is the name of the failed check, e.g. "Read/Temperature"
+	Check string
+	Err   error
+}
+
+func (v Violation) Error() string {
+	return fmt.Sprintf("%s: %v", v.Check, v.Err)
+}
+
+// Run runs the conformance suite, and reports the violations as errors of the test
+func Run(t *testing.T, config Config) {
+	t.Helper()
+	violations, err := Check(config)
+	if err != nil {
+		t.Fatalf("failed to run the conformance suite: %v", err)
+	}
+	for _, v := range violations {
+		t.Errorf("%v", v)
+	}
+}
+
+// suite is a run of the conformance suite
+type suite struct {
+	config     Config
+	device     models.Device
+	probe      *probe
+	harness    *sdkTesting.Harness
+	violations []Violation
+}
+
+// Check runs the conformance suite, and returns the violations of the ProtocolDriver contract. The error is
+// returned if the suite fails to run, e.g. if the Config is invalid.
+func Check(config Config) ([]Violation, error) {
+	if config.NewDriver == nil {
+		return nil, errors.New("NewDriver is required")
+	}
+	if config.Profile.Name == "" || config.Device.Name == "" {
+		return nil, errors.New("the names of the Profile and the Device are required")
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = sdkTesting.DefaultTimeout
+	}
+	device := config.Device
+	if device.ProfileName == "" {
+		device.ProfileName = config.Profile.Name
+	}
+	if device.AdminState == "" {
+		device.AdminState = models.Unlocked
+	}
+	if device.OperatingState == "" {
+		device.OperatingState = models.Up
+	}
+
+	before := goroutines()
+	driver := config.NewDriver()
+	s := &suite{config: config, device: device, probe: newProbe(driver)}
+	h, err := sdkTesting.New(s.probe, sdkTesting.Options{DriverConfigs: config.DriverConfigs, LogLevel: config.LogLevel})
+	if err != nil {
+		s.violations = append(s.violations, s.probe.close()...)
+		if s.checkResult("Initialize") || s.checkResult("Start") {
+			return s.violations, nil
+		}
+		return nil, err
+	}
+	s.harness = h
+
+	err = s.run()
+	h.Close()
+	s.violations = append(s.violations, s.probe.close()...)
+	if err != nil {
+		return nil, err
+	}
+	s.checkResult("Stop")
+	if leaked := leakedGoroutines(before, packagePath(driver), config.Timeout); len(leaked) > 0 {
+		s.violate(goroutinesCheck, "%d goroutines leaked after Stop:\n\n%s", len(leaked), strings.Join(leaked, "\n\n"))
+	}
+	return s.violations, nil
+}
+
+// run runs the checks of the device service started by the harness
+func (s *suite) run() error {
+	if _, err := s.harness.Service().AddDeviceProfile(s.config.Profile); err != nil {
+		return fmt.Errorf("failed to add the Profile: %w", err)
+	}
+
+	if err := s.probe.ValidateDevice(s.device); err != nil {
+		s.violate("ValidateDevice", "ValidateDevice failed: %v", err)
+		return nil
+	}
+	_, err := s.harness.Service().AddDevice(s.device)
+	if s.checkResult("ValidateDevice") || s.checkResult("AddDevice") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to add the Device: %w", err)
+	}
+
+	s.checkReads()
+	s.checkWrites()
+
+	updated, err := s.harness.Service().GetDeviceByName(s.device.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get the Device: %w", err)
+	}
+	updated.Labels = append(slices.Clone(updated.Labels), "conformance")
+	if err = s.harness.Service().UpdateDevice(updated); !s.checkResult("UpdateDevice") && err != nil {
+		return fmt.Errorf("failed to update the Device: %w", err)
+	}
+
+	s.checkDiscovery()
+
+	if err = s.harness.Service().RemoveDeviceByName(s.device.Name); !s.checkResult("RemoveDevice") && err != nil {
+		return fmt.Errorf("failed to remove the Device: %w", err)
+	}
+	return nil
+}
+
+func (s *suite) violate(check string, format string, args ...any) {
+	s.violations = append(s.violations, Violation{Check: check, Err: fmt.Errorf(format, args...)})
+}
+
+// checkResult reports the error of the last call of the method of the ProtocolDriver, and returns whether it failed
+func (s *suite) checkResult(method string) bool {
+	if err := s.probe.result(method); err != nil {
+		s.violate(method, "%s failed: %v", method, err)
+		return true
+	}
+	return false
+}
+
+// computed returns whether the DeviceResource is computed by the SDK instead of read by the ProtocolDriver
+func computed(dr models.DeviceResource) bool {
+	_, virtual := dr.Attributes[sdkCommon.VirtualResourceAttribute]
+	_, bitField := dr.Attributes[sdkCommon.BitFieldWordAttribute]
+	return virtual || bitField
+}
+
+func (s *suite) checkReads() {
+	var reqs []sdkModels.CommandRequest
+	var resources []models.DeviceResource
+	for _, dr := range s.config.Profile.DeviceResources {
+		if !strings.Contains(dr.Properties.ReadWrite, common.ReadWrite_R) || computed(dr) {
+			continue
+		}
+		req := sdkModels.CommandRequest{DeviceResourceName: dr.Name, Attributes: dr.Attributes, Type: dr.Properties.ValueType}
+		s.checkRead("Read/"+dr.Name, []sdkModels.CommandRequest{req}, []models.DeviceResource{dr})
+		reqs = append(reqs, req)
+		resources = append(resources, dr)
+	}
+	if len(reqs) > 1 {
+		s.checkRead("Read", reqs, resources)
+	}
+}
+
+func (s *suite) checkRead(check string, reqs []sdkModels.CommandRequest, resources []models.DeviceResource) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.Timeout)
+	defer cancel()
+	res, err := s.probe.HandleReadCommandsWithContext(ctx, s.device.Name, s.device.Protocols, reqs)
+	if err != nil {
+		s.violate(check, "HandleReadCommands failed: %v", err)
+		return
+	}
+	if len(res) != len(reqs) {
+		s.violate(check, "HandleReadCommands returned %d CommandValues for %d DeviceResources", len(res), len(reqs))
+		return
+	}
+	for i, cv := range res {
+		if cv != nil && cv.DeviceResourceName != resources[i].Name {
+			s.violate(check, "HandleReadCommands returned the CommandValue of DeviceResource %s instead of %s",
+				cv.DeviceResourceName, resources[i].Name)
+			continue
+		}
+		if err = validateCommandValue(cv, resources[i]); err != nil {
+			s.violate(check, "HandleReadCommands returned %v", err)
+		}
+	}
+}
+
+// validateCommandValue validates the CommandValue of the DeviceResource
+func validateCommandValue(cv *sdkModels.CommandValue, dr models.DeviceResource) error {
+	if cv == nil {
+		return fmt.Errorf("a nil CommandValue for DeviceResource %s", dr.Name)
+	}
+	if cv.Type != dr.Properties.ValueType {
+		return fmt.Errorf("a CommandValue of value type %s instead of %s for DeviceResource %s", cv.Type, dr.Properties.ValueType, dr.Name)
+	}
+	if _, err := sdkModels.NewCommandValue(cv.DeviceResourceName, cv.Type, cv.Value); err != nil {
+		return fmt.Errorf("a value of Go type %T instead of value type %s for DeviceResource %s", cv.Value, cv.Type, dr.Name)
+	}
+	return nil
+}
+
+// checkWrites writes the writable DeviceResources by SET commands, so that the values are converted to
+// CommandValues by the SDK
+func (s *suite) checkWrites() {
+	for _, dr := range s.config.Profile.DeviceResources {
+		if !strings.Contains(dr.Properties.ReadWrite, common.ReadWrite_W) || computed(dr) {
+			continue
+		}
+		values := make(map[string]any)
+		if v, ok := s.config.WriteValues[dr.Name]; ok {
+			values[dr.Name] = v
+		} else if dr.Properties.DefaultValue == "" {
+			// there is no value to write to the write-only DeviceResources, and the Binary and ObjectArray values
+			// can't be written by SET commands
+			if !strings.Contains(dr.Properties.ReadWrite, common.ReadWrite_R) ||
+				dr.Properties.ValueType == common.ValueTypeBinary || dr.Properties.ValueType == common.ValueTypeObjectArray {
+				continue
+			}
+			event, err := s.harness.Get(s.device.Name, dr.Name, nil)
+			if err != nil || len(event.Readings) != 1 {
+				// the failed read is reported by the read checks
+				continue
+			}
+			if event.Readings[0].ObjectValue != nil {
+				values[dr.Name] = event.Readings[0].ObjectValue
+			} else {
+				values[dr.Name] = event.Readings[0].Value
+			}
+		}
+
+		s.probe.clearResult("HandleWriteCommands")
+		if err := s.harness.Set(s.device.Name, dr.Name, values, nil); err != nil {
+			if driverErr := s.probe.result("HandleWriteCommands"); driverErr != nil {
+				s.violate("Write/"+dr.Name, "HandleWriteCommands failed: %v", driverErr)
+			} else {
+				s.violate("Write/"+dr.Name, "SET command failed: %v", err)
+			}
+		}
+	}
+}
+
+func (s *suite) checkDiscovery() {
+	if !s.config.Discovery {
+		return
+	}
+	if err := s.probe.Discover(); err != nil {
+		s.violate("Discover", "Discover failed: %v", err)
+		return
+	}
+	devices := s.probe.discoveredDevices(s.config.Timeout)
+	if len(devices) == 0 {
+		s.violate("Discover", "no device sent to the DiscoveredDeviceChannel within %v", s.config.Timeout)
+	}
+	for _, d := range devices {
+		if d.Name == "" {
+			s.violate("Discover", "discovered device without name")
+		}
+		if len(d.Protocols) == 0 {
+			s.violate("Discover", "discovered device %s without protocols", d.Name)
+		}
+	}
+}
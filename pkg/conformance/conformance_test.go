@@ -0,0 +1,226 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/interfaces"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/simulator"
+)
+
+func testConfig(driver func() interfaces.ProtocolDriver) Config {
+	return Config{
+		NewDriver: driver,
+		Profile: models.DeviceProfile{
+			Name: "Simulated-Thermostat",
+			DeviceResources: []models.DeviceResource{
+				{
+					Name:       "Temperature",
+					Attributes: map[string]any{simulator.GeneratorAttribute: simulator.GeneratorSine, simulator.MinAttribute: 10, simulator.MaxAttribute: 30},
+					Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R},
+				},
+				{
+					Name:       "Setpoint",
+					Properties: models.ResourceProperties{ValueType: common.ValueTypeInt32, ReadWrite: common.ReadWrite_RW, DefaultValue: "18"},
+				},
+				{
+					Name:       "Mode",
+					Attributes: map[string]any{simulator.GeneratorAttribute: simulator.GeneratorSequence, simulator.ValuesAttribute: "heat,cool"},
+					Properties: models.ResourceProperties{ValueType: common.ValueTypeString, ReadWrite: common.ReadWrite_RW},
+				},
+				{
+					Name:       "Reset",
+					Properties: models.ResourceProperties{ValueType: common.ValueTypeBool, ReadWrite: common.ReadWrite_W},
+				},
+			},
+		},
+		Device: models.Device{
+			Name:      "Thermostat01",
+			Protocols: map[string]models.ProtocolProperties{simulator.Protocol: {simulator.PushIntervalProperty: "10ms"}},
+		},
+		DriverConfigs: map[string]string{simulator.DeviceCountConfig: "2"},
+		WriteValues:   map[string]any{"Reset": "true"},
+		Discovery:     true,
+		Timeout:       time.Second,
+	}
+}
+
+func TestRun(t *testing.T) {
+	Run(t, testConfig(func() interfaces.ProtocolDriver { return simulator.NewDriver() }))
+}
+
+// faultyDriver is a simulator ProtocolDriver which violates its contract
+type faultyDriver struct {
+	interfaces.ProtocolDriver
+	fault string
+	sdk   interfaces.DeviceServiceSDK
+	stop  chan struct{}
+}
+
+func (d *faultyDriver) Initialize(sdk interfaces.DeviceServiceSDK) error {
+	if d.fault == "Initialize error" {
+		return errors.New("failed")
+	}
+	d.sdk = sdk
+	return d.ProtocolDriver.Initialize(sdk)
+}
+
+func (d *faultyDriver) AddDevice(deviceName string, protocols map[string]models.ProtocolProperties, adminState models.AdminState) error {
+	if d.fault == "wrong AsyncValues" {
+		cv, err := sdkModels.NewCommandValue("Temperature", common.ValueTypeString, "20")
+		if err != nil {
+			return err
+		}
+		d.sdk.AsyncValuesChannel() <- &sdkModels.AsyncValues{DeviceName: deviceName, SourceName: "Temperature", CommandValues: []*sdkModels.CommandValue{cv}}
+	}
+	return d.ProtocolDriver.AddDevice(deviceName, protocols, adminState)
+}
+
+func (d *faultyDriver) Start() error {
+	if d.fault == "leaked goroutine" {
+		go func() { <-d.stop }()
+	}
+	return d.ProtocolDriver.Start()
+}
+
+func (d *faultyDriver) Stop(force bool) error {
+	if err := d.ProtocolDriver.Stop(force); err != nil || d.fault != "Stop error" {
+		return err
+	}
+	return errors.New("failed")
+}
+
+func (d *faultyDriver) HandleReadCommands(deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest) ([]*sdkModels.CommandValue, error) {
+	res, err := d.ProtocolDriver.HandleReadCommands(deviceName, protocols, reqs)
+	if err != nil {
+		return res, err
+	}
+	switch d.fault {
+	case "wrong value type":
+		for _, cv := range res {
+			if cv.DeviceResourceName == "Temperature" {
+				cv.Type = common.ValueTypeFloat64
+			}
+		}
+	case "wrong Go type":
+		for _, cv := range res {
+			if cv.DeviceResourceName == "Temperature" {
+				cv.Value = float64(20)
+			}
+		}
+	case "missing value":
+		res = res[:len(res)-1]
+	}
+	return res, nil
+}
+
+func (d *faultyDriver) HandleWriteCommands(deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest, params []*sdkModels.CommandValue) error {
+	if d.fault == "write error" && reqs[0].DeviceResourceName == "Setpoint" {
+		return errors.New("failed")
+	}
+	return d.ProtocolDriver.HandleWriteCommands(deviceName, protocols, reqs, params)
+}
+
+func (d *faultyDriver) UpdateDevice(deviceName string, protocols map[string]models.ProtocolProperties, adminState models.AdminState) error {
+	if d.fault == "UpdateDevice panic" {
+		panic("failed")
+	}
+	return d.ProtocolDriver.UpdateDevice(deviceName, protocols, adminState)
+}
+
+func (d *faultyDriver) Discover() error {
+	if d.fault == "no discovered device" {
+		return nil
+	}
+	return d.ProtocolDriver.Discover()
+}
+
+func TestCheck_Violations(t *testing.T) {
+	tests := []struct {
+		fault          string
+		expectedChecks []string
+	}{
+		{"none", nil},
+		{"Initialize error", []string{"Initialize"}},
+		{"Stop error", []string{"Stop"}},
+		{"leaked goroutine", []string{goroutinesCheck}},
+		{"wrong value type", []string{"Read/Temperature", "Read"}},
+		{"wrong Go type", []string{"Read/Temperature", "Read"}},
+		{"missing value", []string{"Read/Temperature", "Read/Setpoint", "Read/Mode", "Read"}},
+		{"wrong AsyncValues", []string{asyncValuesCheck}},
+		{"write error", []string{"Write/Setpoint"}},
+		{"UpdateDevice panic", []string{"UpdateDevice"}},
+		{"no discovered device", []string{"Discover"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fault, func(t *testing.T) {
+			stop := make(chan struct{})
+			defer close(stop)
+			config := testConfig(func() interfaces.ProtocolDriver {
+				return &faultyDriver{ProtocolDriver: simulator.NewDriver(), fault: tt.fault, stop: stop}
+			})
+			if tt.fault == "leaked goroutine" || tt.fault == "no discovered device" {
+				config.Timeout = 100 * time.Millisecond
+			}
+
+			violations, err := Check(config)
+			require.NoError(t, err)
+			var checks []string
+			for _, v := range violations {
+				if !slices.Contains(checks, v.Check) {
+					checks = append(checks, v.Check)
+				}
+			}
+			assert.ElementsMatch(t, tt.expectedChecks, checks, "unexpected violations %v", violations)
+		})
+	}
+}
+
+func TestCheck_InvalidConfig(t *testing.T) {
+	newDriver := func() interfaces.ProtocolDriver { return simulator.NewDriver() }
+	tests := []struct {
+		name   string
+		config func(config *Config)
+	}{
+		{"no driver", func(config *Config) { config.NewDriver = nil }},
+		{"no profile name", func(config *Config) { config.Profile.Name = "" }},
+		{"no device name", func(config *Config) { config.Device.Name = "" }},
+		{"unknown profile", func(config *Config) { config.Device.ProfileName = "Unknown" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := testConfig(newDriver)
+			tt.config(&config)
+			_, err := Check(config)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLeakedGoroutines(t *testing.T) {
+	before := goroutines()
+	stop := make(chan struct{})
+	go func() { <-stop }()
+	leaked := leakedGoroutines(before, packagePath(&faultyDriver{}), 10*time.Millisecond)
+	require.Len(t, leaked, 1)
+	assert.Contains(t, leaked[0], "TestLeakedGoroutines")
+	assert.Len(t, leakedGoroutines(before, "", 10*time.Millisecond), 1)
+	assert.Empty(t, leakedGoroutines(before, packagePath(simulator.NewDriver()), 10*time.Millisecond))
+
+	close(stop)
+	assert.Empty(t, leakedGoroutines(before, "", time.Second))
+}
@@ -0,0 +1,89 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"reflect"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+)
+
+// goroutines returns the stacks of the goroutines of the process by their ids
+func goroutines() map[string]string {
+	buf := make([]byte, 64*1024)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+
+	res := make(map[string]string)
+	for _, stack := range strings.Split(string(buf), "\n\n") {
+		// the header of a stack is e.g. "goroutine 18 [running]:"
+		header, _, _ := strings.Cut(stack, "\n")
+		if fields := strings.Fields(header); len(fields) > 1 && fields[0] == "goroutine" {
+			res[fields[1]] = stack
+		}
+	}
+	return res
+}
+
+// packagePath returns the import path of the package which declares the type of the value, empty if the type is
+// unnamed
+func packagePath(v any) string {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Pointer && t.Name() == "" {
+		t = t.Elem()
+	}
+	if t == nil {
+		return ""
+	}
+	return t.PkgPath()
+}
+
+// leakedGoroutines waits for the timeout until the goroutines started since the goroutines before end, and returns
+// the stacks of the ones which are still running. Only the goroutines whose stacks have frames of the package of the
+// pkgPath are returned, so that the goroutines of the other tests running in parallel aren't reported, unless the
+// pkgPath is empty.
+func leakedGoroutines(before map[string]string, pkgPath string, timeout time.Duration) []string {
+	deadline := time.Now().Add(timeout)
+	for {
+		var leaked []string
+		for id, stack := range goroutines() {
+			if _, ok := before[id]; !ok && hasPackageFrames(stack, pkgPath) {
+				leaked = append(leaked, stack)
+			}
+		}
+		if len(leaked) == 0 || time.Now().After(deadline) {
+			slices.Sort(leaked)
+			return leaked
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// hasPackageFrames returns whether the stack has a frame, including the one of the creator of the goroutine, of a
+// function of the package of the pkgPath, or true if the pkgPath is empty
+func hasPackageFrames(stack string, pkgPath string) bool {
+	if pkgPath == "" {
+		return true
+	}
+	for _, line := range strings.Split(stack, "\n") {
+		// the frames are e.g. "example.com/driver.(*Driver).Start.func1(...)" and
+		// "created by example.com/driver.(*Driver).Start in goroutine 7"
+		function := strings.TrimPrefix(line, "created by ")
+		if strings.HasPrefix(function, pkgPath+".") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,265 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/interfaces"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+// probe wraps the checked ProtocolDriver, records the results of its methods, and recovers their panics. The
+// ProtocolDriver is given a DeviceServiceSDK whose channels are the ones of the probe, so that the AsyncValues are
+// validated before they are sent to the device service, and the discovered devices are collected for the checks.
+type probe struct {
+	driver     interfaces.ProtocolDriver
+	ctxDriver  interfaces.ContextProtocolDriver
+	results    map[string]error
+	violations []Violation
+	discovered []sdkModels.DiscoveredDevice
+	notify     chan struct{}
+	done       chan struct{}
+	wg         sync.WaitGroup
+	mutex      sync.Mutex
+}
+
+// probeSDK is the DeviceServiceSDK given to the checked ProtocolDriver
+type probeSDK struct {
+	interfaces.DeviceServiceSDK
+	asyncValues       chan *sdkModels.AsyncValues
+	discoveredDevices chan []sdkModels.DiscoveredDevice
+}
+
+func (s *probeSDK) AsyncValuesChannel() chan *sdkModels.AsyncValues {
+	return s.asyncValues
+}
+
+func (s *probeSDK) DiscoveredDeviceChannel() chan []sdkModels.DiscoveredDevice {
+	return s.discoveredDevices
+}
+
+func newProbe(driver interfaces.ProtocolDriver) *probe {
+	ctxDriver, _ := driver.(interfaces.ContextProtocolDriver)
+	return &probe{
+		driver:    driver,
+		ctxDriver: ctxDriver,
+		results:   make(map[string]error),
+		notify:    make(chan struct{}, 1),
+		done:      make(chan struct{}),
+	}
+}
+
+// call calls a method of the ProtocolDriver and records its result, a panic is recovered as the error of the method
+func (p *probe) call(method string, f func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+		p.mutex.Lock()
+		p.results[method] = err
+		p.mutex.Unlock()
+	}()
+	return f()
+}
+
+// result returns the error of the last call of the method
+func (p *probe) result(method string) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.results[method]
+}
+
+func (p *probe) clearResult(method string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	delete(p.results, method)
+}
+
+func (p *probe) violate(check string, format string, args ...any) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.violations = append(p.violations, Violation{Check: check, Err: fmt.Errorf(format, args...)})
+}
+
+// close stops validating the AsyncValues and collecting the discovered devices, and returns the violations found
+func (p *probe) close() []Violation {
+	close(p.done)
+	p.wg.Wait()
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.violations
+}
+
+func (p *probe) Initialize(sdk interfaces.DeviceServiceSDK) error {
+	s := &probeSDK{
+		DeviceServiceSDK:  sdk,
+		asyncValues:       make(chan *sdkModels.AsyncValues, cap(sdk.AsyncValuesChannel())),
+		discoveredDevices: make(chan []sdkModels.DiscoveredDevice, cap(sdk.DiscoveredDeviceChannel())),
+	}
+	p.wg.Add(2)
+	go p.validateAsyncValues(s, sdk.AsyncValuesChannel())
+	go p.collectDiscoveredDevices(s)
+	return p.call("Initialize", func() error {
+		return p.driver.Initialize(s)
+	})
+}
+
+// validateAsyncValues validates the AsyncValues of the existing devices, and sends them to the device service
+func (p *probe) validateAsyncValues(sdk *probeSDK, asyncValues chan *sdkModels.AsyncValues) {
+	defer p.wg.Done()
+	for {
+		var values *sdkModels.AsyncValues
+		select {
+		case values = <-sdk.asyncValues:
+		case <-p.done:
+			return
+		}
+
+		if values == nil {
+			p.violate(asyncValuesCheck, "nil AsyncValues sent to the AsyncValuesChannel")
+			continue
+		}
+		if sdk.DeviceExistsForName(values.DeviceName) {
+			for _, cv := range values.CommandValues {
+				if cv == nil {
+					p.violate(asyncValuesCheck, "AsyncValues of device %s contain a nil CommandValue", values.DeviceName)
+					continue
+				}
+				dr, ok := sdk.DeviceResource(values.DeviceName, cv.DeviceResourceName)
+				if !ok {
+					p.violate(asyncValuesCheck, "AsyncValues of device %s contain a CommandValue of unknown DeviceResource %s",
+						values.DeviceName, cv.DeviceResourceName)
+					continue
+				}
+				if err := validateCommandValue(cv, dr); err != nil {
+					p.violate(asyncValuesCheck, "AsyncValues of device %s contain %v", values.DeviceName, err)
+				}
+			}
+		}
+
+		select {
+		case asyncValues <- values:
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *probe) collectDiscoveredDevices(sdk *probeSDK) {
+	defer p.wg.Done()
+	for {
+		select {
+		case devices := <-sdk.discoveredDevices:
+			p.mutex.Lock()
+			p.discovered = append(p.discovered, devices...)
+			p.mutex.Unlock()
+			select {
+			case p.notify <- struct{}{}:
+			default:
+			}
+		case <-p.done:
+			return
+		}
+	}
+}
+
+// discoveredDevices waits for the timeout until devices are discovered, and returns the discovered devices
+func (p *probe) discoveredDevices(timeout time.Duration) []sdkModels.DiscoveredDevice {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		p.mutex.Lock()
+		devices := p.discovered
+		p.mutex.Unlock()
+		if len(devices) > 0 {
+			return devices
+		}
+		select {
+		case <-p.notify:
+		case <-timer.C:
+			return nil
+		}
+	}
+}
+
+func (p *probe) HandleReadCommands(deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest) (res []*sdkModels.CommandValue, err error) {
+	err = p.call("HandleReadCommands", func() error {
+		res, err = p.driver.HandleReadCommands(deviceName, protocols, reqs)
+		return err
+	})
+	return res, err
+}
+
+func (p *probe) HandleWriteCommands(deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest, params []*sdkModels.CommandValue) error {
+	return p.call("HandleWriteCommands", func() error {
+		return p.driver.HandleWriteCommands(deviceName, protocols, reqs, params)
+	})
+}
+
+func (p *probe) HandleReadCommandsWithContext(ctx context.Context, deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest) (res []*sdkModels.CommandValue, err error) {
+	if p.ctxDriver == nil {
+		return p.HandleReadCommands(deviceName, protocols, reqs)
+	}
+	err = p.call("HandleReadCommands", func() error {
+		res, err = p.ctxDriver.HandleReadCommandsWithContext(ctx, deviceName, protocols, reqs)
+		return err
+	})
+	return res, err
+}
+
+func (p *probe) HandleWriteCommandsWithContext(ctx context.Context, deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest, params []*sdkModels.CommandValue) error {
+	if p.ctxDriver == nil {
+		return p.HandleWriteCommands(deviceName, protocols, reqs, params)
+	}
+	return p.call("HandleWriteCommands", func() error {
+		return p.ctxDriver.HandleWriteCommandsWithContext(ctx, deviceName, protocols, reqs, params)
+	})
+}
+
+func (p *probe) Start() error {
+	return p.call("Start", p.driver.Start)
+}
+
+func (p *probe) Stop(force bool) error {
+	return p.call("Stop", func() error {
+		return p.driver.Stop(force)
+	})
+}
+
+func (p *probe) AddDevice(deviceName string, protocols map[string]models.ProtocolProperties, adminState models.AdminState) error {
+	return p.call("AddDevice", func() error {
+		return p.driver.AddDevice(deviceName, protocols, adminState)
+	})
+}
+
+func (p *probe) UpdateDevice(deviceName string, protocols map[string]models.ProtocolProperties, adminState models.AdminState) error {
+	return p.call("UpdateDevice", func() error {
+		return p.driver.UpdateDevice(deviceName, protocols, adminState)
+	})
+}
+
+func (p *probe) RemoveDevice(deviceName string, protocols map[string]models.ProtocolProperties) error {
+	return p.call("RemoveDevice", func() error {
+		return p.driver.RemoveDevice(deviceName, protocols)
+	})
+}
+
+func (p *probe) Discover() error {
+	return p.call("Discover", p.driver.Discover)
+}
+
+func (p *probe) ValidateDevice(device models.Device) error {
+	return p.call("ValidateDevice", func() error {
+		return p.driver.ValidateDevice(device)
+	})
+}
@@ -0,0 +1,303 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package faultinjection injects faults into the read and write commands of a ProtocolDriver on demand, i.e. errors,
+// latency, hangs, malformed CommandValues and wrong DeviceResource names, to validate how a device service handles
+// misbehaving devices, e.g. the AllowedFails and DeviceDownTimeout settings.
+package faultinjection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/interfaces"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+// WrongResourceNamePrefix prefixes the DeviceResource names of the CommandValues whose names are made wrong
+const WrongResourceNamePrefix = "wrong-"
+
+// ErrInjected is the error of the commands failed by the Injector
+var ErrInjected = errors.New("injected fault")
+
+// Settings are the faults injected into the commands. The rates are the probabilities between 0 and 1 that a
+// command or a CommandValue is faulty. No fault is injected with the zero value.
+type Settings struct {
+	// Devices limits the faults to the commands of the devices, all the devices if it's empty
+	Devices []string `json:"devices,omitempty"`
+	// Resources limits the faults to the commands of the DeviceResources, all the DeviceResources if it's empty
+	Resources []string `json:"resources,omitempty"`
+	// ErrorRate is the probability that a command fails
+	ErrorRate float64 `json:"errorRate,omitempty"`
+	// Latency is the duration added to the commands, e.g. "500ms"
+	Latency string `json:"latency,omitempty"`
+	// HangRate is the probability that a command hangs until its deadline, the duration of HangDuration if it's
+	// not empty, or the Injector is closed
+	HangRate     float64 `json:"hangRate,omitempty"`
+	HangDuration string  `json:"hangDuration,omitempty"`
+	// MalformedValueRate is the probability that a read CommandValue has a value not matching its value type
+	MalformedValueRate float64 `json:"malformedValueRate,omitempty"`
+	// WrongResourceNameRate is the probability that the DeviceResource name of a read CommandValue is wrong
+	WrongResourceNameRate float64 `json:"wrongResourceNameRate,omitempty"`
+}
+
+// faults are the parsed Settings
+type faults struct {
+	Settings
+	latency      time.Duration
+	hangDuration time.Duration
+}
+
+// Validate validates the rates and the durations of the Settings
+func (s Settings) Validate() error {
+	_, err := s.parse()
+	return err
+}
+
+func (s Settings) parse() (faults, error) {
+	f := faults{Settings: s}
+	rates := map[string]float64{
+		"ErrorRate":             s.ErrorRate,
+		"HangRate":              s.HangRate,
+		"MalformedValueRate":    s.MalformedValueRate,
+		"WrongResourceNameRate": s.WrongResourceNameRate,
+	}
+	for name, rate := range rates {
+		if rate < 0 || rate > 1 {
+			return f, fmt.Errorf("invalid %s %v, expected a probability between 0 and 1", name, rate)
+		}
+	}
+	durations := []struct {
+		name   string
+		value  string
+		target *time.Duration
+	}{
+		{"Latency", s.Latency, &f.latency},
+		{"HangDuration", s.HangDuration, &f.hangDuration},
+	}
+	for _, d := range durations {
+		if d.value == "" {
+			continue
+		}
+		var err error
+		if *d.target, err = time.ParseDuration(d.value); err != nil || *d.target < 0 {
+			return f, fmt.Errorf("invalid %s '%s', expected a non-negative duration", d.name, d.value)
+		}
+	}
+	return f, nil
+}
+
+// Injector wraps a ProtocolDriver, and injects the faults of its Settings into the read and write commands. The
+// other methods are passed through to the ProtocolDriver. The ContextProtocolDriver methods are passed through to
+// the ContextProtocolDriver if the ProtocolDriver implements it, to the ProtocolDriver methods otherwise. The faults
+// of the ContextProtocolDriver methods are injected within the context in both cases, so that the injected latency
+// and hangs end at the deadline of the command, while the ProtocolDriver methods themselves aren't bounded by it.
+type Injector struct {
+	interfaces.ProtocolDriver
+	ctxDriver interfaces.ContextProtocolDriver
+	lc        logger.LoggingClient
+	settings  func() Settings
+	override  *Settings
+	done      chan struct{}
+	closeOnce sync.Once
+	mutex     sync.Mutex
+}
+
+// NewInjector returns an Injector of the ProtocolDriver. The faults are the ones of the settings function, called
+// for each command, unless they are overridden. No fault is injected if the function is nil.
+func NewInjector(driver interfaces.ProtocolDriver, settings func() Settings, lc logger.LoggingClient) *Injector {
+	ctxDriver, _ := driver.(interfaces.ContextProtocolDriver)
+	return &Injector{ProtocolDriver: driver, ctxDriver: ctxDriver, lc: lc, settings: settings, done: make(chan struct{})}
+}
+
+// Override overrides the Settings of the settings function, until it's called with nil
+func (i *Injector) Override(settings *Settings) error {
+	if settings != nil {
+		if err := settings.Validate(); err != nil {
+			return err
+		}
+	}
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.override = settings
+	return nil
+}
+
+// Settings returns the current Settings, and whether they are overridden
+func (i *Injector) Settings() (Settings, bool) {
+	i.mutex.Lock()
+	override := i.override
+	i.mutex.Unlock()
+	if override != nil {
+		return *override, true
+	}
+	if i.settings == nil {
+		return Settings{}, false
+	}
+	return i.settings(), false
+}
+
+// faults returns the faults of the command, ok is false if none are injected
+func (i *Injector) faults(deviceName string, reqs []sdkModels.CommandRequest) (f faults, ok bool) {
+	settings, _ := i.Settings()
+	f, err := settings.parse()
+	if err != nil {
+		i.lc.Errorf("no fault injected, the fault injection settings are invalid: %v", err)
+		return f, false
+	}
+	if len(f.Devices) > 0 && !slices.Contains(f.Devices, deviceName) {
+		return f, false
+	}
+	if len(f.Resources) == 0 {
+		return f, true
+	}
+	for _, req := range reqs {
+		if slices.Contains(f.Resources, req.DeviceResourceName) {
+			return f, true
+		}
+	}
+	return f, false
+}
+
+// inject waits for the latency and the hang, and returns the injected error
+func (i *Injector) inject(ctx context.Context, f faults, deviceName string) error {
+	if f.latency > 0 {
+		if err := i.wait(ctx, f.latency); err != nil {
+			return err
+		}
+	}
+	if rand.Float64() < f.HangRate {
+		i.lc.Debugf("hanging the command of device %s", deviceName)
+		if err := i.wait(ctx, f.hangDuration); err != nil {
+			return err
+		}
+	}
+	if rand.Float64() < f.ErrorRate {
+		return fmt.Errorf("%w in the command of device %s", ErrInjected, deviceName)
+	}
+	return nil
+}
+
+// wait waits for the duration, or forever if it's zero, until the context is done or the Injector is closed
+func (i *Injector) wait(ctx context.Context, d time.Duration) error {
+	var timeout <-chan time.Time
+	if d > 0 {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case <-timeout:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-i.done:
+		return fmt.Errorf("%w, the fault injection is closed", ErrInjected)
+	}
+}
+
+// corrupt returns the read CommandValues with the malformed values and the wrong DeviceResource names
+func (i *Injector) corrupt(f faults, values []*sdkModels.CommandValue) []*sdkModels.CommandValue {
+	if f.MalformedValueRate == 0 && f.WrongResourceNameRate == 0 {
+		return values
+	}
+	res := make([]*sdkModels.CommandValue, len(values))
+	for n, cv := range values {
+		res[n] = cv
+		if cv == nil || (len(f.Resources) > 0 && !slices.Contains(f.Resources, cv.DeviceResourceName)) {
+			continue
+		}
+		corrupted := *cv
+		if rand.Float64() < f.MalformedValueRate {
+			if cv.Type == common.ValueTypeString {
+				corrupted.Value = 0
+			} else {
+				corrupted.Value = "malformed"
+			}
+		}
+		if rand.Float64() < f.WrongResourceNameRate {
+			corrupted.DeviceResourceName = WrongResourceNamePrefix + cv.DeviceResourceName
+		}
+		res[n] = &corrupted
+	}
+	return res
+}
+
+func (i *Injector) HandleReadCommands(deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest) ([]*sdkModels.CommandValue, error) {
+	f, ok := i.faults(deviceName, reqs)
+	if !ok {
+		return i.ProtocolDriver.HandleReadCommands(deviceName, protocols, reqs)
+	}
+	if err := i.inject(context.Background(), f, deviceName); err != nil {
+		return nil, err
+	}
+	res, err := i.ProtocolDriver.HandleReadCommands(deviceName, protocols, reqs)
+	if err != nil {
+		return res, err
+	}
+	return i.corrupt(f, res), nil
+}
+
+func (i *Injector) HandleWriteCommands(deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest, params []*sdkModels.CommandValue) error {
+	if f, ok := i.faults(deviceName, reqs); ok {
+		if err := i.inject(context.Background(), f, deviceName); err != nil {
+			return err
+		}
+	}
+	return i.ProtocolDriver.HandleWriteCommands(deviceName, protocols, reqs, params)
+}
+
+func (i *Injector) HandleReadCommandsWithContext(ctx context.Context, deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest) ([]*sdkModels.CommandValue, error) {
+	f, ok := i.faults(deviceName, reqs)
+	if ok {
+		if err := i.inject(ctx, f, deviceName); err != nil {
+			return nil, err
+		}
+	}
+	var res []*sdkModels.CommandValue
+	var err error
+	if i.ctxDriver == nil {
+		res, err = i.ProtocolDriver.HandleReadCommands(deviceName, protocols, reqs)
+	} else {
+		res, err = i.ctxDriver.HandleReadCommandsWithContext(ctx, deviceName, protocols, reqs)
+	}
+	if !ok || err != nil {
+		return res, err
+	}
+	return i.corrupt(f, res), nil
+}
+
+func (i *Injector) HandleWriteCommandsWithContext(ctx context.Context, deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest, params []*sdkModels.CommandValue) error {
+	if f, ok := i.faults(deviceName, reqs); ok {
+		if err := i.inject(ctx, f, deviceName); err != nil {
+			return err
+		}
+	}
+	if i.ctxDriver == nil {
+		return i.ProtocolDriver.HandleWriteCommands(deviceName, protocols, reqs, params)
+	}
+	return i.ctxDriver.HandleWriteCommandsWithContext(ctx, deviceName, protocols, reqs, params)
+}
+
+// Close releases the hanging commands, and stops hanging the commands
+func (i *Injector) Close() {
+	i.closeOnce.Do(func() { close(i.done) })
+}
+
+// Stop closes the Injector, and stops the ProtocolDriver
+func (i *Injector) Stop(force bool) error {
+	i.Close()
+	return i.ProtocolDriver.Stop(force)
+}
@@ -0,0 +1,153 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package faultinjection
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/interfaces/mocks"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+const testDevice = "testDevice"
+
+func newTestInjector(t *testing.T, settings Settings) (*Injector, *mocks.ProtocolDriver) {
+	driver := mocks.NewProtocolDriver(t)
+	temperature, err := sdkModels.NewCommandValue("temperature", common.ValueTypeFloat32, float32(20.5))
+	require.NoError(t, err)
+	name, err := sdkModels.NewCommandValue("name", common.ValueTypeString, "thermostat")
+	require.NoError(t, err)
+	driver.On("HandleReadCommands", mock.Anything, mock.Anything, mock.Anything).
+		Return([]*sdkModels.CommandValue{temperature, name}, nil).Maybe()
+	driver.On("HandleWriteCommands", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	return NewInjector(driver, func() Settings { return settings }, logger.NewMockClient()), driver
+}
+
+func reqs(names ...string) []sdkModels.CommandRequest {
+	res := make([]sdkModels.CommandRequest, len(names))
+	for i, name := range names {
+		res[i] = sdkModels.CommandRequest{DeviceResourceName: name}
+	}
+	return res
+}
+
+func TestInjector_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		settings    Settings
+		deviceName  string
+		resources   []string
+		expectFault bool
+	}{
+		{"no fault", Settings{}, testDevice, []string{"temperature"}, false},
+		{"all commands", Settings{ErrorRate: 1}, testDevice, []string{"temperature"}, true},
+		{"device", Settings{ErrorRate: 1, Devices: []string{testDevice}}, testDevice, []string{"temperature"}, true},
+		{"other device", Settings{ErrorRate: 1, Devices: []string{"other"}}, testDevice, []string{"temperature"}, false},
+		{"resource", Settings{ErrorRate: 1, Resources: []string{"name"}}, testDevice, []string{"temperature", "name"}, true},
+		{"other resource", Settings{ErrorRate: 1, Resources: []string{"name"}}, testDevice, []string{"temperature"}, false},
+		{"invalid settings", Settings{ErrorRate: 1, Latency: "soon"}, testDevice, []string{"temperature"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			injector, _ := newTestInjector(t, tt.settings)
+
+			_, readErr := injector.HandleReadCommands(tt.deviceName, nil, reqs(tt.resources...))
+			writeErr := injector.HandleWriteCommands(tt.deviceName, nil, reqs(tt.resources...), nil)
+			if tt.expectFault {
+				assert.ErrorIs(t, readErr, ErrInjected)
+				assert.ErrorIs(t, writeErr, ErrInjected)
+			} else {
+				assert.NoError(t, readErr)
+				assert.NoError(t, writeErr)
+			}
+		})
+	}
+}
+
+func TestInjector_CorruptValues(t *testing.T) {
+	injector, _ := newTestInjector(t, Settings{MalformedValueRate: 1})
+	res, err := injector.HandleReadCommands(testDevice, nil, reqs("temperature", "name"))
+	require.NoError(t, err)
+	assert.Equal(t, "malformed", res[0].Value)
+	assert.Equal(t, 0, res[1].Value, "expect a malformed string value not to be a string")
+
+	injector, driver := newTestInjector(t, Settings{WrongResourceNameRate: 1, Resources: []string{"name"}})
+	res, err = injector.HandleReadCommands(testDevice, nil, reqs("temperature", "name"))
+	require.NoError(t, err)
+	assert.Equal(t, "temperature", res[0].DeviceResourceName)
+	assert.Equal(t, WrongResourceNamePrefix+"name", res[1].DeviceResourceName)
+	original, _ := driver.HandleReadCommands(testDevice, nil, nil)
+	assert.Equal(t, "name", original[1].DeviceResourceName, "expect the values of the driver not to be modified")
+}
+
+func TestInjector_LatencyAndHang(t *testing.T) {
+	injector, _ := newTestInjector(t, Settings{Latency: "20ms"})
+	start := time.Now()
+	_, err := injector.HandleReadCommands(testDevice, nil, reqs("temperature"))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+
+	injector, _ = newTestInjector(t, Settings{HangRate: 1, HangDuration: "20ms"})
+	start = time.Now()
+	_, err = injector.HandleReadCommands(testDevice, nil, reqs("temperature"))
+	require.NoError(t, err, "expect the command to resume after the hang")
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+
+	// the hang ends at the deadline of the command, even if the ProtocolDriver isn't a ContextProtocolDriver
+	injector, driver := newTestInjector(t, Settings{HangRate: 1})
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = injector.HandleReadCommandsWithContext(ctx, testDevice, nil, reqs("temperature"))
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "expect the hang to last until the deadline")
+	err = injector.HandleWriteCommandsWithContext(ctx, testDevice, nil, reqs("temperature"), nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "expect the hang to last until the deadline")
+	driver.AssertNotCalled(t, "HandleReadCommands", mock.Anything, mock.Anything, mock.Anything)
+	driver.AssertNotCalled(t, "HandleWriteCommands", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := injector.HandleReadCommands(testDevice, nil, reqs("temperature"))
+		done <- err
+	}()
+	injector.Close()
+	select {
+	case err = <-done:
+		assert.ErrorIs(t, err, ErrInjected)
+	case <-time.After(time.Second):
+		require.Fail(t, "expect the hanging command to be released by Close")
+	}
+}
+
+func TestInjector_Override(t *testing.T) {
+	injector, _ := newTestInjector(t, Settings{Latency: "1ms"})
+
+	settings, overridden := injector.Settings()
+	assert.False(t, overridden)
+	assert.Equal(t, "1ms", settings.Latency)
+
+	assert.Error(t, injector.Override(&Settings{HangDuration: "-1s"}))
+	require.NoError(t, injector.Override(&Settings{ErrorRate: 1}))
+	settings, overridden = injector.Settings()
+	assert.True(t, overridden)
+	assert.Equal(t, 1.0, settings.ErrorRate)
+	_, err := injector.HandleReadCommands(testDevice, nil, reqs("temperature"))
+	assert.ErrorIs(t, err, ErrInjected)
+
+	require.NoError(t, injector.Override(nil))
+	_, overridden = injector.Settings()
+	assert.False(t, overridden)
+	_, err = injector.HandleReadCommands(testDevice, nil, reqs("temperature"))
+	assert.NoError(t, err)
+}
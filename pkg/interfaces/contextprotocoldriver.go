@@ -0,0 +1,31 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+// ContextProtocolDriver is a low-level device-specific interface implemented
+// by device services whose read and write operations honor a request context.
+// When a ProtocolDriver also implements this interface, the SDK invokes these
+// methods instead of HandleReadCommands and HandleWriteCommands, and the given
+// context is cancelled once the caller goes away or the command deadline expires.
+type ContextProtocolDriver interface {
+	// HandleReadCommandsWithContext passes a slice of CommandRequest struct each representing
+	// a ResourceOperation for a specific device resource.
+	HandleReadCommandsWithContext(ctx context.Context, deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest) ([]*sdkModels.CommandValue, error)
+
+	// HandleWriteCommandsWithContext passes a slice of CommandRequest struct each representing
+	// a ResourceOperation for a specific device resource, and params provide parameters for the
+	// individual command.
+	HandleWriteCommandsWithContext(ctx context.Context, deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest, params []*sdkModels.CommandValue) error
+}
@@ -1,10 +1,14 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
 package interfaces
 
+import (
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
 type AutoEventManager interface {
 	// StartAutoEvents starts all the AutoEvents of the device service
 	StartAutoEvents()
@@ -12,4 +16,14 @@ type AutoEventManager interface {
 	RestartForDevice(name string)
 	// StopForDevice stops all the AutoEvents of the specific device
 	StopForDevice(name string)
+	// AutoEventStatuses returns the runtime status of the AutoEvents of the specific device,
+	// or of all the devices if the name is empty
+	AutoEventStatuses(deviceName string) []sdkModels.AutoEventStatus
+	// PauseAutoEvent stops firing the AutoEvent of the specific device and source until it is resumed.
+	// The pause is kept in memory only, so Core Metadata is not updated.
+	PauseAutoEvent(deviceName string, sourceName string) error
+	// ResumeAutoEvent resumes the paused AutoEvent of the specific device and source
+	ResumeAutoEvent(deviceName string, sourceName string) error
+	// TriggerAutoEvent fires the AutoEvent of the specific device and source immediately, regardless of its schedule
+	TriggerAutoEvent(deviceName string, sourceName string) error
 }
@@ -2,18 +2,65 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	models "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // AutoEventManager is an autogenerated mock type for the AutoEventManager type
 type AutoEventManager struct {
 	mock.Mock
 }
 
+// AutoEventStatuses provides a mock function with given fields: deviceName
+func (_m *AutoEventManager) AutoEventStatuses(deviceName string) []models.AutoEventStatus {
+	ret := _m.Called(deviceName)
+
+	var r0 []models.AutoEventStatus
+	if rf, ok := ret.Get(0).(func(string) []models.AutoEventStatus); ok {
+		r0 = rf(deviceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.AutoEventStatus)
+		}
+	}
+
+	return r0
+}
+
+// PauseAutoEvent provides a mock function with given fields: deviceName, sourceName
+func (_m *AutoEventManager) PauseAutoEvent(deviceName string, sourceName string) error {
+	ret := _m.Called(deviceName, sourceName)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(deviceName, sourceName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RestartForDevice provides a mock function with given fields: name
 func (_m *AutoEventManager) RestartForDevice(name string) {
 	_m.Called(name)
 }
 
+// ResumeAutoEvent provides a mock function with given fields: deviceName, sourceName
+func (_m *AutoEventManager) ResumeAutoEvent(deviceName string, sourceName string) error {
+	ret := _m.Called(deviceName, sourceName)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(deviceName, sourceName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // StartAutoEvents provides a mock function with given fields:
 func (_m *AutoEventManager) StartAutoEvents() {
 	_m.Called()
@@ -24,6 +71,20 @@ func (_m *AutoEventManager) StopForDevice(name string) {
 	_m.Called(name)
 }
 
+// TriggerAutoEvent provides a mock function with given fields: deviceName, sourceName
+func (_m *AutoEventManager) TriggerAutoEvent(deviceName string, sourceName string) error {
+	ret := _m.Called(deviceName, sourceName)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(deviceName, sourceName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewAutoEventManager interface {
 	mock.TestingT
 	Cleanup(func())
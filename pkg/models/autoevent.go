@@ -0,0 +1,31 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// AutoEventStatus is the runtime status and statistics of an AutoEvent of a device.
+// The timestamps are in nanoseconds since the epoch, and omitted when not applicable.
+type AutoEventStatus struct {
+	DeviceName string `json:"deviceName"`
+	SourceName string `json:"sourceName"`
+	Interval   string `json:"interval"`
+	// Schedule is the wall-clock schedule replacing the Interval, from the ds-autoevents property of the device
+	Schedule  string `json:"schedule,omitempty"`
+	OnChange  bool   `json:"onChange"`
+	Running   bool   `json:"running"`
+	Paused    bool   `json:"paused"`
+	LastFired int64  `json:"lastFired,omitempty"`
+	NextFire  int64  `json:"nextFire,omitempty"`
+	LastError string `json:"lastError,omitempty"`
+	// LastErrorTime is when the last failed read happened
+	LastErrorTime       int64 `json:"lastErrorTime,omitempty"`
+	ReadCount           int64 `json:"readCount"`
+	FailureCount        int64 `json:"failureCount"`
+	ConsecutiveFailures int64 `json:"consecutiveFailures"`
+	// SuppressedCount is the number of events not sent because the readings did not change
+	SuppressedCount int64 `json:"suppressedCount"`
+	EventCount      int64 `json:"eventCount"`
+}
@@ -0,0 +1,426 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/spf13/cast"
+)
+
+// DeviceResource attributes of the Codec of the raw value of a DeviceResource
+const (
+	// ByteOrderAttribute is ByteOrderBigEndian (default) or ByteOrderLittleEndian
+	ByteOrderAttribute = "ds-byteorder"
+	// WordSwapAttribute swaps the 16-bit words of the 32-bit and 64-bit values
+	WordSwapAttribute = "ds-wordswap"
+	// StringEncodingAttribute is StringEncodingUTF8 (default), StringEncodingASCII or StringEncodingUTF16
+	StringEncodingAttribute = "ds-stringencoding"
+)
+
+// ByteOrder is the order of the bytes of a numeric value, or of a UTF-16 code unit
+type ByteOrder string
+
+const (
+	ByteOrderBigEndian    ByteOrder = "BigEndian"
+	ByteOrderLittleEndian ByteOrder = "LittleEndian"
+)
+
+// StringEncoding is the character encoding of a string value
+type StringEncoding string
+
+const (
+	StringEncodingUTF8  StringEncoding = "UTF8"
+	StringEncodingASCII StringEncoding = "ASCII"
+	// StringEncodingUTF16 is encoded in the ByteOrder of the Codec
+	StringEncodingUTF16 StringEncoding = "UTF16"
+)
+
+// Codec converts the values of the EdgeX value types from and to the raw bytes of a device, like a buffer of
+// registers. The zero Codec is big-endian without word swap and encodes the strings in UTF-8.
+//
+// The numeric values take their size in bytes, and the Bool values one byte, which is true if not zero. The arrays
+// are the concatenation of their elements. The strings are decoded up to the first NUL character, and the elements
+// of a string array are separated by NUL characters. The Binary values are the raw bytes, and the Object values
+// are encoded in JSON.
+type Codec struct {
+	ByteOrder ByteOrder
+	// WordSwap swaps the 16-bit words of the 32-bit and 64-bit values, e.g. the big-endian ABCD bytes of a 32-bit
+	// value are ordered CDAB, as by the devices which store the low word in the first of two 16-bit registers
+	WordSwap       bool
+	StringEncoding StringEncoding
+}
+
+// NewCodec returns the Codec defined by the ds-byteorder, ds-wordswap and ds-stringencoding attributes of a
+// DeviceResource, with the defaults of the zero Codec.
+func NewCodec(attributes map[string]any) (Codec, error) {
+	var c Codec
+	if v, ok := attributes[ByteOrderAttribute]; ok {
+		switch order := fmt.Sprint(v); {
+		case strings.EqualFold(order, string(ByteOrderBigEndian)):
+			c.ByteOrder = ByteOrderBigEndian
+		case strings.EqualFold(order, string(ByteOrderLittleEndian)):
+			c.ByteOrder = ByteOrderLittleEndian
+		default:
+			errMsg := fmt.Sprintf("invalid %s '%s', expected %s or %s", ByteOrderAttribute, order, ByteOrderBigEndian, ByteOrderLittleEndian)
+			return Codec{}, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+		}
+	}
+	if v, ok := attributes[WordSwapAttribute]; ok {
+		swap, err := cast.ToBoolE(v)
+		if err != nil {
+			return Codec{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid %s", WordSwapAttribute), err)
+		}
+		c.WordSwap = swap
+	}
+	if v, ok := attributes[StringEncodingAttribute]; ok {
+		encoding := strings.ReplaceAll(fmt.Sprint(v), "-", "")
+		switch {
+		case strings.EqualFold(encoding, string(StringEncodingUTF8)):
+			c.StringEncoding = StringEncodingUTF8
+		case strings.EqualFold(encoding, string(StringEncodingASCII)):
+			c.StringEncoding = StringEncodingASCII
+		case strings.EqualFold(encoding, string(StringEncodingUTF16)):
+			c.StringEncoding = StringEncodingUTF16
+		default:
+			errMsg := fmt.Sprintf("invalid %s '%v', expected %s, %s or %s", StringEncodingAttribute, v,
+				StringEncodingUTF8, StringEncodingASCII, StringEncodingUTF16)
+			return Codec{}, errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+		}
+	}
+	return c, nil
+}
+
+// NewCommandValueFromBytes creates a CommandValue of the value type from the raw bytes, decoded with the Codec
+// defined by the attributes of the DeviceResource.
+func NewCommandValueFromBytes(deviceResourceName string, valueType string, data []byte, attributes map[string]any) (*CommandValue, error) {
+	codec, err := NewCodec(attributes)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	value, err := codec.Decode(valueType, data)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to decode the value of DeviceResource %s", deviceResourceName)
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), errMsg, err)
+	}
+	return NewCommandValue(deviceResourceName, valueType, value)
+}
+
+// Decode returns the value of the value type decoded from the raw bytes
+func (c Codec) Decode(valueType string, data []byte) (any, error) {
+	switch valueType {
+	case common.ValueTypeBool:
+		if err := checkLength(valueType, data, 1, false); err != nil {
+			return nil, err
+		}
+		return data[0] != 0, nil
+	case common.ValueTypeBoolArray:
+		values := make([]bool, len(data))
+		for i, b := range data {
+			values[i] = b != 0
+		}
+		return values, nil
+	case common.ValueTypeString:
+		s, err := c.decodeString(data)
+		if err != nil {
+			return nil, err
+		}
+		s, _, _ = strings.Cut(s, "\x00")
+		return s, nil
+	case common.ValueTypeStringArray:
+		s, err := c.decodeString(data)
+		if err != nil {
+			return nil, err
+		}
+		values := strings.Split(strings.TrimRight(s, "\x00"), "\x00")
+		if len(values) == 1 && values[0] == "" {
+			values = []string{}
+		}
+		return values, nil
+	case common.ValueTypeUint8:
+		return decodeScalar(c, valueType, data, 1, func(u uint64) uint8 { return uint8(u) })
+	case common.ValueTypeUint16:
+		return decodeScalar(c, valueType, data, 2, func(u uint64) uint16 { return uint16(u) })
+	case common.ValueTypeUint32:
+		return decodeScalar(c, valueType, data, 4, func(u uint64) uint32 { return uint32(u) })
+	case common.ValueTypeUint64:
+		return decodeScalar(c, valueType, data, 8, func(u uint64) uint64 { return u })
+	case common.ValueTypeInt8:
+		return decodeScalar(c, valueType, data, 1, func(u uint64) int8 { return int8(u) })
+	case common.ValueTypeInt16:
+		return decodeScalar(c, valueType, data, 2, func(u uint64) int16 { return int16(u) })
+	case common.ValueTypeInt32:
+		return decodeScalar(c, valueType, data, 4, func(u uint64) int32 { return int32(u) })
+	case common.ValueTypeInt64:
+		return decodeScalar(c, valueType, data, 8, func(u uint64) int64 { return int64(u) })
+	case common.ValueTypeFloat32:
+		return decodeScalar(c, valueType, data, 4, func(u uint64) float32 { return math.Float32frombits(uint32(u)) })
+	case common.ValueTypeFloat64:
+		return decodeScalar(c, valueType, data, 8, math.Float64frombits)
+	case common.ValueTypeUint8Array:
+		return decodeArray(c, valueType, data, 1, func(u uint64) uint8 { return uint8(u) })
+	case common.ValueTypeUint16Array:
+		return decodeArray(c, valueType, data, 2, func(u uint64) uint16 { return uint16(u) })
+	case common.ValueTypeUint32Array:
+		return decodeArray(c, valueType, data, 4, func(u uint64) uint32 { return uint32(u) })
+	case common.ValueTypeUint64Array:
+		return decodeArray(c, valueType, data, 8, func(u uint64) uint64 { return u })
+	case common.ValueTypeInt8Array:
+		return decodeArray(c, valueType, data, 1, func(u uint64) int8 { return int8(u) })
+	case common.ValueTypeInt16Array:
+		return decodeArray(c, valueType, data, 2, func(u uint64) int16 { return int16(u) })
+	case common.ValueTypeInt32Array:
+		return decodeArray(c, valueType, data, 4, func(u uint64) int32 { return int32(u) })
+	case common.ValueTypeInt64Array:
+		return decodeArray(c, valueType, data, 8, func(u uint64) int64 { return int64(u) })
+	case common.ValueTypeFloat32Array:
+		return decodeArray(c, valueType, data, 4, func(u uint64) float32 { return math.Float32frombits(uint32(u)) })
+	case common.ValueTypeFloat64Array:
+		return decodeArray(c, valueType, data, 8, math.Float64frombits)
+	case common.ValueTypeBinary:
+		return slices.Clone(data), nil
+	case common.ValueTypeObject, common.ValueTypeObjectArray:
+		var value any
+		if valueType == common.ValueTypeObjectArray {
+			value = &[]any{}
+		} else {
+			value = &map[string]any{}
+		}
+		if err := json.Unmarshal(data, value); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to decode %s value", valueType), err)
+		}
+		if valueType == common.ValueTypeObjectArray {
+			return *value.(*[]any), nil
+		}
+		return *value.(*map[string]any), nil
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported value type %s", valueType), nil)
+	}
+}
+
+// Encode returns the raw bytes of the value of the value type
+func (c Codec) Encode(valueType string, value any) ([]byte, error) {
+	if err := validate(valueType, value); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to encode %s value", valueType), err)
+	}
+
+	switch v := value.(type) {
+	case bool:
+		if v {
+			return []byte{1}, nil
+		}
+		return []byte{0}, nil
+	case []bool:
+		data := make([]byte, len(v))
+		for i, b := range v {
+			if b {
+				data[i] = 1
+			}
+		}
+		return data, nil
+	case string:
+		return c.encodeString(v)
+	case []string:
+		return c.encodeString(strings.Join(v, "\x00"))
+	case uint8:
+		return c.appendUint(nil, uint64(v), 1), nil
+	case uint16:
+		return c.appendUint(nil, uint64(v), 2), nil
+	case uint32:
+		return c.appendUint(nil, uint64(v), 4), nil
+	case uint64:
+		return c.appendUint(nil, v, 8), nil
+	case int8:
+		return c.appendUint(nil, uint64(v), 1), nil
+	case int16:
+		return c.appendUint(nil, uint64(v), 2), nil
+	case int32:
+		return c.appendUint(nil, uint64(v), 4), nil
+	case int64:
+		return c.appendUint(nil, uint64(v), 8), nil
+	case float32:
+		return c.appendUint(nil, uint64(math.Float32bits(v)), 4), nil
+	case float64:
+		return c.appendUint(nil, math.Float64bits(v), 8), nil
+	case []uint8:
+		if valueType == common.ValueTypeBinary {
+			return slices.Clone(v), nil
+		}
+		return encodeArray(c, v, 1, func(e uint8) uint64 { return uint64(e) }), nil
+	case []uint16:
+		return encodeArray(c, v, 2, func(e uint16) uint64 { return uint64(e) }), nil
+	case []uint32:
+		return encodeArray(c, v, 4, func(e uint32) uint64 { return uint64(e) }), nil
+	case []uint64:
+		return encodeArray(c, v, 8, func(e uint64) uint64 { return e }), nil
+	case []int8:
+		return encodeArray(c, v, 1, func(e int8) uint64 { return uint64(e) }), nil
+	case []int16:
+		return encodeArray(c, v, 2, func(e int16) uint64 { return uint64(e) }), nil
+	case []int32:
+		return encodeArray(c, v, 4, func(e int32) uint64 { return uint64(e) }), nil
+	case []int64:
+		return encodeArray(c, v, 8, func(e int64) uint64 { return uint64(e) }), nil
+	case []float32:
+		return encodeArray(c, v, 4, func(e float32) uint64 { return uint64(math.Float32bits(e)) }), nil
+	case []float64:
+		return encodeArray(c, v, 8, math.Float64bits), nil
+	}
+
+	if valueType == common.ValueTypeObject || valueType == common.ValueTypeObjectArray {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to encode %s value", valueType), err)
+		}
+		return data, nil
+	}
+	return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported value type %s", valueType), nil)
+}
+
+type byteOrder interface {
+	binary.ByteOrder
+	binary.AppendByteOrder
+}
+
+func (c Codec) order() byteOrder {
+	if c.ByteOrder == ByteOrderLittleEndian {
+		return binary.LittleEndian
+	}
+	return binary.BigEndian
+}
+
+// uint returns the unsigned integer of the bytes of a value of 1, 2, 4 or 8 bytes
+func (c Codec) uint(b []byte) uint64 {
+	if c.WordSwap && len(b) >= 4 {
+		b = swapWords(slices.Clone(b))
+	}
+	switch len(b) {
+	case 1:
+		return uint64(b[0])
+	case 2:
+		return uint64(c.order().Uint16(b))
+	case 4:
+		return uint64(c.order().Uint32(b))
+	default:
+		return c.order().Uint64(b)
+	}
+}
+
+// appendUint appends the bytes of the unsigned integer of a value of size bytes
+func (c Codec) appendUint(data []byte, u uint64, size int) []byte {
+	var b []byte
+	switch size {
+	case 1:
+		b = []byte{uint8(u)}
+	case 2:
+		b = c.order().AppendUint16(nil, uint16(u))
+	case 4:
+		b = c.order().AppendUint32(nil, uint32(u))
+	default:
+		b = c.order().AppendUint64(nil, u)
+	}
+	if c.WordSwap && size >= 4 {
+		b = swapWords(b)
+	}
+	return append(data, b...)
+}
+
+// swapWords reverses the order of the 16-bit words of the bytes in place
+func swapWords(b []byte) []byte {
+	for i, j := 0, len(b)-2; i < j; i, j = i+2, j-2 {
+		b[i], b[i+1], b[j], b[j+1] = b[j], b[j+1], b[i], b[i+1]
+	}
+	return b
+}
+
+func (c Codec) decodeString(data []byte) (string, error) {
+	switch c.StringEncoding {
+	case StringEncodingUTF16:
+		if len(data)%2 != 0 {
+			return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("odd length %d of UTF-16 string", len(data)), nil)
+		}
+		units := make([]uint16, len(data)/2)
+		for i := range units {
+			units[i] = c.order().Uint16(data[2*i:])
+		}
+		return string(utf16.Decode(units)), nil
+	case StringEncodingASCII:
+		for _, b := range data {
+			if b >= utf8.RuneSelf {
+				return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid ASCII character 0x%02x", b), nil)
+			}
+		}
+		return string(data), nil
+	default:
+		if !utf8.Valid(data) {
+			return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid UTF-8 string", nil)
+		}
+		return string(data), nil
+	}
+}
+
+func (c Codec) encodeString(s string) ([]byte, error) {
+	switch c.StringEncoding {
+	case StringEncodingUTF16:
+		units := utf16.Encode([]rune(s))
+		data := make([]byte, 0, 2*len(units))
+		for _, u := range units {
+			data = c.order().AppendUint16(data, u)
+		}
+		return data, nil
+	case StringEncodingASCII:
+		for _, r := range s {
+			if r >= utf8.RuneSelf {
+				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid ASCII character %q", r), nil)
+			}
+		}
+	}
+	return []byte(s), nil
+}
+
+func checkLength(valueType string, data []byte, size int, array bool) error {
+	if (!array && len(data) != size) || (array && len(data)%size != 0) {
+		errMsg := fmt.Sprintf("invalid length %d of %s value of %d-byte elements", len(data), valueType, size)
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, errMsg, nil)
+	}
+	return nil
+}
+
+func decodeScalar[T any](c Codec, valueType string, data []byte, size int, convert func(uint64) T) (any, error) {
+	if err := checkLength(valueType, data, size, false); err != nil {
+		return nil, err
+	}
+	return convert(c.uint(data)), nil
+}
+
+func decodeArray[T any](c Codec, valueType string, data []byte, size int, convert func(uint64) T) (any, error) {
+	if err := checkLength(valueType, data, size, true); err != nil {
+		return nil, err
+	}
+	values := make([]T, 0, len(data)/size)
+	for i := 0; i < len(data); i += size {
+		values = append(values, convert(c.uint(data[i:i+size])))
+	}
+	return values, nil
+}
+
+func encodeArray[T any](c Codec, values []T, size int, convert func(T) uint64) []byte {
+	data := make([]byte, 0, len(values)*size)
+	for _, v := range values {
+		data = c.appendUint(data, convert(v), size)
+	}
+	return data
+}
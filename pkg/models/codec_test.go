@@ -0,0 +1,158 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCodec(t *testing.T) {
+	tests := []struct {
+		name        string
+		attributes  map[string]any
+		expected    Codec
+		expectedErr bool
+	}{
+		{"default", nil, Codec{}, false},
+		{"valid", map[string]any{ByteOrderAttribute: "littleEndian", WordSwapAttribute: "true", StringEncodingAttribute: "utf-16"},
+			Codec{ByteOrder: ByteOrderLittleEndian, WordSwap: true, StringEncoding: StringEncodingUTF16}, false},
+		{"invalid byte order", map[string]any{ByteOrderAttribute: "middle"}, Codec{}, true},
+		{"invalid word swap", map[string]any{WordSwapAttribute: "sometimes"}, Codec{}, true},
+		{"invalid string encoding", map[string]any{StringEncodingAttribute: "EBCDIC"}, Codec{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codec, err := NewCodec(tt.attributes)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, codec)
+		})
+	}
+}
+
+func TestCodec_Decode(t *testing.T) {
+	bigEndian := Codec{}
+	littleEndian := Codec{ByteOrder: ByteOrderLittleEndian}
+	wordSwap := Codec{WordSwap: true}
+	tests := []struct {
+		name        string
+		codec       Codec
+		valueType   string
+		data        []byte
+		expected    any
+		expectedErr bool
+	}{
+		{"Bool", bigEndian, common.ValueTypeBool, []byte{2}, true, false},
+		{"BoolArray", bigEndian, common.ValueTypeBoolArray, []byte{0, 1}, []bool{false, true}, false},
+		{"Uint16 big-endian", bigEndian, common.ValueTypeUint16, []byte{0x12, 0x34}, uint16(0x1234), false},
+		{"Uint16 little-endian", littleEndian, common.ValueTypeUint16, []byte{0x34, 0x12}, uint16(0x1234), false},
+		{"Uint16 word swap unaffected", wordSwap, common.ValueTypeUint16, []byte{0x12, 0x34}, uint16(0x1234), false},
+		{"Int32 word swap", wordSwap, common.ValueTypeInt32, []byte{0x56, 0x78, 0x12, 0x34}, int32(0x12345678), false},
+		{"Uint32 little-endian word swap", Codec{ByteOrder: ByteOrderLittleEndian, WordSwap: true}, common.ValueTypeUint32,
+			[]byte{0x34, 0x12, 0x78, 0x56}, uint32(0x12345678), false},
+		{"Int64 word swap", wordSwap, common.ValueTypeInt64, []byte{0, 0, 0, 0, 0, 0, 0, 1}, int64(1) << 48, false},
+		{"Int8 negative", bigEndian, common.ValueTypeInt8, []byte{0xFF}, int8(-1), false},
+		{"Float32", bigEndian, common.ValueTypeFloat32, []byte{0x3F, 0xC0, 0, 0}, float32(1.5), false},
+		{"Float64 little-endian", littleEndian, common.ValueTypeFloat64, []byte{0, 0, 0, 0, 0, 0, 0xF8, 0x3F}, 1.5, false},
+		{"Int16Array", bigEndian, common.ValueTypeInt16Array, []byte{0xFF, 0xFE, 0, 2}, []int16{-2, 2}, false},
+		{"Float32Array word swap", wordSwap, common.ValueTypeFloat32Array, []byte{0, 0, 0x3F, 0xC0, 0, 0, 0xC0, 0}, []float32{1.5, -2}, false},
+		{"String trimmed at NUL", bigEndian, common.ValueTypeString, []byte("pump\x00\x00"), "pump", false},
+		{"String ASCII", Codec{StringEncoding: StringEncodingASCII}, common.ValueTypeString, []byte("pump"), "pump", false},
+		{"String UTF-16 little-endian", Codec{ByteOrder: ByteOrderLittleEndian, StringEncoding: StringEncodingUTF16}, common.ValueTypeString,
+			[]byte{'o', 0, 'k', 0, 0, 0}, "ok", false},
+		{"StringArray", bigEndian, common.ValueTypeStringArray, []byte("a\x00bc\x00\x00"), []string{"a", "bc"}, false},
+		{"Binary", bigEndian, common.ValueTypeBinary, []byte{1, 2}, []byte{1, 2}, false},
+		{"Object", bigEndian, common.ValueTypeObject, []byte(`{"a":1}`), map[string]any{"a": float64(1)}, false},
+		{"ObjectArray", bigEndian, common.ValueTypeObjectArray, []byte(`[{"a":1}]`), []any{map[string]any{"a": float64(1)}}, false},
+		{"invalid scalar length", bigEndian, common.ValueTypeUint32, []byte{1, 2}, nil, true},
+		{"invalid array length", bigEndian, common.ValueTypeUint16Array, []byte{1, 2, 3}, nil, true},
+		{"invalid ASCII", Codec{StringEncoding: StringEncodingASCII}, common.ValueTypeString, []byte{0xC3, 0xA9}, nil, true},
+		{"invalid UTF-8", bigEndian, common.ValueTypeString, []byte{0xFF}, nil, true},
+		{"invalid UTF-16 length", Codec{StringEncoding: StringEncodingUTF16}, common.ValueTypeString, []byte{0}, nil, true},
+		{"invalid Object", bigEndian, common.ValueTypeObject, []byte(`[1]`), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := tt.codec.Decode(tt.valueType, tt.data)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func TestCodec_Encode(t *testing.T) {
+	codecs := []Codec{
+		{},
+		{ByteOrder: ByteOrderLittleEndian, WordSwap: true, StringEncoding: StringEncodingUTF16},
+	}
+	values := []struct {
+		valueType string
+		value     any
+	}{
+		{common.ValueTypeBool, true},
+		{common.ValueTypeBoolArray, []bool{true, false}},
+		{common.ValueTypeString, "pompe à chaleur"},
+		{common.ValueTypeStringArray, []string{"a", "bc"}},
+		{common.ValueTypeUint8, uint8(200)},
+		{common.ValueTypeUint16Array, []uint16{1, 0xFFFF}},
+		{common.ValueTypeUint32, uint32(0x12345678)},
+		{common.ValueTypeUint64Array, []uint64{1 << 63}},
+		{common.ValueTypeInt8Array, []int8{-128, 127}},
+		{common.ValueTypeInt16, int16(-2)},
+		{common.ValueTypeInt32Array, []int32{-1, 1 << 30}},
+		{common.ValueTypeInt64, int64(-1) << 40},
+		{common.ValueTypeFloat32Array, []float32{1.5, -0.25}},
+		{common.ValueTypeFloat64, 3.14159},
+		{common.ValueTypeBinary, []byte{0, 1, 2}},
+		{common.ValueTypeObject, map[string]any{"a": "b"}},
+	}
+	for _, codec := range codecs {
+		for _, v := range values {
+			t.Run(string(codec.ByteOrder)+" "+v.valueType, func(t *testing.T) {
+				data, err := codec.Encode(v.valueType, v.value)
+				require.NoError(t, err)
+				decoded, err := codec.Decode(v.valueType, data)
+				require.NoError(t, err)
+				assert.Equal(t, v.value, decoded)
+			})
+		}
+	}
+
+	data, err := Codec{WordSwap: true}.Encode(common.ValueTypeUint32, uint32(0x12345678))
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x56, 0x78, 0x12, 0x34}, data)
+
+	_, err = Codec{StringEncoding: StringEncodingASCII}.Encode(common.ValueTypeString, "é")
+	assert.Error(t, err)
+	_, err = Codec{}.Encode(common.ValueTypeUint16, int16(1))
+	assert.Error(t, err, "expect the value to match the value type")
+}
+
+func TestNewCommandValueFromBytes(t *testing.T) {
+	attributes := map[string]any{ByteOrderAttribute: ByteOrderLittleEndian, WordSwapAttribute: true}
+	cv, err := NewCommandValueFromBytes("temperature", common.ValueTypeFloat32, []byte{0xC0, 0x3F, 0, 0}, attributes)
+	require.NoError(t, err)
+	assert.Equal(t, "temperature", cv.DeviceResourceName)
+	assert.Equal(t, common.ValueTypeFloat32, cv.Type)
+	assert.Equal(t, float32(1.5), cv.Value)
+
+	_, err = NewCommandValueFromBytes("temperature", common.ValueTypeFloat32, []byte{0x3F}, nil)
+	assert.Error(t, err)
+	_, err = NewCommandValueFromBytes("temperature", common.ValueTypeFloat32, []byte{0x3F, 0xC0, 0, 0}, map[string]any{ByteOrderAttribute: "middle"})
+	assert.Error(t, err)
+}
@@ -1,7 +1,7 @@
 // -*- Mode: Go; indent-tabs-mode: t -*-
 //
 // Copyright (C) 2018 Canonical Ltd
-// Copyright (C) 2018-2021 IOTech Ltd
+// Copyright (C) 2018-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -39,6 +39,9 @@ type CommandValue struct {
 	// Tags allows device service to add custom information to the Event in order to
 	// help identify its origin or otherwise label it before it is send to north side.
 	Tags map[string]string
+	// Quality qualifies the value, it's good if not set. The ProtocolDriver can set it, and the SDK sets it when
+	// the value fails to transform or the assertion of the DeviceResource.
+	Quality *Quality
 }
 
 // NewCommandValue create a CommandValue according to the valueType supplied.
@@ -529,7 +532,7 @@ func validate(valueType string, value interface{}) error {
 			errMsg := fmt.Sprintf("value payload exceeds limit for binary readings (%v bytes)", MaxBinaryBytes)
 			return errors.NewCommonEdgeX(errors.KindServerError, errMsg, nil)
 		}
-	case common.ValueTypeObject:
+	case common.ValueTypeObject, common.ValueTypeObjectArray:
 		_, ok = value.(interface{}) // nolint: gosimple
 	default:
 		return errors.NewCommonEdgeX(errors.KindServerError, "unrecognized value type", nil)
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -14,3 +14,13 @@ type DeviceDiscoveryProgress struct {
 	Progress              `json:",inline"`
 	DiscoveredDeviceCount int `json:"discoveredDeviceCount,omitempty"`
 }
+
+// ReadingValidationFailure is the details of the System Event published when a reading fails a validation rule
+type ReadingValidationFailure struct {
+	DeviceName   string `json:"deviceName"`
+	ResourceName string `json:"resourceName"`
+	// Rule is the reason code of the failed rule, e.g. QualityReasonOutOfRange
+	Rule    string `json:"rule"`
+	Value   string `json:"value"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,81 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// QualityStatus is the OPC UA style status of a CommandValue
+type QualityStatus string
+
+const (
+	// QualityGood is the status of a reliable value, which is assumed when the quality is not set
+	QualityGood QualityStatus = "Good"
+	// QualityUncertain is the status of a value of reduced reliability, e.g. a last known or substituted value
+	QualityUncertain QualityStatus = "Uncertain"
+	// QualityBad is the status of an unusable value, which is reported as a null value of the declared value type
+	QualityBad QualityStatus = "Bad"
+)
+
+// reason codes of the quality set by the SDK, the drivers are free to use their own
+const (
+	QualityReasonOverflow             = "Overflow"
+	QualityReasonNaN                  = "NaN"
+	QualityReasonAssertionFailed      = "AssertionFailed"
+	QualityReasonOutOfRange           = "OutOfRange"
+	QualityReasonRateOfChange         = "RateOfChange"
+	QualityReasonStuckValue           = "StuckValue"
+	QualityReasonSensorFailure        = "SensorFailure"
+	QualityReasonCommunicationFailure = "CommunicationFailure"
+	QualityReasonLastKnownValue       = "LastKnownValue"
+	QualityReasonConfigurationError   = "ConfigurationError"
+)
+
+// tags of the Reading carrying the quality of a CommandValue, which are only added if the quality is set
+const (
+	QualityTag        = "ds-quality"
+	QualityReasonTag  = "ds-qualityreason"
+	QualityMessageTag = "ds-qualitymessage"
+)
+
+// Quality qualifies the value of a CommandValue
+type Quality struct {
+	Status QualityStatus
+	// Reason is a code like QualityReasonOverflow
+	Reason string
+	// Message describes the reason in plain text
+	Message string
+}
+
+// SetQuality sets the quality of the CommandValue. A bad value is discarded, so that it's reported as a
+// null value of the declared value type.
+func (cv *CommandValue) SetQuality(status QualityStatus, reason string, message string) {
+	cv.Quality = &Quality{Status: status, Reason: reason, Message: message}
+	if status == QualityBad {
+		cv.Value = nil
+	}
+}
+
+// QualityStatus returns the status of the quality of the CommandValue, QualityGood if it's not set
+func (cv *CommandValue) QualityStatus() QualityStatus {
+	if cv.Quality == nil || cv.Quality.Status == "" {
+		return QualityGood
+	}
+	return cv.Quality.Status
+}
+
+// QualityTags returns the Reading tags of the quality of the CommandValue, nil if the quality is not set
+func (cv *CommandValue) QualityTags() map[string]any {
+	if cv.Quality == nil {
+		return nil
+	}
+	tags := map[string]any{QualityTag: string(cv.QualityStatus())}
+	if cv.Quality.Reason != "" {
+		tags[QualityReasonTag] = cv.Quality.Reason
+	}
+	if cv.Quality.Message != "" {
+		tags[QualityMessageTag] = cv.Quality.Message
+	}
+	return tags
+}
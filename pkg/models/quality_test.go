@@ -0,0 +1,46 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommandValue_SetQuality(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        QualityStatus
+		reason        string
+		message       string
+		expectedValue any
+		expectedTags  map[string]any
+	}{
+		{"uncertain value is kept", QualityUncertain, QualityReasonLastKnownValue, "", float32(1.5),
+			map[string]any{QualityTag: "Uncertain", QualityReasonTag: QualityReasonLastKnownValue}},
+		{"bad value is discarded", QualityBad, QualityReasonSensorFailure, "open circuit", nil,
+			map[string]any{QualityTag: "Bad", QualityReasonTag: QualityReasonSensorFailure, QualityMessageTag: "open circuit"}},
+		{"good", QualityGood, "", "", float32(1.5), map[string]any{QualityTag: "Good"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cv, err := NewCommandValue("temperature", common.ValueTypeFloat32, float32(1.5))
+			require.NoError(t, err)
+			assert.Equal(t, QualityGood, cv.QualityStatus())
+			assert.Nil(t, cv.QualityTags())
+
+			cv.SetQuality(tt.status, tt.reason, tt.message)
+			assert.Equal(t, tt.status, cv.QualityStatus())
+			assert.Equal(t, tt.expectedValue, cv.Value)
+			assert.Equal(t, common.ValueTypeFloat32, cv.Type)
+			assert.Equal(t, tt.expectedTags, cv.QualityTags())
+		})
+	}
+}
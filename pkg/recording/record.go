@@ -0,0 +1,202 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package recording records the read and write commands executed by a ProtocolDriver, with their requests,
+// parameters, results, errors and timing, and replays the recordings with a ProtocolDriver, so that the behavior
+// of a field device can be reproduced deterministically, e.g. in CI with the test harness of the pkg/testing package.
+//
+// The recordings are files of JSON lines, one Record per line in the order the commands completed.
+package recording
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+const (
+	// ReadOperation is the Operation of the recorded read commands
+	ReadOperation = "read"
+	// WriteOperation is the Operation of the recorded write commands
+	WriteOperation = "write"
+)
+
+// maxLineSize is the maximum size of a recorded line, which contains the binary values of the command
+const maxLineSize = 2*sdkModels.MaxBinaryBytes + 1024*1024
+
+// Record is a read or write command executed by the ProtocolDriver
+type Record struct {
+	// Time is when the command started
+	Time time.Time
+	// Duration is how long the ProtocolDriver took to execute the command
+	Duration time.Duration
+	// Operation is either ReadOperation or WriteOperation
+	Operation  string
+	DeviceName string
+	Protocols  map[string]models.ProtocolProperties `json:",omitempty"`
+	Requests   []sdkModels.CommandRequest
+	// Params are the parameters of a write command
+	Params []Value `json:",omitempty"`
+	// Results are the values read by a read command
+	Results []Value `json:",omitempty"`
+	// Error is the error returned by the ProtocolDriver, empty if the command succeeded
+	Error string `json:",omitempty"`
+}
+
+// Value is a recorded CommandValue, whose value is encoded in JSON so that it's decoded to the Go type of the
+// value type
+type Value struct {
+	DeviceResourceName string
+	Type               string
+	Value              json.RawMessage
+	Origin             int64              `json:",omitempty"`
+	Tags               map[string]string  `json:",omitempty"`
+	Quality            *sdkModels.Quality `json:",omitempty"`
+}
+
+func fromCommandValues(cvs []*sdkModels.CommandValue) ([]Value, error) {
+	if cvs == nil {
+		return nil, nil
+	}
+	values := make([]Value, len(cvs))
+	for i, cv := range cvs {
+		if cv == nil {
+			continue
+		}
+		raw, err := json.Marshal(cv.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode the value of DeviceResource %s: %w", cv.DeviceResourceName, err)
+		}
+		values[i] = Value{
+			DeviceResourceName: cv.DeviceResourceName,
+			Type:               cv.Type,
+			Value:              raw,
+			Origin:             cv.Origin,
+			Tags:               cv.Tags,
+			Quality:            cv.Quality,
+		}
+	}
+	return values, nil
+}
+
+// CommandValue decodes the recorded CommandValue
+func (v Value) CommandValue() (*sdkModels.CommandValue, error) {
+	value, err := decodeValue(v.Type, v.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the value of DeviceResource %s: %w", v.DeviceResourceName, err)
+	}
+	cv, err := sdkModels.NewCommandValueWithOrigin(v.DeviceResourceName, v.Type, value, v.Origin)
+	if err != nil {
+		return nil, err
+	}
+	if v.Tags != nil {
+		cv.Tags = v.Tags
+	}
+	cv.Quality = v.Quality
+	return cv, nil
+}
+
+func decodeValue(valueType string, raw json.RawMessage) (any, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	switch valueType {
+	case common.ValueTypeString:
+		return decode[string](raw)
+	case common.ValueTypeStringArray:
+		return decode[[]string](raw)
+	case common.ValueTypeBool:
+		return decode[bool](raw)
+	case common.ValueTypeBoolArray:
+		return decode[[]bool](raw)
+	case common.ValueTypeUint8:
+		return decode[uint8](raw)
+	case common.ValueTypeUint8Array, common.ValueTypeBinary:
+		return decode[[]byte](raw)
+	case common.ValueTypeUint16:
+		return decode[uint16](raw)
+	case common.ValueTypeUint16Array:
+		return decode[[]uint16](raw)
+	case common.ValueTypeUint32:
+		return decode[uint32](raw)
+	case common.ValueTypeUint32Array:
+		return decode[[]uint32](raw)
+	case common.ValueTypeUint64:
+		return decode[uint64](raw)
+	case common.ValueTypeUint64Array:
+		return decode[[]uint64](raw)
+	case common.ValueTypeInt8:
+		return decode[int8](raw)
+	case common.ValueTypeInt8Array:
+		return decode[[]int8](raw)
+	case common.ValueTypeInt16:
+		return decode[int16](raw)
+	case common.ValueTypeInt16Array:
+		return decode[[]int16](raw)
+	case common.ValueTypeInt32:
+		return decode[int32](raw)
+	case common.ValueTypeInt32Array:
+		return decode[[]int32](raw)
+	case common.ValueTypeInt64:
+		return decode[int64](raw)
+	case common.ValueTypeInt64Array:
+		return decode[[]int64](raw)
+	case common.ValueTypeFloat32:
+		return decode[float32](raw)
+	case common.ValueTypeFloat32Array:
+		return decode[[]float32](raw)
+	case common.ValueTypeFloat64:
+		return decode[float64](raw)
+	case common.ValueTypeFloat64Array:
+		return decode[[]float64](raw)
+	case common.ValueTypeObject, common.ValueTypeObjectArray:
+		return decode[any](raw)
+	default:
+		return nil, fmt.Errorf("unrecognized value type %s", valueType)
+	}
+}
+
+func decode[T any](raw json.RawMessage) (any, error) {
+	var value T
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// ReadFile reads the Records of a recording file
+func ReadFile(path string) ([]Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var records []Record
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, maxLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var record Record
+		if err = json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("failed to parse line %d of recording %s: %w", line, path, err)
+		}
+		records = append(records, record)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read recording %s: %w", path, err)
+	}
+	return records, nil
+}
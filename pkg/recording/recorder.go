@@ -0,0 +1,191 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package recording
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/interfaces"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+// RedactedValue replaces the values of the redacted protocol properties in the Records
+const RedactedValue = "<redacted>"
+
+// RedactAllProtocolProperties is the name redacting all the protocol properties
+const RedactAllProtocolProperties = "*"
+
+// Recorder wraps a ProtocolDriver, and records its read and write commands to a writer. The other methods are
+// passed through to the ProtocolDriver. The ContextProtocolDriver methods are passed through to the
+// ContextProtocolDriver if the ProtocolDriver implements it, to the ProtocolDriver methods otherwise.
+type Recorder struct {
+	interfaces.ProtocolDriver
+	ctxDriver interfaces.ContextProtocolDriver
+	lc        logger.LoggingClient
+	encoder   *json.Encoder
+	mutex     sync.Mutex
+	stopped   bool
+	inFlight  sync.WaitGroup
+	redacted  map[string]bool
+}
+
+// NewRecorder returns a Recorder of the ProtocolDriver, writing the Records as JSON lines to the writer. The
+// failures to record are logged, and don't fail the commands.
+func NewRecorder(driver interfaces.ProtocolDriver, w io.Writer, lc logger.LoggingClient) *Recorder {
+	ctxDriver, _ := driver.(interfaces.ContextProtocolDriver)
+	return &Recorder{ProtocolDriver: driver, ctxDriver: ctxDriver, lc: lc, encoder: json.NewEncoder(w)}
+}
+
+// RedactProtocolProperties replaces the values of the protocol properties by RedactedValue in the Records, since
+// they can hold credentials. RedactAllProtocolProperties redacts all of them. It must be called before the
+// Recorder executes any command.
+func (r *Recorder) RedactProtocolProperties(names ...string) {
+	r.redacted = make(map[string]bool, len(names))
+	for _, name := range names {
+		r.redacted[name] = true
+	}
+}
+
+// StopRecording stops recording, and waits for the commands being executed to be recorded, so that the writer can be
+// closed. The commands executed afterwards are passed through to the ProtocolDriver without being recorded.
+func (r *Recorder) StopRecording() {
+	r.mutex.Lock()
+	r.stopped = true
+	r.mutex.Unlock()
+	r.inFlight.Wait()
+}
+
+// begin tells whether the command is recorded, in which case the caller must call inFlight.Done once the command
+// is recorded.
+func (r *Recorder) begin() bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.stopped {
+		return false
+	}
+	r.inFlight.Add(1)
+	return true
+}
+
+func (r *Recorder) HandleReadCommands(deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest) ([]*sdkModels.CommandValue, error) {
+	if !r.begin() {
+		return r.ProtocolDriver.HandleReadCommands(deviceName, protocols, reqs)
+	}
+	defer r.inFlight.Done()
+	start := time.Now()
+	res, err := r.ProtocolDriver.HandleReadCommands(deviceName, protocols, reqs)
+	r.recordRead(start, deviceName, protocols, reqs, res, err)
+	return res, err
+}
+
+func (r *Recorder) HandleWriteCommands(deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest, params []*sdkModels.CommandValue) error {
+	if !r.begin() {
+		return r.ProtocolDriver.HandleWriteCommands(deviceName, protocols, reqs, params)
+	}
+	defer r.inFlight.Done()
+	start := time.Now()
+	err := r.ProtocolDriver.HandleWriteCommands(deviceName, protocols, reqs, params)
+	r.recordWrite(start, deviceName, protocols, reqs, params, err)
+	return err
+}
+
+func (r *Recorder) HandleReadCommandsWithContext(ctx context.Context, deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest) ([]*sdkModels.CommandValue, error) {
+	if r.ctxDriver == nil {
+		return r.HandleReadCommands(deviceName, protocols, reqs)
+	}
+	if !r.begin() {
+		return r.ctxDriver.HandleReadCommandsWithContext(ctx, deviceName, protocols, reqs)
+	}
+	defer r.inFlight.Done()
+	start := time.Now()
+	res, err := r.ctxDriver.HandleReadCommandsWithContext(ctx, deviceName, protocols, reqs)
+	r.recordRead(start, deviceName, protocols, reqs, res, err)
+	return res, err
+}
+
+func (r *Recorder) HandleWriteCommandsWithContext(ctx context.Context, deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest, params []*sdkModels.CommandValue) error {
+	if r.ctxDriver == nil {
+		return r.HandleWriteCommands(deviceName, protocols, reqs, params)
+	}
+	if !r.begin() {
+		return r.ctxDriver.HandleWriteCommandsWithContext(ctx, deviceName, protocols, reqs, params)
+	}
+	defer r.inFlight.Done()
+	start := time.Now()
+	err := r.ctxDriver.HandleWriteCommandsWithContext(ctx, deviceName, protocols, reqs, params)
+	r.recordWrite(start, deviceName, protocols, reqs, params, err)
+	return err
+}
+
+func (r *Recorder) recordRead(start time.Time, deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest, res []*sdkModels.CommandValue, err error) {
+	record := newRecord(start, ReadOperation, deviceName, r.redactProtocols(protocols), reqs, err)
+	var encodeErr error
+	if record.Results, encodeErr = fromCommandValues(res); encodeErr != nil {
+		r.lc.Errorf("failed to record the read command of device %s: %v", deviceName, encodeErr)
+		return
+	}
+	r.record(record)
+}
+
+func (r *Recorder) recordWrite(start time.Time, deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest, params []*sdkModels.CommandValue, err error) {
+	record := newRecord(start, WriteOperation, deviceName, r.redactProtocols(protocols), reqs, err)
+	var encodeErr error
+	if record.Params, encodeErr = fromCommandValues(params); encodeErr != nil {
+		r.lc.Errorf("failed to record the write command of device %s: %v", deviceName, encodeErr)
+		return
+	}
+	r.record(record)
+}
+
+func newRecord(start time.Time, operation string, deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest, err error) Record {
+	record := Record{
+		Time:       start,
+		Duration:   time.Since(start),
+		Operation:  operation,
+		DeviceName: deviceName,
+		Protocols:  protocols,
+		Requests:   reqs,
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	return record
+}
+
+// redactProtocols returns a copy of the protocols with the redacted protocol properties
+func (r *Recorder) redactProtocols(protocols map[string]models.ProtocolProperties) map[string]models.ProtocolProperties {
+	if len(r.redacted) == 0 || protocols == nil {
+		return protocols
+	}
+	redacted := make(map[string]models.ProtocolProperties, len(protocols))
+	for protocol, properties := range protocols {
+		redactedProperties := make(models.ProtocolProperties, len(properties))
+		for name, value := range properties {
+			if r.redacted[RedactAllProtocolProperties] || r.redacted[name] {
+				value = RedactedValue
+			}
+			redactedProperties[name] = value
+		}
+		redacted[protocol] = redactedProperties
+	}
+	return redacted
+}
+
+func (r *Recorder) record(record Record) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if err := r.encoder.Encode(record); err != nil {
+		r.lc.Errorf("failed to record the %s command of device %s: %v", record.Operation, record.DeviceName, err)
+	}
+}
@@ -0,0 +1,184 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package recording
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/interfaces/mocks"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+const testDevice = "testDevice"
+
+func commandValue(t *testing.T, name string, valueType string, value any) *sdkModels.CommandValue {
+	cv, err := sdkModels.NewCommandValueWithOrigin(name, valueType, value, 1000)
+	require.NoError(t, err)
+	return cv
+}
+
+func requests(names ...string) []sdkModels.CommandRequest {
+	reqs := make([]sdkModels.CommandRequest, len(names))
+	for i, name := range names {
+		reqs[i] = sdkModels.CommandRequest{DeviceResourceName: name}
+	}
+	return reqs
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	temperature := commandValue(t, "temperature", common.ValueTypeFloat32, float32(20.5))
+	temperature.Tags["unit"] = "degC"
+	temperature.SetQuality(sdkModels.QualityUncertain, sdkModels.QualityReasonSensorFailure, "calibrating")
+	values := []*sdkModels.CommandValue{
+		temperature,
+		commandValue(t, "counts", common.ValueTypeInt16Array, []int16{-1, 2}),
+		commandValue(t, "image", common.ValueTypeBinary, []byte{0xff, 0x00}),
+		commandValue(t, "config", common.ValueTypeObject, map[string]any{"mode": "auto"}),
+		commandValue(t, "total", common.ValueTypeUint64, uint64(18446744073709551615)),
+	}
+	setpoint := commandValue(t, "setpoint", common.ValueTypeInt32, int32(21))
+
+	driver := mocks.NewProtocolDriver(t)
+	driver.On("HandleReadCommands", testDevice, mock.Anything, requests("temperature", "counts", "image", "config", "total")).Return(values, nil).Once()
+	driver.On("HandleReadCommands", testDevice, mock.Anything, requests("temperature", "counts", "image", "config", "total")).Return(nil, errors.New("timeout")).Once()
+	driver.On("HandleWriteCommands", testDevice, mock.Anything, requests("setpoint"), mock.Anything).Return(nil).Once()
+
+	path := filepath.Join(t.TempDir(), "recording.jsonl")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	recorder := NewRecorder(driver, file, logger.NewMockClient())
+	res, err := recorder.HandleReadCommands(testDevice, nil, requests("temperature", "counts", "image", "config", "total"))
+	require.NoError(t, err)
+	assert.Equal(t, values, res)
+	_, err = recorder.HandleReadCommands(testDevice, nil, requests("temperature", "counts", "image", "config", "total"))
+	require.EqualError(t, err, "timeout")
+	require.NoError(t, recorder.HandleWriteCommands(testDevice, nil, requests("setpoint"), []*sdkModels.CommandValue{setpoint}))
+	require.NoError(t, file.Close())
+
+	records, err := ReadFile(path)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, ReadOperation, records[0].Operation)
+	assert.Equal(t, "timeout", records[1].Error)
+	assert.Equal(t, WriteOperation, records[2].Operation)
+
+	replay := NewReplayDriver(records, false)
+	for range 2 {
+		res, err = replay.HandleReadCommands(testDevice, nil, requests("temperature", "counts", "image", "config", "total"))
+		require.NoError(t, err)
+		assert.Equal(t, values, res, "expect the recorded values to be replayed with their types")
+		_, err = replay.HandleReadCommands(testDevice, nil, requests("temperature", "counts", "image", "config", "total"))
+		assert.EqualError(t, err, "timeout")
+	}
+
+	_, err = replay.HandleReadCommands(testDevice, nil, requests("temperature"))
+	assert.Error(t, err, "expect no recording of the DeviceResources")
+	assert.NoError(t, replay.HandleWriteCommands(testDevice, nil, requests("setpoint"), []*sdkModels.CommandValue{setpoint}))
+	other := commandValue(t, "setpoint", common.ValueTypeInt32, int32(22))
+	assert.Error(t, replay.HandleWriteCommands(testDevice, nil, requests("setpoint"), []*sdkModels.CommandValue{other}),
+		"expect the parameters differing from the recording to fail")
+}
+
+func TestReadFile_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte("{\"Operation\": \"read\"}\n\nnot json\n"), 0600))
+
+	_, err := ReadFile(path)
+	assert.ErrorContains(t, err, "line 3")
+	_, err = ReadFile(filepath.Join(t.TempDir(), "missing.jsonl"))
+	assert.Error(t, err)
+}
+
+func TestRecorder_StopRecording(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	driver := mocks.NewProtocolDriver(t)
+	driver.On("HandleReadCommands", testDevice, mock.Anything, requests("temperature")).
+		Run(func(mock.Arguments) {
+			close(started)
+			<-release
+		}).Return(nil, nil).Once()
+	driver.On("HandleReadCommands", testDevice, mock.Anything, requests("humidity")).Return(nil, nil).Once()
+
+	var buffer bytes.Buffer
+	recorder := NewRecorder(driver, &buffer, logger.NewMockClient())
+	executed := make(chan struct{})
+	go func() {
+		defer close(executed)
+		_, _ = recorder.HandleReadCommands(testDevice, nil, requests("temperature"))
+	}()
+	<-started
+
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		recorder.StopRecording()
+	}()
+	select {
+	case <-stopped:
+		t.Fatal("expect StopRecording to wait for the command being executed")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(release)
+	<-stopped
+	<-executed
+	recorded := buffer.String()
+	assert.Contains(t, recorded, "temperature", "expect the command being executed to be recorded")
+
+	// the commands executed once stopped are not recorded
+	_, err := recorder.HandleReadCommands(testDevice, nil, requests("humidity"))
+	require.NoError(t, err)
+	assert.Equal(t, recorded, buffer.String())
+}
+
+func TestRecorder_RedactProtocolProperties(t *testing.T) {
+	protocols := map[string]models.ProtocolProperties{
+		"http": {"Address": "10.0.0.1", "Password": "secret"},
+	}
+	tests := []struct {
+		name     string
+		redacted []string
+		expected models.ProtocolProperties
+	}{
+		{"no redaction", nil, models.ProtocolProperties{"Address": "10.0.0.1", "Password": "secret"}},
+		{"listed properties", []string{"Password"}, models.ProtocolProperties{"Address": "10.0.0.1", "Password": RedactedValue}},
+		{"all properties", []string{RedactAllProtocolProperties}, models.ProtocolProperties{"Address": RedactedValue, "Password": RedactedValue}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			driver := mocks.NewProtocolDriver(t)
+			driver.On("HandleReadCommands", testDevice, protocols, requests("temperature")).Return(nil, nil).Once()
+
+			path := filepath.Join(t.TempDir(), "recording.jsonl")
+			file, err := os.Create(path)
+			require.NoError(t, err)
+			recorder := NewRecorder(driver, file, logger.NewMockClient())
+			recorder.RedactProtocolProperties(tt.redacted...)
+			_, err = recorder.HandleReadCommands(testDevice, protocols, requests("temperature"))
+			require.NoError(t, err)
+			require.NoError(t, file.Close())
+
+			records, err := ReadFile(path)
+			require.NoError(t, err)
+			require.Len(t, records, 1)
+			assert.Equal(t, tt.expected, records[0].Protocols["http"])
+			assert.Equal(t, "secret", protocols["http"]["Password"], "expect the protocols of the driver to be left as is")
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package recording
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/interfaces"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+// ReplayDriver is a ProtocolDriver which serves the recorded commands back. A command is served by the next Record
+// of the same operation, device and DeviceResources, in the recorded order, and the Records are served again from
+// the first one once they are exhausted. A write command fails if its parameters differ from the recorded ones.
+type ReplayDriver struct {
+	records        map[string][]Record
+	next           map[string]int
+	preserveTiming bool
+	mutex          sync.Mutex
+}
+
+// NewReplayDriver returns a ReplayDriver of the Records. If preserveTiming is true, the commands take the recorded
+// durations.
+func NewReplayDriver(records []Record, preserveTiming bool) *ReplayDriver {
+	d := &ReplayDriver{records: make(map[string][]Record), next: make(map[string]int), preserveTiming: preserveTiming}
+	for _, r := range records {
+		key := recordKey(r.Operation, r.DeviceName, r.Requests)
+		d.records[key] = append(d.records[key], r)
+	}
+	return d
+}
+
+func recordKey(operation string, deviceName string, reqs []sdkModels.CommandRequest) string {
+	names := make([]string, len(reqs))
+	for i, req := range reqs {
+		names[i] = req.DeviceResourceName
+	}
+	return operation + "/" + deviceName + "/" + strings.Join(names, ",")
+}
+
+func (d *ReplayDriver) nextRecord(operation string, deviceName string, reqs []sdkModels.CommandRequest) (Record, error) {
+	key := recordKey(operation, deviceName, reqs)
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	records := d.records[key]
+	if len(records) == 0 {
+		names := make([]string, len(reqs))
+		for i, req := range reqs {
+			names[i] = req.DeviceResourceName
+		}
+		return Record{}, fmt.Errorf("no recorded %s command of device %s for DeviceResources %v", operation, deviceName, names)
+	}
+	record := records[d.next[key]%len(records)]
+	d.next[key]++
+	return record, nil
+}
+
+func (d *ReplayDriver) replay(record Record) error {
+	if d.preserveTiming {
+		time.Sleep(record.Duration)
+	}
+	if record.Error != "" {
+		return errors.New(record.Error)
+	}
+	return nil
+}
+
+func (d *ReplayDriver) Initialize(_ interfaces.DeviceServiceSDK) error {
+	return nil
+}
+
+func (d *ReplayDriver) HandleReadCommands(deviceName string, _ map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest) ([]*sdkModels.CommandValue, error) {
+	record, err := d.nextRecord(ReadOperation, deviceName, reqs)
+	if err != nil {
+		return nil, err
+	}
+	if err = d.replay(record); err != nil {
+		return nil, err
+	}
+	if record.Results == nil {
+		return nil, nil
+	}
+	res := make([]*sdkModels.CommandValue, len(record.Results))
+	for i, v := range record.Results {
+		if v.Type == "" {
+			continue
+		}
+		if res[i], err = v.CommandValue(); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (d *ReplayDriver) HandleWriteCommands(deviceName string, _ map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest, params []*sdkModels.CommandValue) error {
+	record, err := d.nextRecord(WriteOperation, deviceName, reqs)
+	if err != nil {
+		return err
+	}
+	values, err := fromCommandValues(params)
+	if err != nil {
+		return err
+	}
+	if len(values) != len(record.Params) {
+		return fmt.Errorf("write command of device %s has %d parameters, %d were recorded", deviceName, len(values), len(record.Params))
+	}
+	for i, v := range values {
+		recorded := record.Params[i]
+		if v.DeviceResourceName != recorded.DeviceResourceName || v.Type != recorded.Type || string(v.Value) != string(recorded.Value) {
+			return fmt.Errorf("write parameter %s %s of device %s differs from the recorded %s %s",
+				v.DeviceResourceName, v.Value, deviceName, recorded.DeviceResourceName, recorded.Value)
+		}
+	}
+	return d.replay(record)
+}
+
+func (d *ReplayDriver) Start() error {
+	return nil
+}
+
+func (d *ReplayDriver) Stop(_ bool) error {
+	return nil
+}
+
+func (d *ReplayDriver) AddDevice(_ string, _ map[string]models.ProtocolProperties, _ models.AdminState) error {
+	return nil
+}
+
+func (d *ReplayDriver) UpdateDevice(_ string, _ map[string]models.ProtocolProperties, _ models.AdminState) error {
+	return nil
+}
+
+func (d *ReplayDriver) RemoveDevice(_ string, _ map[string]models.ProtocolProperties) error {
+	return nil
+}
+
+func (d *ReplayDriver) Discover() error {
+	return nil
+}
+
+func (d *ReplayDriver) ValidateDevice(_ models.Device) error {
+	return nil
+}
@@ -41,13 +41,13 @@ func (s *deviceService) processAsyncResults(ctx context.Context, dic *di.Contain
 		case <-ctx.Done():
 			return
 		case acv := <-s.asyncCh:
-			go s.sendAsyncValues(acv, working, dic)
+			go s.sendAsyncValues(ctx, acv, working, dic)
 		}
 	}
 }
 
 // sendAsyncValues convert AsyncValues to event and send the event to CoreData
-func (s *deviceService) sendAsyncValues(acv *sdkModels.AsyncValues, working chan bool, dic *di.Container) {
+func (s *deviceService) sendAsyncValues(ctx context.Context, acv *sdkModels.AsyncValues, working chan bool, dic *di.Container) {
 	working <- true
 	defer func() {
 		<-working
@@ -71,7 +71,7 @@ func (s *deviceService) sendAsyncValues(acv *sdkModels.AsyncValues, working chan
 	}
 
 	configuration := container.ConfigurationFrom(dic.Get)
-	event, err := transformer.CommandValuesToEventDTO(acv.CommandValues, acv.DeviceName, acv.SourceName, configuration.Device.DataTransform, dic)
+	event, err := transformer.CommandValuesToEventDTO(ctx, acv.CommandValues, acv.DeviceName, acv.SourceName, configuration.Device.DataTransform, dic)
 	if err != nil {
 		s.lc.Errorf("failed to transform CommandValues to Event: %v", err)
 		return
@@ -1,6 +1,6 @@
 // -*- Mode: Go; indent-tabs-mode: t -*-
 //
-// Copyright (C) 2020-2025 IOTech Ltd
+// Copyright (C) 2020-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -55,10 +55,17 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, st
 	s.controller = restController.NewRestController(b.router, dic, s.serviceKey)
 	s.controller.InitRestRoutes(dic)
 
-	if !b.checkDependencyServiceAvailable(common.CoreMetaDataServiceKey, startupTimer) {
+	// the in-process service runs with the Core Metadata clients of the DIC, which are available
+	if !s.inProcess && !b.checkDependencyServiceAvailable(common.CoreMetaDataServiceKey, startupTimer) {
 		return false
 	}
 
+	if !sdkCommon.InitializeRecorder(ctx, wg, dic) {
+		return false
+	}
+	// the faults are injected in front of the recorder, so that only the commands of the devices are recorded
+	sdkCommon.InitializeFaultInjection(ctx, wg, dic)
+
 	edgexErr := cache.InitCache(s.serviceKey, s.baseServiceName, dic)
 	if edgexErr != nil {
 		s.lc.Errorf("Failed to init cache: %s", edgexErr.Error())
@@ -68,6 +75,8 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, st
 	devices := cache.Devices().All()
 	config := container.ConfigurationFrom(dic.Get)
 	reqFailsTracker := container.NewAllowedFailuresTracker()
+	commandScheduler := container.NewCommandScheduler()
+	transformStates := container.NewTransformStates()
 	for _, d := range devices {
 		reqFailsTracker.Set(d.Name, int(config.Device.AllowedFails))
 	}
@@ -75,6 +84,12 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, st
 		container.AllowedRequestFailuresTrackerName: func(get di.Get) any {
 			return reqFailsTracker
 		},
+		container.CommandSchedulerName: func(get di.Get) any {
+			return commandScheduler
+		},
+		container.TransformStatesName: func(get di.Get) any {
+			return transformStates
+		},
 	})
 
 	if s.AsyncReadingsEnabled() {
@@ -125,6 +140,10 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, st
 		return false
 	}
 
+	if !sdkCommon.InitializeEventBuffer(ctx, wg, dic) {
+		return false
+	}
+
 	s.autoEventManager.StartAutoEvents()
 
 	// Very important that this bootstrap handler is called after the NewServiceMetrics handler so
@@ -186,7 +205,11 @@ func (h *messageBusBootstrap) messageBusBootstrapHandler(ctx context.Context, wg
 	if !handlers.MessagingBootstrapHandler(ctx, wg, startupTimer, dic) {
 		return false
 	}
+	return h.subscribeBootstrapHandler(ctx, wg, startupTimer, dic)
+}
 
+// subscribeBootstrapHandler subscribes to the requests and the System Events with the MessageClient of the DIC
+func (h *messageBusBootstrap) subscribeBootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	err := messaging.SubscribeCommands(ctx, dic)
 	if err != nil {
@@ -194,6 +217,12 @@ func (h *messageBusBootstrap) messageBusBootstrapHandler(ctx context.Context, wg
 		return false
 	}
 
+	err = messaging.SubscribeBatchCommands(ctx, dic)
+	if err != nil {
+		lc.Errorf("Failed to subscribe batch command request: %v", err)
+		return false
+	}
+
 	err = messaging.MetadataSystemEventsCallback(ctx, h.baseServiceName, dic)
 	if err != nil {
 		lc.Errorf("Failed to subscribe Metadata system events: %v", err)
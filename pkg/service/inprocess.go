@@ -0,0 +1,86 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/flags"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/handlers"
+	bootstrapInterfaces "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/labstack/echo/v4"
+	"github.com/panjf2000/ants/v2"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/autodiscovery"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/autoevent"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/config"
+)
+
+// RunInProcess bootstraps the service in the process, with the configuration and the Core Metadata clients,
+// MessageClient and LoggingClient of the DIC instead of the ones of the configuration providers and the
+// dependency services, and starts the ProtocolDriver. The args are the command line flags, e.g. the configuration
+// file of the custom configuration. The service runs until the context is done, then the ProtocolDriver is stopped
+// and the returned WaitGroup is done.
+//
+// It's used by the test harness of the pkg/testing package, the HTTP server isn't started.
+func (s *deviceService) RunInProcess(ctx context.Context, configuration *config.ConfigurationStruct, args []string, dic *di.Container) (*sync.WaitGroup, error) {
+	s.inProcess = true
+	s.flags = flags.New()
+	s.flags.Parse(args)
+	s.baseServiceName = s.serviceKey
+	s.config = configuration
+	s.deviceServiceModel = &models.DeviceService{Name: s.serviceKey}
+	s.dic = dic
+	s.dic.Update(s.serviceConstructors())
+	s.dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.ConfigurationInterfaceName: func(get di.Get) any {
+			return s.config
+		},
+	})
+
+	pool, err := ants.NewPool(s.config.Device.AsyncBufferSize)
+	if err != nil {
+		return nil, err
+	}
+	s.pool = pool
+
+	wg := &sync.WaitGroup{}
+	startupTimer := startup.NewStartUpTimer(s.serviceKey)
+	for _, handler := range []bootstrapInterfaces.BootstrapHandler{
+		newMessageBusBootstrap(s.baseServiceName).subscribeBootstrapHandler,
+		handlers.NewServiceMetrics(s.serviceKey).BootstrapHandler, // Must be after Messaging
+		autoevent.NewBootstrap(s.pool).BootstrapHandler,
+		NewBootstrap(s, echo.New()).BootstrapHandler,
+		autodiscovery.BootstrapHandler,
+	} {
+		if !handler(ctx, wg, startupTimer, s.dic) {
+			s.pool.Release()
+			return nil, fmt.Errorf("bootstrapping failed")
+		}
+	}
+
+	if err = s.driver.Start(); err != nil {
+		s.pool.Release()
+		return nil, fmt.Errorf("failed to Start ProtocolDriver: %v", err)
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-ctx.Done()
+		s.Stop(false)
+		s.pool.Release()
+	}()
+	return wg, nil
+}
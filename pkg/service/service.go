@@ -1,7 +1,7 @@
 // -*- Mode: Go; indent-tabs-mode: t -*-
 //
 // Copyright (C) 2017-2018 Canonical Ltd
-// Copyright (C) 2018-2025 IOTech Ltd
+// Copyright (C) 2018-2026 IOTech Ltd
 // Copyright (C) 2019,2023 Intel Corporation
 //
 // SPDX-License-Identifier: Apache-2.0
@@ -61,6 +61,7 @@ type deviceService struct {
 	lc                 logger.LoggingClient
 	driver             interfaces.ProtocolDriver
 	extdriver          interfaces.ExtendedProtocolDriver
+	ctxdriver          interfaces.ContextProtocolDriver
 	autoEventManager   interfaces.AutoEventManager
 	commonController   *controller.CommonController
 	controller         *restController.RestController
@@ -74,6 +75,7 @@ type deviceService struct {
 	ctx                context.Context
 	dic                *di.Container
 	pool               *ants.Pool
+	inProcess          bool
 }
 
 // NewDeviceService returns an implementation of interfaces.DeviceServiceSDKExt for the specified key, version, and driver.
@@ -97,6 +99,12 @@ func NewDeviceService(serviceKey string, serviceVersion string, driver interface
 		service.extdriver = nil
 	}
 
+	if ctxdriver, ok := driver.(interfaces.ContextProtocolDriver); ok {
+		service.ctxdriver = ctxdriver
+	} else {
+		service.ctxdriver = nil
+	}
+
 	service.config = &config.ConfigurationStruct{}
 	return interfaces.DeviceServiceSDK(&service), nil
 }
@@ -117,20 +125,7 @@ func (s *deviceService) Run() error {
 	s.config = &config.ConfigurationStruct{}
 	s.deviceServiceModel = &models.DeviceService{Name: s.serviceKey}
 
-	s.dic = di.NewContainer(di.ServiceConstructorMap{
-		container.ConfigurationName: func(get di.Get) any {
-			return s.config
-		},
-		container.DeviceServiceName: func(get di.Get) any {
-			return s.deviceServiceModel
-		},
-		container.ProtocolDriverName: func(get di.Get) any {
-			return s.driver
-		},
-		container.ExtendedProtocolDriverName: func(get di.Get) any {
-			return s.extdriver
-		},
-	})
+	s.dic = di.NewContainer(s.serviceConstructors())
 
 	// set poolSize to config.Device.AsyncBufferSize
 	config := container.ConfigurationFrom(s.dic.Get)
@@ -188,6 +183,27 @@ func (s *deviceService) Run() error {
 	return nil
 }
 
+// serviceConstructors returns the constructors of the service's own dependencies
+func (s *deviceService) serviceConstructors() di.ServiceConstructorMap {
+	return di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) any {
+			return s.config
+		},
+		container.DeviceServiceName: func(get di.Get) any {
+			return s.deviceServiceModel
+		},
+		container.ProtocolDriverName: func(get di.Get) any {
+			return s.driver
+		},
+		container.ExtendedProtocolDriverName: func(get di.Get) any {
+			return s.extdriver
+		},
+		container.ContextProtocolDriverName: func(get di.Get) any {
+			return s.ctxdriver
+		},
+	}
+}
+
 // Name returns the name of this Device Service
 func (s *deviceService) Name() string {
 	return s.serviceKey
@@ -0,0 +1,466 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package simulator provides a ProtocolDriver of simulated devices, which generates plausible values for the
+// DeviceResources of any device profile, so that device services and their applications can be developed and tested
+// without hardware.
+//
+// The values of each DeviceResource are generated as set by its attributes, e.g.
+//
+//	attributes: { generator: "sine", min: -10, max: 40, period: "1h" }
+//
+// and the simulation of each device is set by the properties of its simulator protocol, e.g.
+//
+//	protocols: { simulator: { latency: "20ms", jitter: "10ms", failureRate: 0.01, pushInterval: "10s" } }
+package simulator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/spf13/cast"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/interfaces"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+// Driver is the ProtocolDriver of the simulated devices
+type Driver struct {
+	sdk     interfaces.DeviceServiceSDK
+	lc      logger.LoggingClient
+	devices map[string]*simulatedDevice
+	mutex   sync.Mutex
+}
+
+// simulatedDevice is the state of the simulation of a device
+type simulatedDevice struct {
+	settings   settings
+	rand       *rand.Rand
+	generators map[string]generator
+	written    map[string]any
+	stop       chan struct{}
+	wg         sync.WaitGroup
+	mutex      sync.Mutex
+}
+
+// NewDriver creates the ProtocolDriver of the simulated devices
+func NewDriver() *Driver {
+	return &Driver{devices: make(map[string]*simulatedDevice)}
+}
+
+func (d *Driver) Initialize(sdk interfaces.DeviceServiceSDK) error {
+	d.sdk = sdk
+	d.lc = sdk.LoggingClient()
+	return nil
+}
+
+func (d *Driver) Start() error {
+	return nil
+}
+
+// Stop stops pushing the values of all the devices
+func (d *Driver) Stop(force bool) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	for name, device := range d.devices {
+		device.stopPushing()
+		delete(d.devices, name)
+	}
+	return nil
+}
+
+func (d *Driver) HandleReadCommands(deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest) ([]*sdkModels.CommandValue, error) {
+	return d.HandleReadCommandsWithContext(context.Background(), deviceName, protocols, reqs)
+}
+
+// HandleReadCommandsWithContext returns the generated values of the DeviceResources, or the last written values of
+// the ones which are written, after the latency of the device
+func (d *Driver) HandleReadCommandsWithContext(ctx context.Context, deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest) ([]*sdkModels.CommandValue, error) {
+	device, err := d.device(deviceName, protocols)
+	if err != nil {
+		return nil, err
+	}
+	if err = device.simulateAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	res := make([]*sdkModels.CommandValue, len(reqs))
+	for i, req := range reqs {
+		dr, ok := d.sdk.DeviceResource(deviceName, req.DeviceResourceName)
+		if !ok {
+			return nil, fmt.Errorf("DeviceResource %s not found for device %s", req.DeviceResourceName, deviceName)
+		}
+		if dr.Properties.ReadWrite == common.ReadWrite_W {
+			return nil, fmt.Errorf("DeviceResource %s of device %s is write-only", dr.Name, deviceName)
+		}
+		if res[i], err = device.read(dr); err != nil {
+			return nil, fmt.Errorf("failed to simulate DeviceResource %s of device %s: %w", dr.Name, deviceName, err)
+		}
+	}
+	return res, nil
+}
+
+func (d *Driver) HandleWriteCommands(deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest, params []*sdkModels.CommandValue) error {
+	return d.HandleWriteCommandsWithContext(context.Background(), deviceName, protocols, reqs, params)
+}
+
+// HandleWriteCommandsWithContext holds the written values, which are read back until the device is updated
+func (d *Driver) HandleWriteCommandsWithContext(ctx context.Context, deviceName string, protocols map[string]models.ProtocolProperties, reqs []sdkModels.CommandRequest, params []*sdkModels.CommandValue) error {
+	device, err := d.device(deviceName, protocols)
+	if err != nil {
+		return err
+	}
+	if err = device.simulateAccess(ctx); err != nil {
+		return err
+	}
+
+	for i, req := range reqs {
+		dr, ok := d.sdk.DeviceResource(deviceName, req.DeviceResourceName)
+		if !ok {
+			return fmt.Errorf("DeviceResource %s not found for device %s", req.DeviceResourceName, deviceName)
+		}
+		if dr.Properties.ReadWrite == common.ReadWrite_R {
+			return fmt.Errorf("DeviceResource %s of device %s is read-only", dr.Name, deviceName)
+		}
+		if i >= len(params) || params[i] == nil {
+			return fmt.Errorf("missing value of DeviceResource %s", dr.Name)
+		}
+		device.mutex.Lock()
+		device.written[dr.Name] = params[i].Value
+		device.mutex.Unlock()
+	}
+	return nil
+}
+
+// AddDevice starts pushing the values of the device if its push interval is set
+func (d *Driver) AddDevice(deviceName string, protocols map[string]models.ProtocolProperties, adminState models.AdminState) error {
+	_, err := d.device(deviceName, protocols)
+	return err
+}
+
+// UpdateDevice restarts the simulation of the device, so that the updated settings and profile apply
+func (d *Driver) UpdateDevice(deviceName string, protocols map[string]models.ProtocolProperties, adminState models.AdminState) error {
+	d.removeDevice(deviceName)
+	_, err := d.device(deviceName, protocols)
+	return err
+}
+
+func (d *Driver) RemoveDevice(deviceName string, protocols map[string]models.ProtocolProperties) error {
+	d.removeDevice(deviceName)
+	return nil
+}
+
+// Discover finds the number of simulated devices set by the SimulatorDevices driver config
+func (d *Driver) Discover() error {
+	count := defaultDeviceCount
+	if v, ok := d.sdk.DriverConfigs()[DeviceCountConfig]; ok {
+		var err error
+		if count, err = strconv.Atoi(v); err != nil || count < 0 {
+			return fmt.Errorf("invalid %s driver config '%s', expected a non-negative integer", DeviceCountConfig, v)
+		}
+	}
+
+	devices := make([]sdkModels.DiscoveredDevice, count)
+	for i := range devices {
+		id := strconv.Itoa(i + 1)
+		devices[i] = sdkModels.DiscoveredDevice{
+			Name:        "Simulated-Device-" + id,
+			Protocols:   map[string]models.ProtocolProperties{Protocol: {IdProperty: id}},
+			Description: "simulated device",
+			Labels:      []string{"simulated"},
+		}
+	}
+	d.sdk.DiscoveredDeviceChannel() <- devices
+	return nil
+}
+
+// ValidateDevice validates the simulation settings of the device and the simulator attributes of its
+// DeviceResources
+func (d *Driver) ValidateDevice(device models.Device) error {
+	if _, err := parseSettings(device.Protocols); err != nil {
+		return err
+	}
+	profile, err := d.sdk.GetProfileByName(device.ProfileName)
+	if err != nil {
+		// the profile is validated by the SDK
+		return nil
+	}
+	for _, dr := range profile.DeviceResources {
+		if _, err = newGenerator(dr); err != nil {
+			return fmt.Errorf("invalid simulator attributes of DeviceResource %s: %w", dr.Name, err)
+		}
+	}
+	return nil
+}
+
+// device returns the simulation of the device, which starts on the first access
+func (d *Driver) device(deviceName string, protocols map[string]models.ProtocolProperties) (*simulatedDevice, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if device, ok := d.devices[deviceName]; ok {
+		return device, nil
+	}
+
+	s, err := parseSettings(protocols)
+	if err != nil {
+		return nil, err
+	}
+	seed := s.seed
+	if !s.seeded {
+		seed = rand.Uint64()
+	}
+	device := &simulatedDevice{
+		settings:   s,
+		rand:       rand.New(rand.NewPCG(seed, seed)),
+		generators: make(map[string]generator),
+		written:    make(map[string]any),
+		stop:       make(chan struct{}),
+	}
+	d.devices[deviceName] = device
+	if s.pushInterval > 0 && d.sdk.AsyncReadingsEnabled() {
+		device.wg.Add(1)
+		go d.push(deviceName, device)
+	}
+	return device, nil
+}
+
+func (d *Driver) removeDevice(deviceName string) {
+	d.mutex.Lock()
+	device, ok := d.devices[deviceName]
+	delete(d.devices, deviceName)
+	d.mutex.Unlock()
+	if ok {
+		device.stopPushing()
+	}
+}
+
+// push sends the values of the readable DeviceResources of the device asynchronously, each as its own source. The
+// virtual DeviceResources and the bit fields are computed by the SDK, so they aren't pushed.
+func (d *Driver) push(deviceName string, device *simulatedDevice) {
+	defer device.wg.Done()
+	ticker := time.NewTicker(device.settings.pushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-device.stop:
+			return
+		case <-ticker.C:
+		}
+
+		edgexDevice, err := d.sdk.GetDeviceByName(deviceName)
+		if err != nil {
+			continue
+		}
+		profile, err := d.sdk.GetProfileByName(edgexDevice.ProfileName)
+		if err != nil {
+			continue
+		}
+		for _, dr := range profile.DeviceResources {
+			if dr.Properties.ReadWrite == common.ReadWrite_W || dr.Attributes[sdkCommon.VirtualResourceAttribute] != nil ||
+				dr.Attributes[sdkCommon.BitFieldWordAttribute] != nil {
+				continue
+			}
+			cv, err := device.read(dr)
+			if err != nil {
+				d.lc.Errorf("failed to simulate DeviceResource %s of device %s: %v", dr.Name, deviceName, err)
+				continue
+			}
+			select {
+			case d.sdk.AsyncValuesChannel() <- &sdkModels.AsyncValues{DeviceName: deviceName, SourceName: dr.Name, CommandValues: []*sdkModels.CommandValue{cv}}:
+			case <-device.stop:
+				return
+			}
+		}
+	}
+}
+
+func (device *simulatedDevice) stopPushing() {
+	close(device.stop)
+	device.wg.Wait()
+}
+
+// simulateAccess waits for the latency of the device, and fails at its failure rate
+func (device *simulatedDevice) simulateAccess(ctx context.Context) error {
+	device.mutex.Lock()
+	latency := device.settings.latency
+	if device.settings.jitter > 0 {
+		latency += time.Duration(device.rand.Int64N(int64(device.settings.jitter) + 1))
+	}
+	failed := device.settings.failureRate > 0 && device.rand.Float64() < device.settings.failureRate
+	device.mutex.Unlock()
+
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	if failed {
+		return fmt.Errorf("simulated failure of the device")
+	}
+	return nil
+}
+
+// read returns the written or the generated value of the DeviceResource, of bad quality at the bad quality rate of
+// the device
+func (device *simulatedDevice) read(dr models.DeviceResource) (*sdkModels.CommandValue, error) {
+	device.mutex.Lock()
+	defer device.mutex.Unlock()
+
+	value, ok := device.written[dr.Name]
+	if !ok {
+		g, ok := device.generators[dr.Name]
+		if !ok {
+			var err error
+			if g, err = newGenerator(dr); err != nil {
+				return nil, err
+			}
+			device.generators[dr.Name] = g
+		}
+		var err error
+		if value, err = device.generate(dr, g); err != nil {
+			return nil, err
+		}
+	}
+
+	cv, err := sdkModels.NewCommandValue(dr.Name, dr.Properties.ValueType, value)
+	if err != nil {
+		return nil, err
+	}
+	if device.settings.badQualityRate > 0 && device.rand.Float64() < device.settings.badQualityRate {
+		cv.SetQuality(sdkModels.QualityBad, sdkModels.QualityReasonSensorFailure, "simulated sensor failure")
+	}
+	return cv, nil
+}
+
+// generate returns the next value of the value type of the DeviceResource, generating the elements of the arrays
+// and the bytes of the Binary values one by one
+func (device *simulatedDevice) generate(dr models.DeviceResource, g generator) (any, error) {
+	now := time.Now()
+	valueType := dr.Properties.ValueType
+	length := defaultArrayLength
+	if v, ok := dr.Attributes[LengthAttribute]; ok {
+		var err error
+		if length, err = cast.ToIntE(v); err != nil || length < 0 {
+			return nil, fmt.Errorf("invalid %s attribute '%v', expected a non-negative integer", LengthAttribute, v)
+		}
+	}
+
+	switch valueType {
+	case common.ValueTypeObject:
+		return parseObject(g.next(now, device.rand))
+	case common.ValueTypeObjectArray:
+		object, err := parseObject(g.next(now, device.rand))
+		if err != nil {
+			return nil, err
+		}
+		if objects, ok := object.([]any); ok {
+			return objects, nil
+		}
+		return []any{object}, nil
+	case common.ValueTypeBinary:
+		return generateElements[byte](length, func() (any, error) {
+			return toValue(common.ValueTypeUint8, device.rand.UintN(256))
+		})
+	}
+
+	element := elementType(valueType)
+	if element == valueType {
+		return toValue(valueType, g.next(now, device.rand))
+	}
+	// the static arrays are the elements of their JSON array, e.g. the DefaultValue "[1, 2, 3]"
+	if s, ok := g.(*staticGenerator); ok {
+		var elements []any
+		if err := json.Unmarshal([]byte(cast.ToString(s.value)), &elements); err != nil && s.value != "" {
+			return nil, fmt.Errorf("failed to parse the array '%v': %w", s.value, err)
+		}
+		length = len(elements)
+		index := 0
+		g = generatorFunc(func() any {
+			index++
+			return fmt.Sprint(elements[index-1])
+		})
+	}
+	next := func() (any, error) {
+		return toValue(element, g.next(now, device.rand))
+	}
+
+	switch valueType {
+	case common.ValueTypeBoolArray:
+		return generateElements[bool](length, next)
+	case common.ValueTypeStringArray:
+		return generateElements[string](length, next)
+	case common.ValueTypeUint8Array:
+		return generateElements[uint8](length, next)
+	case common.ValueTypeUint16Array:
+		return generateElements[uint16](length, next)
+	case common.ValueTypeUint32Array:
+		return generateElements[uint32](length, next)
+	case common.ValueTypeUint64Array:
+		return generateElements[uint64](length, next)
+	case common.ValueTypeInt8Array:
+		return generateElements[int8](length, next)
+	case common.ValueTypeInt16Array:
+		return generateElements[int16](length, next)
+	case common.ValueTypeInt32Array:
+		return generateElements[int32](length, next)
+	case common.ValueTypeInt64Array:
+		return generateElements[int64](length, next)
+	case common.ValueTypeFloat32Array:
+		return generateElements[float32](length, next)
+	case common.ValueTypeFloat64Array:
+		return generateElements[float64](length, next)
+	}
+	return nil, fmt.Errorf("value type %s can't be simulated", valueType)
+}
+
+// generatorFunc adapts a function to a generator
+type generatorFunc func() any
+
+func (f generatorFunc) next(_ time.Time, _ *rand.Rand) any {
+	return f()
+}
+
+func generateElements[T any](length int, next func() (any, error)) ([]T, error) {
+	elements := make([]T, length)
+	for i := range elements {
+		v, err := next()
+		if err != nil {
+			return nil, err
+		}
+		element, ok := v.(T)
+		if !ok {
+			return nil, fmt.Errorf("generated element %v is %T instead of %T", v, v, element)
+		}
+		elements[i] = element
+	}
+	return elements, nil
+}
+
+// parseObject parses the JSON representation of an Object value, an empty object if it's empty
+func parseObject(sample any) (any, error) {
+	s := cast.ToString(sample)
+	if s == "" {
+		return map[string]any{}, nil
+	}
+	var object any
+	if err := json.Unmarshal([]byte(s), &object); err != nil {
+		return nil, fmt.Errorf("failed to parse the Object '%s': %w", s, err)
+	}
+	return object, nil
+}
@@ -0,0 +1,217 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	sdkCommon "github.com/edgexfoundry/device-sdk-go/v4/internal/common"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/interfaces/mocks"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+)
+
+const testDevice = "simulated"
+
+var testProfile = models.DeviceProfile{
+	Name: "simulatedProfile",
+	DeviceResources: []models.DeviceResource{
+		{Name: "temperature", Attributes: map[string]any{GeneratorAttribute: GeneratorSequence, ValuesAttribute: "20.4,21.6"},
+			Properties: models.ResourceProperties{ValueType: common.ValueTypeInt32, ReadWrite: common.ReadWrite_R}},
+		{Name: "setpoint", Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_RW, DefaultValue: "18.5"}},
+		{Name: "reset", Properties: models.ResourceProperties{ValueType: common.ValueTypeBool, ReadWrite: common.ReadWrite_W}},
+		{Name: "samples", Attributes: map[string]any{GeneratorAttribute: GeneratorRandom, LengthAttribute: 3},
+			Properties: models.ResourceProperties{ValueType: common.ValueTypeUint16Array, ReadWrite: common.ReadWrite_R, Minimum: floatPointer(1), Maximum: floatPointer(9)}},
+		{Name: "coefficients", Properties: models.ResourceProperties{ValueType: common.ValueTypeInt8Array, ReadWrite: common.ReadWrite_RW, DefaultValue: "[1, -2]"}},
+		{Name: "config", Properties: models.ResourceProperties{ValueType: common.ValueTypeObject, ReadWrite: common.ReadWrite_RW, DefaultValue: `{"mode": "auto"}`}},
+		{Name: "doubled", Attributes: map[string]any{sdkCommon.VirtualResourceAttribute: "expression"},
+			Properties: models.ResourceProperties{ValueType: common.ValueTypeInt32, ReadWrite: common.ReadWrite_R}},
+	},
+}
+
+func newTestDriver(t *testing.T) (*Driver, *mocks.DeviceServiceSDK) {
+	sdk := mocks.NewDeviceServiceSDK(t)
+	sdk.On("LoggingClient").Return(logger.NewMockClient()).Maybe()
+	sdk.On("AsyncReadingsEnabled").Return(true).Maybe()
+	sdk.On("GetProfileByName", testProfile.Name).Return(testProfile, nil).Maybe()
+	sdk.On("GetDeviceByName", testDevice).Return(models.Device{Name: testDevice, ProfileName: testProfile.Name}, nil).Maybe()
+	sdk.On("DeviceResource", testDevice, mock.Anything).Return(func(_ string, name string) (models.DeviceResource, bool) {
+		for _, dr := range testProfile.DeviceResources {
+			if dr.Name == name {
+				return dr, true
+			}
+		}
+		return models.DeviceResource{}, false
+	}).Maybe()
+
+	d := NewDriver()
+	require.NoError(t, d.Initialize(sdk))
+	t.Cleanup(func() { _ = d.Stop(false) })
+	return d, sdk
+}
+
+func read(d *Driver, protocols map[string]models.ProtocolProperties, resources ...string) ([]*sdkModels.CommandValue, error) {
+	reqs := make([]sdkModels.CommandRequest, len(resources))
+	for i, r := range resources {
+		reqs[i] = sdkModels.CommandRequest{DeviceResourceName: r}
+	}
+	return d.HandleReadCommands(testDevice, protocols, reqs)
+}
+
+func TestDriver_ReadWrite(t *testing.T) {
+	d, _ := newTestDriver(t)
+
+	res, err := read(d, nil, "temperature", "temperature", "setpoint", "samples", "coefficients", "config")
+	require.NoError(t, err)
+	require.Len(t, res, 6)
+	assert.Equal(t, int32(20), res[0].Value, "expect the values to be rounded to the value type")
+	assert.Equal(t, int32(22), res[1].Value)
+	assert.Equal(t, float32(18.5), res[2].Value)
+	samples, ok := res[3].Value.([]uint16)
+	require.True(t, ok)
+	require.Len(t, samples, 3)
+	for _, s := range samples {
+		assert.True(t, s >= 1 && s <= 9)
+	}
+	assert.Equal(t, []int8{1, -2}, res[4].Value)
+	assert.Equal(t, map[string]any{"mode": "auto"}, res[5].Value)
+
+	setpoint, err := sdkModels.NewCommandValue("setpoint", common.ValueTypeFloat32, float32(22))
+	require.NoError(t, err)
+	err = d.HandleWriteCommands(testDevice, nil, []sdkModels.CommandRequest{{DeviceResourceName: "setpoint"}}, []*sdkModels.CommandValue{setpoint})
+	require.NoError(t, err)
+	res, err = read(d, nil, "setpoint")
+	require.NoError(t, err)
+	assert.Equal(t, float32(22), res[0].Value, "expect the written value to be read back")
+
+	_, err = read(d, nil, "reset")
+	assert.Error(t, err, "expect write-only DeviceResources not to be read")
+	_, err = read(d, nil, "unknown")
+	assert.Error(t, err)
+	temperature, err := sdkModels.NewCommandValue("temperature", common.ValueTypeInt32, int32(0))
+	require.NoError(t, err)
+	err = d.HandleWriteCommands(testDevice, nil, []sdkModels.CommandRequest{{DeviceResourceName: "temperature"}}, []*sdkModels.CommandValue{temperature})
+	assert.Error(t, err, "expect read-only DeviceResources not to be written")
+
+	require.NoError(t, d.UpdateDevice(testDevice, nil, models.Unlocked))
+	res, err = read(d, nil, "setpoint")
+	require.NoError(t, err)
+	assert.Equal(t, float32(18.5), res[0].Value, "expect the simulation to restart on update")
+}
+
+func TestDriver_Faults(t *testing.T) {
+	d, _ := newTestDriver(t)
+
+	failing := map[string]models.ProtocolProperties{Protocol: {FailureRateProperty: 1}}
+	_, err := read(d, failing, "temperature")
+	assert.Error(t, err)
+	require.NoError(t, d.RemoveDevice(testDevice, failing))
+
+	bad := map[string]models.ProtocolProperties{Protocol: {BadQualityRateProperty: "1"}}
+	res, err := read(d, bad, "temperature")
+	require.NoError(t, err)
+	assert.Equal(t, sdkModels.QualityBad, res[0].QualityStatus())
+	assert.Nil(t, res[0].Value)
+	require.NoError(t, d.RemoveDevice(testDevice, bad))
+
+	slow := map[string]models.ProtocolProperties{Protocol: {LatencyProperty: "50ms"}}
+	start := time.Now()
+	_, err = read(d, slow, "temperature")
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = d.HandleReadCommandsWithContext(ctx, testDevice, slow, []sdkModels.CommandRequest{{DeviceResourceName: "temperature"}})
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "expect the latency to be cancelled with the context")
+}
+
+func TestDriver_Seed(t *testing.T) {
+	d, _ := newTestDriver(t)
+	seeded := map[string]models.ProtocolProperties{Protocol: {SeedProperty: "42"}}
+
+	first, err := read(d, seeded, "samples")
+	require.NoError(t, err)
+	require.NoError(t, d.RemoveDevice(testDevice, seeded))
+	second, err := read(d, seeded, "samples")
+	require.NoError(t, err)
+	assert.Equal(t, first[0].Value, second[0].Value, "expect the seeded simulation to be reproducible")
+}
+
+func TestDriver_Push(t *testing.T) {
+	d, sdk := newTestDriver(t)
+	asyncCh := make(chan *sdkModels.AsyncValues, 10)
+	sdk.On("AsyncValuesChannel").Return(asyncCh)
+
+	require.NoError(t, d.AddDevice(testDevice, map[string]models.ProtocolProperties{Protocol: {PushIntervalProperty: "10ms"}}, models.Unlocked))
+	sources := make(map[string]bool)
+	for len(sources) < 4 {
+		select {
+		case values := <-asyncCh:
+			assert.Equal(t, testDevice, values.DeviceName)
+			require.Len(t, values.CommandValues, 1)
+			sources[values.SourceName] = true
+		case <-time.After(time.Second):
+			require.Fail(t, "timed out waiting for the pushed values")
+		}
+	}
+	require.NoError(t, d.RemoveDevice(testDevice, nil))
+	assert.NotContains(t, sources, "reset", "expect write-only DeviceResources not to be pushed")
+	assert.NotContains(t, sources, "doubled", "expect virtual DeviceResources not to be pushed")
+}
+
+func TestDriver_Discover(t *testing.T) {
+	d, sdk := newTestDriver(t)
+	deviceCh := make(chan []sdkModels.DiscoveredDevice, 1)
+	sdk.On("DiscoveredDeviceChannel").Return(deviceCh)
+	sdk.On("DriverConfigs").Return(map[string]string{DeviceCountConfig: "2"})
+
+	require.NoError(t, d.Discover())
+	devices := <-deviceCh
+	require.Len(t, devices, 2)
+	assert.Equal(t, "Simulated-Device-2", devices[1].Name)
+	assert.Equal(t, models.ProtocolProperties{IdProperty: "2"}, devices[1].Protocols[Protocol])
+}
+
+func TestDriver_ValidateDevice(t *testing.T) {
+	d, _ := newTestDriver(t)
+	invalidProfile := models.DeviceProfile{Name: "invalidProfile", DeviceResources: []models.DeviceResource{
+		{Name: "invalid", Attributes: map[string]any{GeneratorAttribute: "noise"}},
+	}}
+	d.sdk.(*mocks.DeviceServiceSDK).On("GetProfileByName", invalidProfile.Name).Return(invalidProfile, nil)
+
+	tests := []struct {
+		name        string
+		profile     string
+		protocols   map[string]models.ProtocolProperties
+		expectedErr bool
+	}{
+		{"valid", testProfile.Name, map[string]models.ProtocolProperties{Protocol: {LatencyProperty: "1s", FailureRateProperty: 0.5}}, false},
+		{"invalid - latency", testProfile.Name, map[string]models.ProtocolProperties{Protocol: {LatencyProperty: "soon"}}, true},
+		{"invalid - failure rate", testProfile.Name, map[string]models.ProtocolProperties{Protocol: {FailureRateProperty: 2}}, true},
+		{"invalid - seed", testProfile.Name, map[string]models.ProtocolProperties{Protocol: {SeedProperty: "random"}}, true},
+		{"invalid - generator", invalidProfile.Name, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := d.ValidateDevice(models.Device{Name: testDevice, ProfileName: tt.profile, Protocols: tt.protocols})
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,405 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"encoding/csv"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/spf13/cast"
+)
+
+// generator produces the successive samples of a simulated DeviceResource, either numbers or the string
+// representations of the values
+type generator interface {
+	next(now time.Time, r *rand.Rand) any
+}
+
+// randomGenerator samples uniformly from [min, max]
+type randomGenerator struct {
+	min, max float64
+}
+
+func (g *randomGenerator) next(_ time.Time, r *rand.Rand) any {
+	return g.min + r.Float64()*(g.max-g.min)
+}
+
+// randomWalkGenerator moves from the previous sample by a uniform step in [-step, step], bounded by [min, max]
+type randomWalkGenerator struct {
+	min, max, step float64
+	current        float64
+	started        bool
+}
+
+func (g *randomWalkGenerator) next(_ time.Time, r *rand.Rand) any {
+	if !g.started {
+		g.current, g.started = (g.min+g.max)/2, true
+	} else {
+		g.current = math.Min(math.Max(g.current+(r.Float64()*2-1)*g.step, g.min), g.max)
+	}
+	return g.current
+}
+
+// sineGenerator oscillates between min and max with the period
+type sineGenerator struct {
+	min, max float64
+	period   time.Duration
+}
+
+func (g *sineGenerator) next(now time.Time, _ *rand.Rand) any {
+	phase := float64(now.UnixNano()%g.period.Nanoseconds()) / float64(g.period.Nanoseconds())
+	return (g.min+g.max)/2 + (g.max-g.min)/2*math.Sin(2*math.Pi*phase)
+}
+
+// sawtoothGenerator ramps from min to max over the period
+type sawtoothGenerator struct {
+	min, max float64
+	period   time.Duration
+}
+
+func (g *sawtoothGenerator) next(now time.Time, _ *rand.Rand) any {
+	phase := float64(now.UnixNano()%g.period.Nanoseconds()) / float64(g.period.Nanoseconds())
+	return g.min + (g.max-g.min)*phase
+}
+
+// sequenceGenerator cycles through fixed values, which are also the rows of a replayed CSV column
+type sequenceGenerator struct {
+	values []string
+	index  int
+}
+
+func (g *sequenceGenerator) next(_ time.Time, _ *rand.Rand) any {
+	v := g.values[g.index]
+	g.index = (g.index + 1) % len(g.values)
+	return v
+}
+
+// staticGenerator returns the same value, which is the DefaultValue of the DeviceResource or the last written value
+type staticGenerator struct {
+	value any
+}
+
+func (g *staticGenerator) next(_ time.Time, _ *rand.Rand) any {
+	return g.value
+}
+
+// newGenerator creates the generator of the DeviceResource from its simulator attributes
+func newGenerator(dr models.DeviceResource) (generator, error) {
+	kind := strings.ToLower(cast.ToString(dr.Attributes[GeneratorAttribute]))
+	if kind == "" {
+		kind = defaultGenerator(dr)
+	}
+
+	minimum, maximum, err := generatorRange(dr)
+	if err != nil {
+		return nil, err
+	}
+	period := defaultPeriod
+	if v, ok := dr.Attributes[PeriodAttribute]; ok {
+		if period, err = time.ParseDuration(cast.ToString(v)); err != nil || period <= 0 {
+			return nil, fmt.Errorf("invalid %s attribute '%v', expected a positive duration", PeriodAttribute, v)
+		}
+	}
+
+	switch kind {
+	case GeneratorRandom:
+		return &randomGenerator{min: minimum, max: maximum}, nil
+	case GeneratorRandomWalk:
+		step := (maximum - minimum) / 100
+		if v, ok := dr.Attributes[StepAttribute]; ok {
+			if step, err = cast.ToFloat64E(v); err != nil || step <= 0 {
+				return nil, fmt.Errorf("invalid %s attribute '%v', expected a positive number", StepAttribute, v)
+			}
+		}
+		return &randomWalkGenerator{min: minimum, max: maximum, step: step}, nil
+	case GeneratorSine:
+		return &sineGenerator{min: minimum, max: maximum, period: period}, nil
+	case GeneratorSawtooth:
+		return &sawtoothGenerator{min: minimum, max: maximum, period: period}, nil
+	case GeneratorSequence:
+		values := strings.Split(cast.ToString(dr.Attributes[ValuesAttribute]), ",")
+		for i := range values {
+			values[i] = strings.TrimSpace(values[i])
+		}
+		if len(values) == 1 && values[0] == "" {
+			return nil, fmt.Errorf("the %s generator requires the %s attribute", GeneratorSequence, ValuesAttribute)
+		}
+		return &sequenceGenerator{values: values}, nil
+	case GeneratorCSV:
+		values, err := readCSVColumn(cast.ToString(dr.Attributes[FileAttribute]), cast.ToString(dr.Attributes[ColumnAttribute]))
+		if err != nil {
+			return nil, err
+		}
+		return &sequenceGenerator{values: values}, nil
+	case GeneratorStatic:
+		return &staticGenerator{value: dr.Properties.DefaultValue}, nil
+	}
+	return nil, fmt.Errorf("unknown %s '%s'", GeneratorAttribute, kind)
+}
+
+// defaultGenerator is a random walk for the read-only numbers, random values for the read-only Bools and the static
+// value otherwise, so that the written values are read back
+func defaultGenerator(dr models.DeviceResource) string {
+	if dr.Properties.ReadWrite != common.ReadWrite_R {
+		return GeneratorStatic
+	}
+	if _, ok := numericRange(dr.Properties.ValueType); ok {
+		return GeneratorRandomWalk
+	}
+	if elementType(dr.Properties.ValueType) == common.ValueTypeBool {
+		return GeneratorRandom
+	}
+	return GeneratorStatic
+}
+
+// generatorRange returns the range of the generated values, from the min and max attributes, the Minimum and
+// Maximum of the DeviceResource or the defaults of the value type in that order of precedence
+func generatorRange(dr models.DeviceResource) (float64, float64, error) {
+	typeRange, _ := numericRange(dr.Properties.ValueType)
+	minimum, maximum := math.Max(0, typeRange.min), math.Min(100, typeRange.max)
+	if elementType(dr.Properties.ValueType) == common.ValueTypeBool {
+		minimum, maximum = 0, 1
+	}
+	if dr.Properties.Minimum != nil {
+		minimum = *dr.Properties.Minimum
+	}
+	if dr.Properties.Maximum != nil {
+		maximum = *dr.Properties.Maximum
+	}
+	for attribute, bound := range map[string]*float64{MinAttribute: &minimum, MaxAttribute: &maximum} {
+		if v, ok := dr.Attributes[attribute]; ok {
+			f, err := cast.ToFloat64E(v)
+			if err != nil {
+				return 0, 0, fmt.Errorf("invalid %s attribute '%v', expected a number", attribute, v)
+			}
+			*bound = f
+		}
+	}
+	if minimum > maximum {
+		return 0, 0, fmt.Errorf("minimum %v of the generated values is greater than the maximum %v", minimum, maximum)
+	}
+	return minimum, maximum, nil
+}
+
+// csvFiles caches the rows of the replayed CSV files, which are usually shared by the DeviceResources of many devices
+var csvFiles = struct {
+	rows  map[string][][]string
+	mutex sync.Mutex
+}{rows: make(map[string][][]string)}
+
+// readCSVColumn returns the values of the column, either an index or the name of a column of the header row
+func readCSVColumn(path string, column string) ([]string, error) {
+	if path == "" {
+		return nil, fmt.Errorf("the %s generator requires the %s attribute", GeneratorCSV, FileAttribute)
+	}
+	csvFiles.mutex.Lock()
+	rows, ok := csvFiles.rows[path]
+	csvFiles.mutex.Unlock()
+	if !ok {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open CSV file: %w", err)
+		}
+		defer f.Close()
+		reader := csv.NewReader(f)
+		reader.FieldsPerRecord = -1
+		if rows, err = reader.ReadAll(); err != nil {
+			return nil, fmt.Errorf("failed to read CSV file %s: %w", path, err)
+		}
+		csvFiles.mutex.Lock()
+		csvFiles.rows[path] = rows
+		csvFiles.mutex.Unlock()
+	}
+
+	index, err := strconv.Atoi(column)
+	if column == "" {
+		index, err = 0, nil
+	}
+	if err != nil {
+		// the column is named by the header row, which isn't replayed
+		if len(rows) > 0 {
+			for i, name := range rows[0] {
+				if strings.TrimSpace(name) == column {
+					index, err = i, nil
+					break
+				}
+			}
+			rows = rows[1:]
+		}
+		if err != nil {
+			return nil, fmt.Errorf("column '%s' not found in CSV file %s", column, path)
+		}
+	}
+
+	var values []string
+	for _, row := range rows {
+		if index < len(row) {
+			values = append(values, strings.TrimSpace(row[index]))
+		}
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no values in column '%s' of CSV file %s", column, path)
+	}
+	return values, nil
+}
+
+type valueRange struct {
+	min, max float64
+}
+
+// numericRange returns the range of the numeric value type or the numeric array element type
+func numericRange(valueType string) (valueRange, bool) {
+	switch elementType(valueType) {
+	case common.ValueTypeUint8:
+		return valueRange{0, math.MaxUint8}, true
+	case common.ValueTypeUint16:
+		return valueRange{0, math.MaxUint16}, true
+	case common.ValueTypeUint32:
+		return valueRange{0, math.MaxUint32}, true
+	case common.ValueTypeUint64:
+		return valueRange{0, math.MaxUint64}, true
+	case common.ValueTypeInt8:
+		return valueRange{math.MinInt8, math.MaxInt8}, true
+	case common.ValueTypeInt16:
+		return valueRange{math.MinInt16, math.MaxInt16}, true
+	case common.ValueTypeInt32:
+		return valueRange{math.MinInt32, math.MaxInt32}, true
+	case common.ValueTypeInt64:
+		return valueRange{math.MinInt64, math.MaxInt64}, true
+	case common.ValueTypeFloat32:
+		return valueRange{-math.MaxFloat32, math.MaxFloat32}, true
+	case common.ValueTypeFloat64:
+		return valueRange{-math.MaxFloat64, math.MaxFloat64}, true
+	}
+	return valueRange{}, false
+}
+
+// elementType returns the value type of the elements of an array value type, and the value type itself otherwise
+func elementType(valueType string) string {
+	if valueType == common.ValueTypeObjectArray {
+		return common.ValueTypeObject
+	}
+	return strings.TrimSuffix(valueType, "Array")
+}
+
+// toValue converts a generated sample to a scalar value of the value type. The numbers are rounded and clamped to
+// the range of the integer types, and the strings are parsed.
+func toValue(valueType string, sample any) (any, error) {
+	if s, ok := sample.(string); ok {
+		_, numeric := numericRange(valueType)
+		switch {
+		case s == "" && valueType != common.ValueTypeString:
+			// the zero value, e.g. of a writable DeviceResource without DefaultValue which isn't written yet
+			sample = 0
+		case numeric:
+			// the decimal numbers replayed as integers are rounded and clamped like the generated numbers
+			if v, err := parseValue(valueType, s); err == nil {
+				return v, nil
+			}
+			f, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse '%s' as %s: %w", s, valueType, err)
+			}
+			sample = f
+		default:
+			return parseValue(valueType, s)
+		}
+	}
+	f, err := cast.ToFloat64E(sample)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert sample %v to %s: %w", sample, valueType, err)
+	}
+	if r, ok := numericRange(valueType); ok {
+		if valueType != common.ValueTypeFloat32 && valueType != common.ValueTypeFloat64 {
+			f = math.Round(f)
+		}
+		f = math.Min(math.Max(f, r.min), r.max)
+	}
+
+	switch valueType {
+	case common.ValueTypeBool:
+		return f >= 0.5, nil
+	case common.ValueTypeString:
+		return strconv.FormatFloat(f, 'f', -1, 64), nil
+	case common.ValueTypeUint8:
+		return uint8(f), nil
+	case common.ValueTypeUint16:
+		return uint16(f), nil
+	case common.ValueTypeUint32:
+		return uint32(f), nil
+	case common.ValueTypeUint64:
+		if f >= math.MaxUint64 {
+			return uint64(math.MaxUint64), nil
+		}
+		return uint64(f), nil
+	case common.ValueTypeInt8:
+		return int8(f), nil
+	case common.ValueTypeInt16:
+		return int16(f), nil
+	case common.ValueTypeInt32:
+		return int32(f), nil
+	case common.ValueTypeInt64:
+		if f >= math.MaxInt64 {
+			return int64(math.MaxInt64), nil
+		}
+		return int64(f), nil
+	case common.ValueTypeFloat32:
+		return float32(f), nil
+	case common.ValueTypeFloat64:
+		return f, nil
+	}
+	return nil, fmt.Errorf("value type %s can't be generated from numbers", valueType)
+}
+
+// parseValue parses the string representation of a scalar value of the value type
+func parseValue(valueType string, s string) (any, error) {
+	var v any
+	var err error
+	switch valueType {
+	case common.ValueTypeString:
+		return s, nil
+	case common.ValueTypeBool:
+		v, err = strconv.ParseBool(s)
+	case common.ValueTypeUint8:
+		v, err = cast.ToUint8E(s)
+	case common.ValueTypeUint16:
+		v, err = cast.ToUint16E(s)
+	case common.ValueTypeUint32:
+		v, err = cast.ToUint32E(s)
+	case common.ValueTypeUint64:
+		v, err = strconv.ParseUint(s, 10, 64)
+	case common.ValueTypeInt8:
+		v, err = cast.ToInt8E(s)
+	case common.ValueTypeInt16:
+		v, err = cast.ToInt16E(s)
+	case common.ValueTypeInt32:
+		v, err = cast.ToInt32E(s)
+	case common.ValueTypeInt64:
+		v, err = strconv.ParseInt(s, 10, 64)
+	case common.ValueTypeFloat32:
+		var f float64
+		f, err = strconv.ParseFloat(s, 32)
+		v = float32(f)
+	case common.ValueTypeFloat64:
+		v, err = strconv.ParseFloat(s, 64)
+	default:
+		return nil, fmt.Errorf("value type %s can't be parsed", valueType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse '%s' as %s: %w", s, valueType, err)
+	}
+	return v, nil
+}
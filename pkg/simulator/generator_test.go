@@ -0,0 +1,136 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"math"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func floatPointer(v float64) *float64 {
+	return &v
+}
+
+func TestNewGenerator(t *testing.T) {
+	csvFile := filepath.Join(t.TempDir(), "replay.csv")
+	require.NoError(t, os.WriteFile(csvFile, []byte("time,temperature\n1,20.5\n2,21\n"), 0600))
+	readOnly := models.ResourceProperties{ValueType: common.ValueTypeFloat64, ReadWrite: common.ReadWrite_R}
+	readWrite := models.ResourceProperties{ValueType: common.ValueTypeFloat64, ReadWrite: common.ReadWrite_RW, DefaultValue: "1.5"}
+
+	tests := []struct {
+		name        string
+		attributes  map[string]any
+		properties  models.ResourceProperties
+		expected    generator
+		expectedErr bool
+	}{
+		{"default - read-only number", nil, readOnly, &randomWalkGenerator{min: 0, max: 100, step: 1}, false},
+		{"default - writable number", nil, readWrite, &staticGenerator{value: "1.5"}, false},
+		{"default - read-only Bool", nil, models.ResourceProperties{ValueType: common.ValueTypeBool, ReadWrite: common.ReadWrite_R}, &randomGenerator{min: 0, max: 1}, false},
+		{"random with DeviceResource range", map[string]any{GeneratorAttribute: "random"},
+			models.ResourceProperties{ValueType: common.ValueTypeInt8, Minimum: floatPointer(-10), Maximum: floatPointer(10)}, &randomGenerator{min: -10, max: 10}, false},
+		{"random with attribute range", map[string]any{GeneratorAttribute: "random", MinAttribute: "-1", MaxAttribute: 1.0},
+			models.ResourceProperties{ValueType: common.ValueTypeInt8, Minimum: floatPointer(-10), Maximum: floatPointer(10)}, &randomGenerator{min: -1, max: 1}, false},
+		{"random walk step", map[string]any{GeneratorAttribute: "RandomWalk", StepAttribute: 5}, readOnly, &randomWalkGenerator{min: 0, max: 100, step: 5}, false},
+		{"sine", map[string]any{GeneratorAttribute: "sine", PeriodAttribute: "1h"}, readOnly, &sineGenerator{min: 0, max: 100, period: time.Hour}, false},
+		{"sawtooth", map[string]any{GeneratorAttribute: "sawtooth"}, readOnly, &sawtoothGenerator{min: 0, max: 100, period: defaultPeriod}, false},
+		{"sequence", map[string]any{GeneratorAttribute: "sequence", ValuesAttribute: "1, 2,3"}, readOnly, &sequenceGenerator{values: []string{"1", "2", "3"}}, false},
+		{"csv by column name", map[string]any{GeneratorAttribute: "csv", FileAttribute: csvFile, ColumnAttribute: "temperature"}, readOnly,
+			&sequenceGenerator{values: []string{"20.5", "21"}}, false},
+		{"csv by column index", map[string]any{GeneratorAttribute: "csv", FileAttribute: csvFile, ColumnAttribute: "0"}, readOnly,
+			&sequenceGenerator{values: []string{"time", "1", "2"}}, false},
+		{"invalid - unknown generator", map[string]any{GeneratorAttribute: "noise"}, readOnly, nil, true},
+		{"invalid - min greater than max", map[string]any{MinAttribute: 10, MaxAttribute: 0}, readOnly, nil, true},
+		{"invalid - period", map[string]any{GeneratorAttribute: "sine", PeriodAttribute: "0s"}, readOnly, nil, true},
+		{"invalid - step", map[string]any{StepAttribute: "large"}, readOnly, nil, true},
+		{"invalid - sequence without values", map[string]any{GeneratorAttribute: "sequence"}, readOnly, nil, true},
+		{"invalid - csv column not found", map[string]any{GeneratorAttribute: "csv", FileAttribute: csvFile, ColumnAttribute: "pressure"}, readOnly, nil, true},
+		{"invalid - csv file not found", map[string]any{GeneratorAttribute: "csv", FileAttribute: csvFile + ".missing"}, readOnly, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := newGenerator(models.DeviceResource{Name: "resource", Attributes: tt.attributes, Properties: tt.properties})
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, g)
+		})
+	}
+}
+
+func TestGenerators(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 1))
+	now := time.Unix(0, 0)
+
+	walk := &randomWalkGenerator{min: 0, max: 10, step: 1}
+	previous := walk.next(now, r).(float64)
+	assert.Equal(t, float64(5), previous, "expect the random walk to start in the middle of the range")
+	for range 1000 {
+		v := walk.next(now, r).(float64)
+		assert.LessOrEqual(t, math.Abs(v-previous), float64(1))
+		assert.True(t, v >= 0 && v <= 10)
+		previous = v
+	}
+
+	sine := &sineGenerator{min: -1, max: 1, period: 4 * time.Second}
+	assert.InDelta(t, 0, sine.next(now, r), 1e-9)
+	assert.InDelta(t, 1, sine.next(now.Add(time.Second), r), 1e-9)
+	assert.InDelta(t, -1, sine.next(now.Add(3*time.Second), r), 1e-9)
+
+	sawtooth := &sawtoothGenerator{min: 0, max: 100, period: 4 * time.Second}
+	assert.InDelta(t, 0, sawtooth.next(now, r), 1e-9)
+	assert.InDelta(t, 50, sawtooth.next(now.Add(2*time.Second), r), 1e-9)
+
+	sequence := &sequenceGenerator{values: []string{"a", "b"}}
+	assert.Equal(t, []any{"a", "b", "a"}, []any{sequence.next(now, r), sequence.next(now, r), sequence.next(now, r)})
+}
+
+func TestToValue(t *testing.T) {
+	tests := []struct {
+		name        string
+		valueType   string
+		sample      any
+		expected    any
+		expectedErr bool
+	}{
+		{"rounded integer", common.ValueTypeInt16, 41.6, int16(42), false},
+		{"clamped integer", common.ValueTypeUint8, 300.0, uint8(math.MaxUint8), false},
+		{"clamped negative unsigned", common.ValueTypeUint32, -1.0, uint32(0), false},
+		{"float", common.ValueTypeFloat32, 1.5, float32(1.5), false},
+		{"bool", common.ValueTypeBool, 0.7, true, false},
+		{"string from number", common.ValueTypeString, 2.5, "2.5", false},
+		{"parsed string", common.ValueTypeInt64, "-7", int64(-7), false},
+		{"parsed bool", common.ValueTypeBool, "true", true, false},
+		{"rounded decimal string", common.ValueTypeInt32, "20.6", int32(21), false},
+		{"zero value of empty string", common.ValueTypeFloat64, "", float64(0), false},
+		{"empty string", common.ValueTypeString, "", "", false},
+		{"invalid - unparsable string", common.ValueTypeUint8, "abc", nil, true},
+		{"invalid - binary", common.ValueTypeBinary, 1.0, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := toValue(tt.valueType, tt.sample)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, v)
+		})
+	}
+}
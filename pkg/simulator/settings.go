@@ -0,0 +1,130 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/spf13/cast"
+)
+
+// Protocol is the name of the protocol of the simulated devices, whose properties are the simulation settings
+const Protocol = "simulator"
+
+// properties of the simulator protocol
+const (
+	// LatencyProperty is the duration of each read and write, e.g. "50ms"
+	LatencyProperty = "latency"
+	// JitterProperty is the maximum random duration added to the latency
+	JitterProperty = "jitter"
+	// FailureRateProperty is the probability between 0 and 1 that a read or write fails
+	FailureRateProperty = "failureRate"
+	// BadQualityRateProperty is the probability between 0 and 1 that a read value is of bad quality
+	BadQualityRateProperty = "badQualityRate"
+	// PushIntervalProperty is the interval of pushing the values of the readable DeviceResources asynchronously,
+	// which are not pushed if it's not set
+	PushIntervalProperty = "pushInterval"
+	// SeedProperty seeds the random values of the device, so that the simulation is reproducible
+	SeedProperty = "seed"
+	// IdProperty identifies the discovered simulated devices
+	IdProperty = "id"
+)
+
+// attributes of the simulated DeviceResources
+const (
+	// GeneratorAttribute is the generator of the values, by default a random walk for the read-only numbers and
+	// the static value otherwise
+	GeneratorAttribute = "generator"
+	// MinAttribute is the minimum of the generated numbers, overriding the Minimum of the DeviceResource
+	MinAttribute = "min"
+	// MaxAttribute is the maximum of the generated numbers, overriding the Maximum of the DeviceResource
+	MaxAttribute = "max"
+	// PeriodAttribute is the period of the sine and sawtooth generators, e.g. "1m"
+	PeriodAttribute = "period"
+	// StepAttribute is the maximum step of the random walk generator, 1% of the range by default
+	StepAttribute = "step"
+	// ValuesAttribute is the comma separated values of the sequence generator
+	ValuesAttribute = "values"
+	// FileAttribute is the path of the CSV file replayed by the csv generator
+	FileAttribute = "file"
+	// ColumnAttribute is the index or the header name of the column of the CSV file, the first column by default
+	ColumnAttribute = "column"
+	// LengthAttribute is the number of elements of the generated arrays and Binary values
+	LengthAttribute = "length"
+)
+
+// generators of the values of the simulated DeviceResources
+const (
+	GeneratorRandom     = "random"
+	GeneratorRandomWalk = "randomwalk"
+	GeneratorSine       = "sine"
+	GeneratorSawtooth   = "sawtooth"
+	GeneratorSequence   = "sequence"
+	GeneratorCSV        = "csv"
+	GeneratorStatic     = "static"
+)
+
+// DeviceCountConfig is the driver config of the number of devices found by the discovery
+const DeviceCountConfig = "SimulatorDevices"
+
+const (
+	defaultPeriod      = time.Minute
+	defaultArrayLength = 4
+	defaultDeviceCount = 1
+)
+
+// settings are the simulation settings of a device
+type settings struct {
+	latency        time.Duration
+	jitter         time.Duration
+	failureRate    float64
+	badQualityRate float64
+	pushInterval   time.Duration
+	seed           uint64
+	seeded         bool
+}
+
+// parseSettings parses the settings from the properties of the simulator protocol, which are optional
+func parseSettings(protocols map[string]models.ProtocolProperties) (settings, error) {
+	var s settings
+	properties := protocols[Protocol]
+
+	durations := map[string]*time.Duration{LatencyProperty: &s.latency, JitterProperty: &s.jitter, PushIntervalProperty: &s.pushInterval}
+	for name, d := range durations {
+		v, ok := properties[name]
+		if !ok {
+			continue
+		}
+		var err error
+		if *d, err = time.ParseDuration(cast.ToString(v)); err != nil || *d < 0 {
+			return settings{}, fmt.Errorf("invalid %s property '%v', expected a non-negative duration", name, v)
+		}
+	}
+
+	rates := map[string]*float64{FailureRateProperty: &s.failureRate, BadQualityRateProperty: &s.badQualityRate}
+	for name, r := range rates {
+		v, ok := properties[name]
+		if !ok {
+			continue
+		}
+		var err error
+		if *r, err = cast.ToFloat64E(v); err != nil || *r < 0 || *r > 1 {
+			return settings{}, fmt.Errorf("invalid %s property '%v', expected a number between 0 and 1", name, v)
+		}
+	}
+
+	if v, ok := properties[SeedProperty]; ok {
+		var err error
+		if s.seed, err = cast.ToUint64E(v); err != nil {
+			return settings{}, fmt.Errorf("invalid %s property '%v', expected an unsigned integer", SeedProperty, v)
+		}
+		s.seeded = true
+	}
+	return s, nil
+}
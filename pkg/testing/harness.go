@@ -0,0 +1,318 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package testing provides a test harness which runs a device service with its ProtocolDriver in the process,
+// with an in-memory Core Metadata and MessageBus instead of the EdgeX services and the broker. The device profiles,
+// devices and provision watchers are preloaded from the YAML or JSON files of directories, as by the device service,
+// and the tests issue GET and SET commands, push AsyncValues and assert the published Events and System Events.
+//
+// The caches and metrics of the device service are process globals, so a single harness can run in the process at a
+// time. New fails while another harness runs, the tests of a harness must not run in parallel with each other.
+package testing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v4/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/autodiscovery"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/config"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/faultinjection"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/interfaces"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/service"
+)
+
+const (
+	defaultServiceName = "device-test"
+	serviceVersion     = "0.0.0"
+	baseTopicPrefix    = "edgex"
+	// DefaultTimeout is the time the commands wait for their responses
+	DefaultTimeout = 5 * time.Second
+)
+
+// Options are the options of the test harness
+type Options struct {
+	// ServiceName is the name of the device service, "device-test" if it's empty
+	ServiceName string
+	// ProfilesDir, DevicesDir and ProvisionWatchersDir are the directories of the device profiles, devices and
+	// provision watchers which are loaded when the device service starts
+	ProfilesDir          string
+	DevicesDir           string
+	ProvisionWatchersDir string
+	// DriverConfigs is the driver specific configuration returned by DriverConfigs of the DeviceServiceSDK
+	DriverConfigs map[string]string
+	// ConfigFile is the configuration file containing the custom configuration of the ProtocolDriver
+	ConfigFile string
+	// LogLevel is the level of the logs of the device service, which are discarded if it's empty
+	LogLevel string
+	// DiscoveryDisabled disables the device discovery
+	DiscoveryDisabled bool
+	// EnableFaultInjection wraps the ProtocolDriver with a fault injector, whose faults are set by InjectFaults
+	EnableFaultInjection bool
+}
+
+// running is set from New to Close of the harness running in the process
+var running atomic.Bool
+
+// inProcessService is the device service which is run in the process by the harness
+type inProcessService interface {
+	RunInProcess(ctx context.Context, configuration *config.ConfigurationStruct, args []string, dic *di.Container) (*sync.WaitGroup, error)
+}
+
+// Harness runs a device service in the process, see the package documentation
+type Harness struct {
+	service     interfaces.DeviceServiceSDK
+	driver      interfaces.ProtocolDriver
+	serviceName string
+	dic         *di.Container
+	bus         *messageBus
+	ctx         context.Context
+	cancel      context.CancelFunc
+	wg          *sync.WaitGroup
+	closeOnce   sync.Once
+}
+
+// New starts the device service of the ProtocolDriver with the options. The harness must be closed by Close. It
+// fails if another harness runs in the process.
+func New(driver interfaces.ProtocolDriver, options Options) (h *Harness, err error) {
+	if !running.CompareAndSwap(false, true) {
+		return nil, errors.New("another harness is running in the process, only one harness can run at a time")
+	}
+	defer func() {
+		if err != nil {
+			running.Store(false)
+		}
+	}()
+
+	if options.ServiceName == "" {
+		options.ServiceName = defaultServiceName
+	}
+	ds, err := service.NewDeviceService(options.ServiceName, serviceVersion, driver)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := ds.(inProcessService)
+	if !ok {
+		return nil, errors.New("device service can't run in the process")
+	}
+
+	var lc logger.LoggingClient
+	if options.LogLevel == "" {
+		lc = logger.NewMockClient()
+	} else {
+		lc = logger.NewClient(options.ServiceName, options.LogLevel)
+	}
+	bus := newMessageBus()
+	dic := di.NewContainer(di.ServiceConstructorMap{})
+	md := newMetadata(dic)
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) any {
+			return lc
+		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) any {
+			return bus
+		},
+		bootstrapContainer.DeviceClientName: func(get di.Get) any {
+			return md
+		},
+		bootstrapContainer.DeviceProfileClientName: func(get di.Get) any {
+			return deviceProfileClient{md}
+		},
+		bootstrapContainer.DeviceServiceClientName: func(get di.Get) any {
+			return deviceServiceClient{md}
+		},
+		bootstrapContainer.ProvisionWatcherClientName: func(get di.Get) any {
+			return provisionWatcherClient{md}
+		},
+	})
+
+	var args []string
+	if options.ConfigFile != "" {
+		args = append(args, "-cf", options.ConfigFile)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	wg, err := s.RunInProcess(ctx, newConfiguration(options), args, dic)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &Harness{service: ds, driver: driver, serviceName: options.ServiceName, dic: dic, bus: bus, ctx: ctx, cancel: cancel, wg: wg}, nil
+}
+
+func newConfiguration(options Options) *config.ConfigurationStruct {
+	return &config.ConfigurationStruct{
+		Writable: config.WritableInfo{
+			LogLevel: options.LogLevel,
+		},
+		Service: bootstrapConfig.ServiceInfo{
+			Host: "localhost",
+			Port: 59999,
+		},
+		Device: config.DeviceInfo{
+			DataTransform:        true,
+			MaxCmdOps:            128,
+			MaxCmdValueLen:       256,
+			ProfilesDir:          options.ProfilesDir,
+			DevicesDir:           options.DevicesDir,
+			ProvisionWatchersDir: options.ProvisionWatchersDir,
+			Discovery: config.DiscoveryInfo{
+				Enabled:  !options.DiscoveryDisabled,
+				Interval: "0s",
+			},
+			AsyncBufferSize:      16,
+			EnableAsyncReadings:  true,
+			EnableFaultInjection: options.EnableFaultInjection,
+		},
+		Driver: options.DriverConfigs,
+		MessageBus: bootstrapConfig.MessageBusInfo{
+			BaseTopicPrefix: baseTopicPrefix,
+		},
+	}
+}
+
+// Close stops the device service and its ProtocolDriver, and disconnects the MessageBus
+func (h *Harness) Close() {
+	h.closeOnce.Do(func() {
+		h.cancel()
+		h.wg.Wait()
+		_ = h.bus.Disconnect()
+		running.Store(false)
+	})
+}
+
+// Service returns the DeviceServiceSDK of the device service, e.g. to add devices or to send discovered devices
+func (h *Harness) Service() interfaces.DeviceServiceSDK {
+	return h.service
+}
+
+// Get issues the GET command of the device with the query parameters, as Core Command does over the MessageBus,
+// and returns the Event read
+func (h *Harness) Get(deviceName string, commandName string, queryParams map[string]string) (*dtos.Event, error) {
+	response, err := h.command(deviceName, commandName, "get", nil, queryParams)
+	if err != nil {
+		return nil, err
+	}
+	res, err := types.GetMsgPayload[responses.EventResponse](*response)
+	if err != nil {
+		return nil, err
+	}
+	return &res.Event, nil
+}
+
+// Set issues the SET command of the device with the values and the query parameters, as Core Command does over the
+// MessageBus
+func (h *Harness) Set(deviceName string, commandName string, values map[string]any, queryParams map[string]string) error {
+	_, err := h.command(deviceName, commandName, "set", values, queryParams)
+	return err
+}
+
+func (h *Harness) command(deviceName string, commandName string, method string, payload any, queryParams map[string]string) (*types.MessageEnvelope, error) {
+	requestTopic := common.NewPathBuilder().SetPath(baseTopicPrefix).SetPath(common.CommandRequestSubscribeTopic).
+		SetNameFieldPath(h.serviceName).SetNameFieldPath(deviceName).SetNameFieldPath(commandName).SetPath(method).BuildPath()
+	responseTopicPrefix := common.NewPathBuilder().SetPath(baseTopicPrefix).SetPath(common.ResponseTopic).
+		SetNameFieldPath(h.serviceName).BuildPath()
+
+	response, err := h.bus.Request(types.NewMessageEnvelopeForRequest(payload, queryParams), requestTopic, responseTopicPrefix, DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if response.ErrorCode != 0 {
+		message, _ := types.GetMsgPayload[[]byte](*response)
+		return nil, fmt.Errorf("%s command %s of device %s failed: %s", method, commandName, deviceName, message)
+	}
+	return response, nil
+}
+
+// Discover triggers the device discovery of the ProtocolDriver, as the discovery REST API does. The discovered
+// devices are added asynchronously when they match the provision watchers.
+func (h *Harness) Discover() {
+	autodiscovery.DiscoveryWrapper(h.driver, h.ctx, h.dic)
+}
+
+// InjectFaults sets the faults injected into the commands of the ProtocolDriver, or stops injecting them if the
+// settings are nil. The fault injection must be enabled by Options.EnableFaultInjection.
+func (h *Harness) InjectFaults(settings *faultinjection.Settings) error {
+	injector := container.FaultInjectorFrom(h.dic.Get)
+	if injector == nil {
+		return errors.New("fault injection is not enabled")
+	}
+	return injector.Override(settings)
+}
+
+// PushAsyncValues sends the AsyncValues to the device service, as the ProtocolDriver does for the asynchronous
+// readings
+func (h *Harness) PushAsyncValues(values *sdkModels.AsyncValues) {
+	h.service.AsyncValuesChannel() <- values
+}
+
+// Messages returns the messages published to the MessageBus whose topic matches the filter, in which '+' matches
+// a level and a final '#' matches the remaining levels
+func (h *Harness) Messages(filter string) []Message {
+	messages, _ := h.bus.messages(filter)
+	return messages
+}
+
+// Events returns the Events published by the device service
+func (h *Harness) Events() []dtos.Event {
+	events, _ := h.events()
+	return events
+}
+
+func (h *Harness) events() ([]dtos.Event, <-chan struct{}) {
+	messages, notify := h.bus.messages(common.BuildTopic(baseTopicPrefix, common.EventsPublishTopic, "#"))
+	events := make([]dtos.Event, 0, len(messages))
+	for _, m := range messages {
+		req, err := types.GetMsgPayload[requests.AddEventRequest](m.Envelope)
+		if err == nil {
+			events = append(events, req.Event)
+		}
+	}
+	return events, notify
+}
+
+// WaitForEvents waits until at least n Events are published by the device service, and returns them
+func (h *Harness) WaitForEvents(n int, timeout time.Duration) ([]dtos.Event, error) {
+	deadline := time.After(timeout)
+	for {
+		events, notify := h.events()
+		if len(events) >= n {
+			return events, nil
+		}
+		select {
+		case <-notify:
+		case <-deadline:
+			return events, fmt.Errorf("timed out waiting for %d events, %d events were published", n, len(events))
+		}
+	}
+}
+
+// SystemEvents returns the System Events published by the device service, e.g. the discovery progress
+func (h *Harness) SystemEvents() []dtos.SystemEvent {
+	messages, _ := h.bus.messages(common.BuildTopic(baseTopicPrefix, common.SystemEventPublishTopic, "#"))
+	systemEvents := make([]dtos.SystemEvent, 0, len(messages))
+	for _, m := range messages {
+		systemEvent, err := types.GetMsgPayload[dtos.SystemEvent](m.Envelope)
+		if err == nil {
+			systemEvents = append(systemEvents, systemEvent)
+		}
+	}
+	return systemEvents
+}
@@ -0,0 +1,170 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/faultinjection"
+	sdkModels "github.com/edgexfoundry/device-sdk-go/v4/pkg/models"
+	"github.com/edgexfoundry/device-sdk-go/v4/pkg/simulator"
+)
+
+const testDevice = "Thermostat01"
+
+func newTestHarness(t *testing.T, options Options) *Harness {
+	options.ProfilesDir = "testdata/profiles"
+	options.DevicesDir = "testdata/devices"
+	options.ProvisionWatchersDir = "testdata/provisionwatchers"
+	h, err := New(simulator.NewDriver(), options)
+	require.NoError(t, err)
+	t.Cleanup(h.Close)
+	return h
+}
+
+func TestHarness_Preload(t *testing.T) {
+	h := newTestHarness(t, Options{})
+
+	profile, err := h.Service().GetProfileByName("Simulated-Thermostat")
+	require.NoError(t, err)
+	assert.Len(t, profile.DeviceResources, 2)
+	device, err := h.Service().GetDeviceByName(testDevice)
+	require.NoError(t, err)
+	assert.Equal(t, defaultServiceName, device.ServiceName)
+	_, err = h.Service().GetProvisionWatcherByName("Simulated-Thermostats")
+	assert.NoError(t, err)
+}
+
+func TestNew_Running(t *testing.T) {
+	h := newTestHarness(t, Options{})
+
+	_, err := New(simulator.NewDriver(), Options{ServiceName: "device-other"})
+	require.Error(t, err, "expect a second harness to be refused while the first one runs")
+
+	h.Close()
+	other, err := New(simulator.NewDriver(), Options{ServiceName: "device-other"})
+	require.NoError(t, err)
+	other.Close()
+}
+
+func TestHarness_GetSet(t *testing.T) {
+	h := newTestHarness(t, Options{})
+
+	event, err := h.Get(testDevice, "Status", nil)
+	require.NoError(t, err)
+	require.Len(t, event.Readings, 2)
+	assert.Equal(t, "2.050000e+01", event.Readings[0].Value)
+	assert.Equal(t, "18", event.Readings[1].Value)
+	assert.Empty(t, h.Events(), "expect no event published without ds-pushevent")
+
+	require.NoError(t, h.Set(testDevice, "Setpoint", map[string]any{"Setpoint": "22"}, nil))
+	event, err = h.Get(testDevice, "Setpoint", map[string]string{common.PushEvent: common.ValueTrue})
+	require.NoError(t, err)
+	assert.Equal(t, "22", event.Readings[0].Value)
+	events, err := h.WaitForEvents(2, time.Second)
+	require.NoError(t, err, "expect the events of the SET and the pushed GET")
+	assert.Equal(t, "Setpoint", events[1].SourceName)
+
+	_, err = h.Get(testDevice, "Unknown", nil)
+	assert.Error(t, err)
+	assert.Error(t, h.Set(testDevice, "Temperature", map[string]any{"Temperature": "1"}, nil), "expect writing a read-only resource to fail")
+}
+
+func TestHarness_PushAsyncValues(t *testing.T) {
+	h := newTestHarness(t, Options{})
+
+	cv, err := sdkModels.NewCommandValue("Temperature", common.ValueTypeFloat32, float32(30.5))
+	require.NoError(t, err)
+	h.PushAsyncValues(&sdkModels.AsyncValues{DeviceName: testDevice, SourceName: "Temperature", CommandValues: []*sdkModels.CommandValue{cv}})
+
+	events, err := h.WaitForEvents(1, time.Second)
+	require.NoError(t, err)
+	require.Len(t, events[0].Readings, 1)
+	assert.Equal(t, testDevice, events[0].DeviceName)
+	assert.Equal(t, "3.050000e+01", events[0].Readings[0].Value)
+	assert.Len(t, h.Messages("edgex/events/device/+/Simulated-Thermostat/"+testDevice+"/Temperature"), 1)
+}
+
+func TestHarness_Discover(t *testing.T) {
+	h := newTestHarness(t, Options{DriverConfigs: map[string]string{simulator.DeviceCountConfig: "2"}})
+
+	h.Discover()
+	require.Eventually(t, func() bool {
+		return h.Service().DeviceExistsForName("Simulated-Device-2")
+	}, time.Second, 10*time.Millisecond)
+	device, err := h.Service().GetDeviceByName("Simulated-Device-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Simulated-Thermostat", device.ProfileName)
+	assert.NotEmpty(t, h.SystemEvents(), "expect the discovery progress system events")
+}
+
+func TestHarness_ValidateDevice(t *testing.T) {
+	h := newTestHarness(t, Options{})
+
+	device := models.Device{
+		Name:        "Invalid",
+		ProfileName: "Simulated-Thermostat",
+		Protocols:   map[string]models.ProtocolProperties{simulator.Protocol: {simulator.FailureRateProperty: "2"}},
+		AdminState:  models.Unlocked,
+	}
+	_, err := h.Service().AddDevice(device)
+	assert.Error(t, err, "expect the device to be rejected by the ProtocolDriver")
+	assert.False(t, h.Service().DeviceExistsForName("Invalid"))
+
+	device.Protocols = map[string]models.ProtocolProperties{simulator.Protocol: {}}
+	_, err = h.Service().AddDevice(device)
+	require.NoError(t, err)
+	assert.True(t, h.Service().DeviceExistsForName("Invalid"))
+	require.NoError(t, h.Service().RemoveDeviceByName("Invalid"))
+	assert.False(t, h.Service().DeviceExistsForName("Invalid"))
+}
+
+func TestHarness_InjectFaults(t *testing.T) {
+	h := newTestHarness(t, Options{EnableFaultInjection: true})
+
+	require.NoError(t, h.InjectFaults(&faultinjection.Settings{ErrorRate: 1, Resources: []string{"Temperature"}}))
+	_, err := h.Get(testDevice, "Temperature", nil)
+	assert.Error(t, err)
+	_, err = h.Get(testDevice, "Setpoint", nil)
+	assert.NoError(t, err, "expect the faults to be limited to the Temperature resource")
+
+	require.NoError(t, h.InjectFaults(nil))
+	_, err = h.Get(testDevice, "Temperature", nil)
+	assert.NoError(t, err)
+
+	h.Close()
+	h = newTestHarness(t, Options{})
+	assert.Error(t, h.InjectFaults(&faultinjection.Settings{}), "expect the fault injection not to be enabled")
+}
+
+func TestTopicMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   string
+		topic    string
+		expected bool
+	}{
+		{"exact", "a/b/c", "a/b/c", true},
+		{"different level", "a/b/c", "a/x/c", false},
+		{"shorter topic", "a/b/c", "a/b", false},
+		{"longer topic", "a/b", "a/b/c", false},
+		{"single level wildcard", "a/+/c", "a/b/c", true},
+		{"multi level wildcard", "a/#", "a/b/c", true},
+		{"multi level wildcard of parent", "a/b/#", "a/b", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, topicMatches(tt.filter, tt.topic))
+		})
+	}
+}
@@ -0,0 +1,209 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package testing
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+	"github.com/google/uuid"
+)
+
+// Message is a message published to the in-memory MessageBus
+type Message struct {
+	Topic    string
+	Envelope types.MessageEnvelope
+}
+
+// subscriptionQueueSize is the number of messages queued for a subscriber, so that the publishers don't wait for
+// the subscribers
+const subscriptionQueueSize = 1024
+
+// subscription forwards the messages of the topic in order to the channel of the subscriber
+type subscription struct {
+	topic   string
+	pending chan types.MessageEnvelope
+	done    chan struct{}
+}
+
+func newSubscription(topic string, messages chan types.MessageEnvelope) *subscription {
+	s := &subscription{topic: topic, pending: make(chan types.MessageEnvelope, subscriptionQueueSize), done: make(chan struct{})}
+	go func() {
+		for {
+			select {
+			case <-s.done:
+				return
+			case message := <-s.pending:
+				select {
+				case messages <- message:
+				case <-s.done:
+					return
+				}
+			}
+		}
+	}()
+	return s
+}
+
+// messageBus is an in-memory MessageClient, which delivers the published messages to the subscriptions of
+// matching topics, with the MQTT wildcards '+' and '#', and records them
+type messageBus struct {
+	subscriptions []*subscription
+	published     []Message
+	// notify is closed and replaced on each publication, to wake up the ones waiting for messages
+	notify chan struct{}
+	mutex  sync.Mutex
+}
+
+func newMessageBus() *messageBus {
+	return &messageBus{notify: make(chan struct{})}
+}
+
+func (b *messageBus) Connect() error {
+	return nil
+}
+
+func (b *messageBus) Publish(message types.MessageEnvelope, topic string) error {
+	return b.PublishWithSizeLimit(message, topic, 0)
+}
+
+// PublishWithSizeLimit encodes the payload like the MessageBus clients, so that the subscribers receive the
+// encoded payloads, and fails if the payload is larger than the limit in kilobytes
+func (b *messageBus) PublishWithSizeLimit(message types.MessageEnvelope, topic string, limit int64) error {
+	if err := message.ConvertMsgPayloadToByteArray(); err != nil {
+		return fmt.Errorf("failed to encode the message payload: %w", err)
+	}
+	if payload, ok := message.Payload.([]byte); ok && limit > 0 && int64(len(payload)) > limit*1024 {
+		return fmt.Errorf("message size %d bytes exceeds the limit of %d KB", len(payload), limit)
+	}
+	message.ReceivedTopic = topic
+
+	b.mutex.Lock()
+	b.published = append(b.published, Message{Topic: topic, Envelope: message})
+	var receivers []*subscription
+	for _, s := range b.subscriptions {
+		if topicMatches(s.topic, topic) {
+			receivers = append(receivers, s)
+		}
+	}
+	close(b.notify)
+	b.notify = make(chan struct{})
+	b.mutex.Unlock()
+
+	for _, s := range receivers {
+		select {
+		case s.pending <- message:
+		case <-s.done:
+		}
+	}
+	return nil
+}
+
+func (b *messageBus) Subscribe(topics []types.TopicChannel, _ chan error) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	for _, t := range topics {
+		b.subscriptions = append(b.subscriptions, newSubscription(t.Topic, t.Messages))
+	}
+	return nil
+}
+
+// Request publishes the request and waits for the response published to the topic of the request id
+func (b *messageBus) Request(message types.MessageEnvelope, requestTopic string, responseTopicPrefix string, timeout time.Duration) (*types.MessageEnvelope, error) {
+	if strings.TrimSpace(message.RequestID) == "" {
+		message.RequestID = uuid.NewString()
+	}
+	responseTopic := responseTopicPrefix + "/" + message.RequestID
+	responses := make(chan types.MessageEnvelope, 1)
+	if err := b.Subscribe([]types.TopicChannel{{Topic: responseTopic, Messages: responses}}, nil); err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = b.Unsubscribe(responseTopic)
+	}()
+
+	if err := b.Publish(message, requestTopic); err != nil {
+		return nil, err
+	}
+	select {
+	case response := <-responses:
+		return &response, nil
+	case <-time.After(timeout):
+		return nil, fmt.Errorf("timed out waiting for response on %s topic", responseTopic)
+	}
+}
+
+func (b *messageBus) PublishBinaryData(data []byte, topic string) error {
+	return b.Publish(types.MessageEnvelope{Payload: data}, topic)
+}
+
+func (b *messageBus) SubscribeBinaryData(topics []types.TopicChannel, messageErrors chan error) error {
+	return b.Subscribe(topics, messageErrors)
+}
+
+func (b *messageBus) Unsubscribe(topics ...string) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	subscriptions := b.subscriptions[:0]
+	for _, s := range b.subscriptions {
+		unsubscribed := false
+		for _, t := range topics {
+			unsubscribed = unsubscribed || s.topic == t
+		}
+		if unsubscribed {
+			close(s.done)
+		} else {
+			subscriptions = append(subscriptions, s)
+		}
+	}
+	b.subscriptions = subscriptions
+	return nil
+}
+
+// Disconnect stops forwarding the messages to all the subscriptions
+func (b *messageBus) Disconnect() error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	for _, s := range b.subscriptions {
+		close(s.done)
+	}
+	b.subscriptions = nil
+	return nil
+}
+
+// messages returns the published messages whose topic matches the filter, and the channel closed on the next
+// publication
+func (b *messageBus) messages(filter string) ([]Message, <-chan struct{}) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	var messages []Message
+	for _, m := range b.published {
+		if topicMatches(filter, m.Topic) {
+			messages = append(messages, m)
+		}
+	}
+	return messages, b.notify
+}
+
+// topicMatches reports whether the topic matches the filter, in which '+' matches a level and a final '#' matches
+// the remaining levels
+func topicMatches(filter string, topic string) bool {
+	filterLevels := strings.Split(filter, "/")
+	topicLevels := strings.Split(topic, "/")
+	for i, level := range filterLevels {
+		if level == "#" {
+			return true
+		}
+		if i >= len(topicLevels) || (level != "+" && level != topicLevels[i]) {
+			return false
+		}
+	}
+	return len(filterLevels) == len(topicLevels)
+}
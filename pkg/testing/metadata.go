@@ -0,0 +1,628 @@
+// -*- Mode: Go; indent-tabs-mode: t -*-
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"sort"
+	"sync"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/application"
+	"github.com/edgexfoundry/device-sdk-go/v4/internal/container"
+)
+
+// metadata is an in-memory Core Metadata, implementing the clients of the devices, device profiles, device services
+// and provision watchers. Like Core Metadata, it validates the added and updated devices of the device service with
+// the ProtocolDriver, and notifies the device service of the changes, synchronously instead of by System Events so
+// that the changes apply when the clients return.
+type metadata struct {
+	dic      *di.Container
+	devices  map[string]models.Device
+	profiles map[string]models.DeviceProfile
+	services map[string]models.DeviceService
+	watchers map[string]models.ProvisionWatcher
+	mutex    sync.Mutex
+}
+
+func newMetadata(dic *di.Container) *metadata {
+	return &metadata{
+		dic:      dic,
+		devices:  make(map[string]models.Device),
+		profiles: make(map[string]models.DeviceProfile),
+		services: make(map[string]models.DeviceService),
+		watchers: make(map[string]models.ProvisionWatcher),
+	}
+}
+
+// serviceName returns the name of the device service, which is only notified of the changes of its own devices and
+// provision watchers
+func (m *metadata) serviceName() string {
+	return container.DeviceServiceFrom(m.dic.Get).Name
+}
+
+// notify logs the failure of the device service to apply a change, which doesn't fail the change in Core Metadata
+func (m *metadata) notify(err errors.EdgeX) {
+	if err != nil {
+		bootstrapContainer.LoggingClientFrom(m.dic.Get).Errorf("device service failed to apply the Core Metadata change: %v", err)
+	}
+}
+
+func notFound(entity string, name string) errors.EdgeX {
+	return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("%s %s does not exist", entity, name), nil)
+}
+
+func duplicateName(entity string, name string) errors.EdgeX {
+	return errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("%s name %s exists", entity, name), nil)
+}
+
+func notImplemented(method string) errors.EdgeX {
+	return errors.NewCommonEdgeX(errors.KindNotImplemented, fmt.Sprintf("%s is not implemented by the test harness", method), nil)
+}
+
+// page returns the items sorted by name in the range of the offset and the limit, -1 for all the remaining items
+func page[T any](items map[string]T, filter func(T) bool, offset int, limit int) ([]T, uint32) {
+	names := make([]string, 0, len(items))
+	for name, item := range items {
+		if filter == nil || filter(item) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	total := uint32(len(names))
+	names = names[min(max(offset, 0), len(names)):]
+	if limit >= 0 && limit < len(names) {
+		names = names[:limit]
+	}
+	res := make([]T, len(names))
+	for i, name := range names {
+		res[i] = items[name]
+	}
+	return res, total
+}
+
+func hasLabels(itemLabels []string, labels []string) bool {
+	for _, l := range labels {
+		if !slices.Contains(itemLabels, l) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeviceClient
+
+func (m *metadata) Add(ctx context.Context, reqs []requests.AddDeviceRequest) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+	return m.AddWithQueryParams(ctx, reqs, nil)
+}
+
+func (m *metadata) AddWithQueryParams(_ context.Context, reqs []requests.AddDeviceRequest, _ map[string]string) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+	res := make([]dtoCommon.BaseWithIdResponse, len(reqs))
+	for i, req := range reqs {
+		device := dtos.ToDeviceModel(req.Device)
+		if err := m.validateDevice(device, true); err != nil {
+			return nil, err
+		}
+		device.Id = uuid.NewString()
+		m.mutex.Lock()
+		m.devices[device.Name] = device
+		m.mutex.Unlock()
+		if device.ServiceName == m.serviceName() {
+			m.notify(application.AddDevice(requests.NewAddDeviceRequest(dtos.FromDeviceModelToDTO(device)), m.dic))
+		}
+		res[i] = dtoCommon.NewBaseWithIdResponse(req.RequestId, "", http.StatusCreated, device.Id)
+	}
+	return res, nil
+}
+
+// validateDevice validates the device like Core Metadata, which requests the validation of the devices of the
+// device service from its ProtocolDriver
+func (m *metadata) validateDevice(device models.Device, add bool) errors.EdgeX {
+	m.mutex.Lock()
+	_, exists := m.devices[device.Name]
+	_, profileExists := m.profiles[device.ProfileName]
+	_, serviceExists := m.services[device.ServiceName]
+	m.mutex.Unlock()
+	if add && exists {
+		return duplicateName("device", device.Name)
+	}
+	if device.ProfileName != "" && !profileExists {
+		return notFound("device profile", device.ProfileName)
+	}
+	if !serviceExists {
+		return notFound("device service", device.ServiceName)
+	}
+	if device.ServiceName == m.serviceName() {
+		if err := container.ProtocolDriverFrom(m.dic.Get).ValidateDevice(device); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device %s validation failed", device.Name), err)
+		}
+	}
+	return nil
+}
+
+func (m *metadata) Update(ctx context.Context, reqs []requests.UpdateDeviceRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
+	return m.UpdateWithQueryParams(ctx, reqs, nil)
+}
+
+func (m *metadata) UpdateWithQueryParams(_ context.Context, reqs []requests.UpdateDeviceRequest, _ map[string]string) ([]dtoCommon.BaseResponse, errors.EdgeX) {
+	res := make([]dtoCommon.BaseResponse, len(reqs))
+	for i, req := range reqs {
+		m.mutex.Lock()
+		var device models.Device
+		var exists bool
+		if req.Device.Name != nil {
+			device, exists = m.devices[*req.Device.Name]
+		} else if req.Device.Id != nil {
+			for _, d := range m.devices {
+				if d.Id == *req.Device.Id {
+					device, exists = d, true
+				}
+			}
+		}
+		m.mutex.Unlock()
+		if !exists {
+			return nil, notFound("device", fmt.Sprint(req.Device.Name))
+		}
+
+		previousService := device.ServiceName
+		requests.ReplaceDeviceModelFieldsWithDTO(&device, req.Device)
+		if err := m.validateDevice(device, false); err != nil {
+			return nil, err
+		}
+		m.mutex.Lock()
+		m.devices[device.Name] = device
+		m.mutex.Unlock()
+		if device.ServiceName == m.serviceName() || previousService == m.serviceName() {
+			update := requests.NewUpdateDeviceRequest(dtos.FromDeviceModelToUpdateDTO(device))
+			m.notify(application.UpdateDevice(update, m.dic))
+		}
+		res[i] = dtoCommon.NewBaseResponse(req.RequestId, "", http.StatusOK)
+	}
+	return res, nil
+}
+
+func (m *metadata) AllDevices(_ context.Context, labels []string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	devices, total := page(m.devices, func(d models.Device) bool { return hasLabels(d.Labels, labels) }, offset, limit)
+	return responses.NewMultiDevicesResponse("", "", http.StatusOK, total, devicesToDTOs(devices)), nil
+}
+
+func (m *metadata) AllDevicesWithChildren(_ context.Context, _ string, _ uint, _ []string, _ int, _ int) (responses.MultiDevicesResponse, errors.EdgeX) {
+	return responses.MultiDevicesResponse{}, notImplemented("AllDevicesWithChildren")
+}
+
+func (m *metadata) DeviceNameExists(_ context.Context, name string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if _, ok := m.devices[name]; !ok {
+		return dtoCommon.BaseResponse{}, notFound("device", name)
+	}
+	return dtoCommon.NewBaseResponse("", "", http.StatusOK), nil
+}
+
+func (m *metadata) DeviceByName(_ context.Context, name string) (responses.DeviceResponse, errors.EdgeX) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	device, ok := m.devices[name]
+	if !ok {
+		return responses.DeviceResponse{}, notFound("device", name)
+	}
+	return responses.NewDeviceResponse("", "", http.StatusOK, dtos.FromDeviceModelToDTO(device)), nil
+}
+
+func (m *metadata) DeleteDeviceByName(_ context.Context, name string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	m.mutex.Lock()
+	device, ok := m.devices[name]
+	delete(m.devices, name)
+	m.mutex.Unlock()
+	if !ok {
+		return dtoCommon.BaseResponse{}, notFound("device", name)
+	}
+	if device.ServiceName == m.serviceName() {
+		m.notify(application.DeleteDevice(name, m.dic))
+	}
+	return dtoCommon.NewBaseResponse("", "", http.StatusOK), nil
+}
+
+func (m *metadata) DevicesByProfileName(_ context.Context, name string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	devices, total := page(m.devices, func(d models.Device) bool { return d.ProfileName == name }, offset, limit)
+	return responses.NewMultiDevicesResponse("", "", http.StatusOK, total, devicesToDTOs(devices)), nil
+}
+
+func (m *metadata) DevicesByServiceName(_ context.Context, name string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	devices, total := page(m.devices, func(d models.Device) bool { return d.ServiceName == name }, offset, limit)
+	return responses.NewMultiDevicesResponse("", "", http.StatusOK, total, devicesToDTOs(devices)), nil
+}
+
+func devicesToDTOs(devices []models.Device) []dtos.Device {
+	res := make([]dtos.Device, len(devices))
+	for i, d := range devices {
+		res[i] = dtos.FromDeviceModelToDTO(d)
+	}
+	return res
+}
+
+// deviceProfileClient is the DeviceProfileClient of the in-memory Core Metadata, whose methods clash with the ones
+// of the DeviceClient
+type deviceProfileClient struct {
+	*metadata
+}
+
+func (c deviceProfileClient) Add(_ context.Context, reqs []requests.DeviceProfileRequest) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+	res := make([]dtoCommon.BaseWithIdResponse, len(reqs))
+	for i, req := range reqs {
+		profile := dtos.ToDeviceProfileModel(req.Profile)
+		c.mutex.Lock()
+		if _, exists := c.profiles[profile.Name]; exists {
+			c.mutex.Unlock()
+			return nil, duplicateName("device profile", profile.Name)
+		}
+		profile.Id = uuid.NewString()
+		c.profiles[profile.Name] = profile
+		c.mutex.Unlock()
+		res[i] = dtoCommon.NewBaseWithIdResponse(req.RequestId, "", http.StatusCreated, profile.Id)
+	}
+	return res, nil
+}
+
+func (c deviceProfileClient) Update(_ context.Context, reqs []requests.DeviceProfileRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
+	res := make([]dtoCommon.BaseResponse, len(reqs))
+	for i, req := range reqs {
+		profile := dtos.ToDeviceProfileModel(req.Profile)
+		c.mutex.Lock()
+		existing, exists := c.profiles[profile.Name]
+		if exists {
+			profile.Id = existing.Id
+			c.profiles[profile.Name] = profile
+		}
+		c.mutex.Unlock()
+		if !exists {
+			return nil, notFound("device profile", profile.Name)
+		}
+		c.notify(application.UpdateProfile(requests.NewDeviceProfileRequest(dtos.FromDeviceProfileModelToDTO(profile)), c.dic))
+		res[i] = dtoCommon.NewBaseResponse(req.RequestId, "", http.StatusOK)
+	}
+	return res, nil
+}
+
+func (c deviceProfileClient) AddByYaml(ctx context.Context, yamlFilePath string) (dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+	profile, err := readProfileYaml(yamlFilePath)
+	if err != nil {
+		return dtoCommon.BaseWithIdResponse{}, err
+	}
+	res, err := c.Add(ctx, []requests.DeviceProfileRequest{requests.NewDeviceProfileRequest(profile)})
+	if err != nil {
+		return dtoCommon.BaseWithIdResponse{}, err
+	}
+	return res[0], nil
+}
+
+func (c deviceProfileClient) UpdateByYaml(ctx context.Context, yamlFilePath string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	profile, err := readProfileYaml(yamlFilePath)
+	if err != nil {
+		return dtoCommon.BaseResponse{}, err
+	}
+	res, err := c.Update(ctx, []requests.DeviceProfileRequest{requests.NewDeviceProfileRequest(profile)})
+	if err != nil {
+		return dtoCommon.BaseResponse{}, err
+	}
+	return res[0], nil
+}
+
+func readProfileYaml(path string) (dtos.DeviceProfile, errors.EdgeX) {
+	var profile dtos.DeviceProfile
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return profile, errors.NewCommonEdgeX(errors.KindIOError, fmt.Sprintf("failed to read %s", path), err)
+	}
+	if err = yaml.Unmarshal(content, &profile); err != nil {
+		return profile, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse %s", path), err)
+	}
+	return profile, nil
+}
+
+func (c deviceProfileClient) DeleteByName(_ context.Context, name string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	c.mutex.Lock()
+	_, exists := c.profiles[name]
+	inUse := false
+	for _, d := range c.devices {
+		inUse = inUse || d.ProfileName == name
+	}
+	if exists && !inUse {
+		delete(c.profiles, name)
+	}
+	c.mutex.Unlock()
+	if !exists {
+		return dtoCommon.BaseResponse{}, notFound("device profile", name)
+	}
+	if inUse {
+		return dtoCommon.BaseResponse{}, errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("device profile %s is in use by devices", name), nil)
+	}
+	c.notify(application.DeleteProfile(name, c.dic))
+	return dtoCommon.NewBaseResponse("", "", http.StatusOK), nil
+}
+
+func (c deviceProfileClient) DeviceProfileByName(_ context.Context, name string) (responses.DeviceProfileResponse, errors.EdgeX) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	profile, ok := c.profiles[name]
+	if !ok {
+		return responses.DeviceProfileResponse{}, notFound("device profile", name)
+	}
+	return responses.NewDeviceProfileResponse("", "", http.StatusOK, dtos.FromDeviceProfileModelToDTO(profile)), nil
+}
+
+func (c deviceProfileClient) AllDeviceProfiles(_ context.Context, labels []string, offset int, limit int) (responses.MultiDeviceProfilesResponse, errors.EdgeX) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	profiles, total := page(c.profiles, func(p models.DeviceProfile) bool { return hasLabels(p.Labels, labels) }, offset, limit)
+	res := make([]dtos.DeviceProfile, len(profiles))
+	for i, p := range profiles {
+		res[i] = dtos.FromDeviceProfileModelToDTO(p)
+	}
+	return responses.NewMultiDeviceProfilesResponse("", "", http.StatusOK, total, res), nil
+}
+
+func (c deviceProfileClient) AllDeviceProfileBasicInfos(_ context.Context, _ []string, _ int, _ int) (responses.MultiDeviceProfileBasicInfoResponse, errors.EdgeX) {
+	return responses.MultiDeviceProfileBasicInfoResponse{}, notImplemented("AllDeviceProfileBasicInfos")
+}
+
+func (c deviceProfileClient) DeviceProfilesByModel(_ context.Context, _ string, _ int, _ int) (responses.MultiDeviceProfilesResponse, errors.EdgeX) {
+	return responses.MultiDeviceProfilesResponse{}, notImplemented("DeviceProfilesByModel")
+}
+
+func (c deviceProfileClient) DeviceProfilesByManufacturer(_ context.Context, _ string, _ int, _ int) (responses.MultiDeviceProfilesResponse, errors.EdgeX) {
+	return responses.MultiDeviceProfilesResponse{}, notImplemented("DeviceProfilesByManufacturer")
+}
+
+func (c deviceProfileClient) DeviceProfilesByManufacturerAndModel(_ context.Context, _ string, _ string, _ int, _ int) (responses.MultiDeviceProfilesResponse, errors.EdgeX) {
+	return responses.MultiDeviceProfilesResponse{}, notImplemented("DeviceProfilesByManufacturerAndModel")
+}
+
+func (c deviceProfileClient) DeviceResourceByProfileNameAndResourceName(_ context.Context, profileName string, resourceName string) (responses.DeviceResourceResponse, errors.EdgeX) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	profile, ok := c.profiles[profileName]
+	if !ok {
+		return responses.DeviceResourceResponse{}, notFound("device profile", profileName)
+	}
+	for _, dr := range profile.DeviceResources {
+		if dr.Name == resourceName {
+			return responses.NewDeviceResourceResponse("", "", http.StatusOK, dtos.FromDeviceResourceModelToDTO(dr)), nil
+		}
+	}
+	return responses.DeviceResourceResponse{}, notFound("device resource", resourceName)
+}
+
+func (c deviceProfileClient) UpdateDeviceProfileBasicInfo(_ context.Context, _ []requests.DeviceProfileBasicInfoRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
+	return nil, notImplemented("UpdateDeviceProfileBasicInfo")
+}
+
+func (c deviceProfileClient) AddDeviceProfileResource(_ context.Context, _ []requests.AddDeviceResourceRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
+	return nil, notImplemented("AddDeviceProfileResource")
+}
+
+func (c deviceProfileClient) UpdateDeviceProfileResource(_ context.Context, _ []requests.UpdateDeviceResourceRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
+	return nil, notImplemented("UpdateDeviceProfileResource")
+}
+
+func (c deviceProfileClient) DeleteDeviceResourceByName(_ context.Context, _ string, _ string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	return dtoCommon.BaseResponse{}, notImplemented("DeleteDeviceResourceByName")
+}
+
+func (c deviceProfileClient) AddDeviceProfileDeviceCommand(_ context.Context, _ []requests.AddDeviceCommandRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
+	return nil, notImplemented("AddDeviceProfileDeviceCommand")
+}
+
+func (c deviceProfileClient) UpdateDeviceProfileDeviceCommand(_ context.Context, _ []requests.UpdateDeviceCommandRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
+	return nil, notImplemented("UpdateDeviceProfileDeviceCommand")
+}
+
+func (c deviceProfileClient) DeleteDeviceCommandByName(_ context.Context, _ string, _ string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	return dtoCommon.BaseResponse{}, notImplemented("DeleteDeviceCommandByName")
+}
+
+// deviceServiceClient is the DeviceServiceClient of the in-memory Core Metadata
+type deviceServiceClient struct {
+	*metadata
+}
+
+func (c deviceServiceClient) Add(_ context.Context, reqs []requests.AddDeviceServiceRequest) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+	res := make([]dtoCommon.BaseWithIdResponse, len(reqs))
+	for i, req := range reqs {
+		service := dtos.ToDeviceServiceModel(req.Service)
+		c.mutex.Lock()
+		if _, exists := c.services[service.Name]; exists {
+			c.mutex.Unlock()
+			return nil, duplicateName("device service", service.Name)
+		}
+		service.Id = uuid.NewString()
+		c.services[service.Name] = service
+		c.mutex.Unlock()
+		res[i] = dtoCommon.NewBaseWithIdResponse(req.RequestId, "", http.StatusCreated, service.Id)
+	}
+	return res, nil
+}
+
+func (c deviceServiceClient) Update(_ context.Context, reqs []requests.UpdateDeviceServiceRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
+	res := make([]dtoCommon.BaseResponse, len(reqs))
+	for i, req := range reqs {
+		if req.Service.Name == nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "device service name is required", nil)
+		}
+		c.mutex.Lock()
+		service, exists := c.services[*req.Service.Name]
+		if exists {
+			requests.ReplaceDeviceServiceModelFieldsWithDTO(&service, req.Service)
+			c.services[service.Name] = service
+		}
+		c.mutex.Unlock()
+		if !exists {
+			return nil, notFound("device service", *req.Service.Name)
+		}
+		if service.Name == c.serviceName() {
+			update := requests.NewUpdateDeviceServiceRequest(dtos.FromDeviceServiceModelToUpdateDTO(service))
+			c.notify(application.UpdateDeviceService(update, c.dic))
+		}
+		res[i] = dtoCommon.NewBaseResponse(req.RequestId, "", http.StatusOK)
+	}
+	return res, nil
+}
+
+func (c deviceServiceClient) AllDeviceServices(_ context.Context, labels []string, offset int, limit int) (responses.MultiDeviceServicesResponse, errors.EdgeX) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	services, total := page(c.services, func(s models.DeviceService) bool { return hasLabels(s.Labels, labels) }, offset, limit)
+	res := make([]dtos.DeviceService, len(services))
+	for i, s := range services {
+		res[i] = dtos.FromDeviceServiceModelToDTO(s)
+	}
+	return responses.NewMultiDeviceServicesResponse("", "", http.StatusOK, total, res), nil
+}
+
+func (c deviceServiceClient) DeviceServiceByName(_ context.Context, name string) (responses.DeviceServiceResponse, errors.EdgeX) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	service, ok := c.services[name]
+	if !ok {
+		return responses.DeviceServiceResponse{}, notFound("device service", name)
+	}
+	return responses.NewDeviceServiceResponse("", "", http.StatusOK, dtos.FromDeviceServiceModelToDTO(service)), nil
+}
+
+func (c deviceServiceClient) DeleteByName(_ context.Context, name string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, ok := c.services[name]; !ok {
+		return dtoCommon.BaseResponse{}, notFound("device service", name)
+	}
+	delete(c.services, name)
+	return dtoCommon.NewBaseResponse("", "", http.StatusOK), nil
+}
+
+// provisionWatcherClient is the ProvisionWatcherClient of the in-memory Core Metadata
+type provisionWatcherClient struct {
+	*metadata
+}
+
+func (c provisionWatcherClient) Add(_ context.Context, reqs []requests.AddProvisionWatcherRequest) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+	res := make([]dtoCommon.BaseWithIdResponse, len(reqs))
+	for i, req := range reqs {
+		watcher := dtos.ToProvisionWatcherModel(req.ProvisionWatcher)
+		c.mutex.Lock()
+		if _, exists := c.watchers[watcher.Name]; exists {
+			c.mutex.Unlock()
+			return nil, duplicateName("provision watcher", watcher.Name)
+		}
+		watcher.Id = uuid.NewString()
+		c.watchers[watcher.Name] = watcher
+		c.mutex.Unlock()
+		if watcher.ServiceName == c.serviceName() {
+			add := requests.NewAddProvisionWatcherRequest(dtos.FromProvisionWatcherModelToDTO(watcher))
+			c.notify(application.AddProvisionWatcher(add, c.dic))
+		}
+		res[i] = dtoCommon.NewBaseWithIdResponse(req.RequestId, "", http.StatusCreated, watcher.Id)
+	}
+	return res, nil
+}
+
+func (c provisionWatcherClient) Update(_ context.Context, reqs []requests.UpdateProvisionWatcherRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
+	res := make([]dtoCommon.BaseResponse, len(reqs))
+	for i, req := range reqs {
+		if req.ProvisionWatcher.Name == nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "provision watcher name is required", nil)
+		}
+		c.mutex.Lock()
+		watcher, exists := c.watchers[*req.ProvisionWatcher.Name]
+		if exists {
+			requests.ReplaceProvisionWatcherModelFieldsWithDTO(&watcher, req.ProvisionWatcher)
+			c.watchers[watcher.Name] = watcher
+		}
+		c.mutex.Unlock()
+		if !exists {
+			return nil, notFound("provision watcher", *req.ProvisionWatcher.Name)
+		}
+		if watcher.ServiceName == c.serviceName() {
+			update := requests.NewUpdateProvisionWatcherRequest(dtos.FromProvisionWatcherModelToUpdateDTO(watcher))
+			c.notify(application.UpdateProvisionWatcher(update, c.dic))
+		}
+		res[i] = dtoCommon.NewBaseResponse(req.RequestId, "", http.StatusOK)
+	}
+	return res, nil
+}
+
+func (c provisionWatcherClient) AllProvisionWatchers(_ context.Context, labels []string, offset int, limit int) (responses.MultiProvisionWatchersResponse, errors.EdgeX) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	watchers, total := page(c.watchers, func(w models.ProvisionWatcher) bool { return hasLabels(w.Labels, labels) }, offset, limit)
+	return responses.NewMultiProvisionWatchersResponse("", "", http.StatusOK, total, watchersToDTOs(watchers)), nil
+}
+
+func (c provisionWatcherClient) ProvisionWatcherByName(_ context.Context, name string) (responses.ProvisionWatcherResponse, errors.EdgeX) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	watcher, ok := c.watchers[name]
+	if !ok {
+		return responses.ProvisionWatcherResponse{}, notFound("provision watcher", name)
+	}
+	return responses.NewProvisionWatcherResponse("", "", http.StatusOK, dtos.FromProvisionWatcherModelToDTO(watcher)), nil
+}
+
+func (c provisionWatcherClient) DeleteProvisionWatcherByName(_ context.Context, name string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	c.mutex.Lock()
+	watcher, ok := c.watchers[name]
+	delete(c.watchers, name)
+	c.mutex.Unlock()
+	if !ok {
+		return dtoCommon.BaseResponse{}, notFound("provision watcher", name)
+	}
+	if watcher.ServiceName == c.serviceName() {
+		c.notify(application.DeleteProvisionWatcher(name, c.dic))
+	}
+	return dtoCommon.NewBaseResponse("", "", http.StatusOK), nil
+}
+
+func (c provisionWatcherClient) ProvisionWatchersByProfileName(_ context.Context, name string, offset int, limit int) (responses.MultiProvisionWatchersResponse, errors.EdgeX) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	watchers, total := page(c.watchers, func(w models.ProvisionWatcher) bool { return w.DiscoveredDevice.ProfileName == name }, offset, limit)
+	return responses.NewMultiProvisionWatchersResponse("", "", http.StatusOK, total, watchersToDTOs(watchers)), nil
+}
+
+func (c provisionWatcherClient) ProvisionWatchersByServiceName(_ context.Context, name string, offset int, limit int) (responses.MultiProvisionWatchersResponse, errors.EdgeX) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	watchers, total := page(c.watchers, func(w models.ProvisionWatcher) bool { return w.ServiceName == name }, offset, limit)
+	return responses.NewMultiProvisionWatchersResponse("", "", http.StatusOK, total, watchersToDTOs(watchers)), nil
+}
+
+func watchersToDTOs(watchers []models.ProvisionWatcher) []dtos.ProvisionWatcher {
+	res := make([]dtos.ProvisionWatcher, len(watchers))
+	for i, w := range watchers {
+		res[i] = dtos.FromProvisionWatcherModelToDTO(w)
+	}
+	return res
+}